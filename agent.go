@@ -1,5 +1,15 @@
 package boardgame
 
+import (
+	"context"
+	"time"
+)
+
+//DefaultAgentThinkTime is how long an agent has to propose a move, unless it
+//implements ThinkTimeAgent. If it's the agent's turn and it runs out of time,
+//the first legal move is made on its behalf so the game doesn't stall.
+const DefaultAgentThinkTime = 30 * time.Second
+
 //Agent represents an Artificial Intelligence agent that plays as a specific
 //player in a specific game. Agents are created and affiliated with
 //GameManagers, and then when new games are set up they may be asked to play
@@ -35,5 +45,32 @@ type Agent interface {
 	//newState is the new state for this agent; if it is nil, a new state will
 	//not be saved and the next time ProposeMove is called the previously used
 	//state will be provided again.
+	//
+	//ProposeMove is called on its own goroutine, so it may take its time
+	//without holding up the game; game is a read-only snapshot of the game
+	//as of the version the agent is being asked about. If the agent takes
+	//longer than its think time, or the game moves on to a new version
+	//before it answers, whatever it returns is discarded (including
+	//newState), and it will be asked again about the new version.
 	ProposeMove(game *Game, player PlayerIndex, agentState []byte) (move Move, newState []byte)
 }
+
+//ContextAgent is an Agent that can stop thinking early. If an Agent
+//implements it, ProposeMoveContext is called instead of ProposeMove, with a
+//context that is cancelled once the agent's think time runs out or the game
+//moves on, at which point nothing it returns will be used. Search-based
+//agents should implement it to avoid wasting time on answers that will be
+//thrown away.
+type ContextAgent interface {
+	Agent
+	ProposeMoveContext(ctx context.Context, game *Game, player PlayerIndex, agentState []byte) (move Move, newState []byte)
+}
+
+//ThinkTimeAgent is an Agent that wants a different think time than
+//DefaultAgentThinkTime.
+type ThinkTimeAgent interface {
+	Agent
+	//ThinkTime is how long the agent has to propose a move each time it's
+	//asked.
+	ThinkTime() time.Duration
+}
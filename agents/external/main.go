@@ -0,0 +1,427 @@
+/*
+
+Package external is a boardgame.Agent that delegates its decisions to a bot
+running outside of the server, written in any language. The bot is either a
+process the agent starts and talks to over its stdin and stdout, or a server
+the agent connects to over a local socket.
+
+The protocol is newline delimited JSON. For each decision the agent writes a
+Request on its own line, and the bot must reply with a Response on its own
+line before the agent's timeout. A request to propose a move includes the
+state sanitized for the agent's seat (exactly what JSONForPlayer returns) and
+the moves that are currently legal for that seat, with their fields. The bot
+replies with the move it chooses, if any, and optionally new opaque agent
+state, which is stored via the storage manager's SaveAgentState and sent back
+with the next request for that seat.
+
+If the bot doesn't answer in time, can't be reached, or chooses a move that
+isn't legal, the agent falls back to proposing a random legal move, so a
+broken bot can't stall a game. A bot that times out is restarted (or
+reconnected to) before the next request.
+
+To use one, return it from your GameDelegate's ConfigureAgents:
+
+	func (g *gameDelegate) ConfigureAgents() []boardgame.Agent {
+		return []boardgame.Agent{
+			external.NewProcessAgent("python", "Python Bot", "python3", "bot.py"),
+		}
+	}
+
+*/
+package external
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/jkomoros/boardgame"
+)
+
+//DefaultTimeout is how long the bot has to answer a request, unless
+//WithTimeout is used.
+const DefaultTimeout = 5 * time.Second
+
+//maxLineSize is the longest line we'll accept from a bot.
+const maxLineSize = 16 * 1024 * 1024
+
+//Agent is a boardgame.Agent that asks an external bot what to do. Create one
+//with NewProcessAgent or NewSocketAgent. It's safe to use in many games at
+//once; requests are sent to the bot one at a time.
+type Agent struct {
+	name        string
+	displayName string
+	dial        func(timeout time.Duration) (io.ReadWriteCloser, error)
+	timeout     time.Duration
+
+	//lock is held for the duration of each exchange with the bot, and
+	//guards conn and rand.
+	lock sync.Mutex
+	conn *conn
+	rand *rand.Rand
+}
+
+//conn is a live connection to a bot.
+type conn struct {
+	rwc io.ReadWriteCloser
+	//lines receives each line the bot writes. It's closed when the bot
+	//closes its end.
+	lines chan []byte
+	//closed is closed when we close our end.
+	closed chan bool
+}
+
+//NewProcessAgent returns an agent that starts the given command the first
+//time it needs it and talks to it over its stdin and stdout. The process's
+//stderr is passed through to ours.
+func NewProcessAgent(name, displayName string, command string, args ...string) *Agent {
+	return newAgent(name, displayName, func(timeout time.Duration) (io.ReadWriteCloser, error) {
+		p, err := startProcess(command, args...)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
+//NewSocketAgent returns an agent that connects to a bot listening at the
+//given address, for example network "unix" and a path, or network "tcp" and
+//"localhost:9000".
+func NewSocketAgent(name, displayName string, network, address string) *Agent {
+	return newAgent(name, displayName, func(timeout time.Duration) (io.ReadWriteCloser, error) {
+		return net.DialTimeout(network, address, timeout)
+	})
+}
+
+func newAgent(name, displayName string, dial func(timeout time.Duration) (io.ReadWriteCloser, error)) *Agent {
+	return &Agent{
+		name:        name,
+		displayName: displayName,
+		dial:        dial,
+		timeout:     DefaultTimeout,
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+//WithTimeout sets how long the bot has to answer each request. We return a
+//reference to ourself to allow chaining.
+func (a *Agent) WithTimeout(timeout time.Duration) *Agent {
+	a.timeout = timeout
+	return a
+}
+
+//Name returns the name the agent was created with.
+func (a *Agent) Name() string {
+	return a.name
+}
+
+//DisplayName returns the display name the agent was created with.
+func (a *Agent) DisplayName() string {
+	return a.displayName
+}
+
+//Close disconnects from the bot, stopping it if it's a process. The next
+//request will start or connect to it again.
+func (a *Agent) Close() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.disconnect()
+}
+
+//SetUpForGame tells the bot about the new game, and returns the AgentState
+//it replies with.
+func (a *Agent) SetUpForGame(game *boardgame.Game, player boardgame.PlayerIndex) (agentState []byte) {
+
+	request, err := newRequest(RequestSetUp, game, player, nil)
+
+	if err != nil {
+		game.Manager().Logger().Warnln("External agent " + a.name + " couldn't describe game: " + err.Error())
+		return nil
+	}
+
+	response, err := a.exchange(request)
+
+	if err != nil {
+		game.Manager().Logger().Warnln("External agent " + a.name + " failed to set up: " + err.Error())
+		return nil
+	}
+
+	if response.AgentState == "" {
+		return nil
+	}
+
+	return []byte(response.AgentState)
+}
+
+//ProposeMove asks the bot which move to make, if player has any legal
+//moves. If the bot fails to choose a legal move in time, a random legal move
+//is proposed instead.
+func (a *Agent) ProposeMove(game *boardgame.Game, player boardgame.PlayerIndex, agentState []byte) (move boardgame.Move, newState []byte) {
+
+	legal := legalMoves(game, player)
+
+	if len(legal) == 0 {
+		//Nothing to decide, so don't bother the bot.
+		return nil, nil
+	}
+
+	logger := game.Manager().Logger()
+
+	request, err := newRequest(RequestProposeMove, game, player, agentState)
+
+	if err != nil {
+		logger.Warnln("External agent " + a.name + " couldn't describe game: " + err.Error())
+		return a.randomMove(legal), nil
+	}
+
+	for _, legalMove := range legal {
+		request.LegalMoves = append(request.LegalMoves, describeMove(legalMove))
+	}
+
+	response, err := a.exchange(request)
+
+	if err != nil {
+		logger.Warnln("External agent " + a.name + " failed, proposing a random move instead: " + err.Error())
+		return a.randomMove(legal), nil
+	}
+
+	if response.AgentState != "" {
+		newState = []byte(response.AgentState)
+	}
+
+	if response.Move == nil {
+		return nil, newState
+	}
+
+	move, err = chosenMove(game, player, response.Move)
+
+	if err != nil {
+		logger.Warnln("External agent " + a.name + " chose an invalid move, proposing a random move instead: " + err.Error())
+		return a.randomMove(legal), newState
+	}
+
+	return move, newState
+}
+
+func newRequest(requestType RequestType, game *boardgame.Game, player boardgame.PlayerIndex, agentState []byte) (*Request, error) {
+	state := game.CurrentState()
+
+	sanitized, err := game.JSONForPlayer(player, state)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stateJSON, err := json.Marshal(sanitized)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Request{
+		Type:       requestType,
+		GameName:   game.Name(),
+		GameID:     game.ID(),
+		Version:    state.Version(),
+		Player:     player,
+		State:      stateJSON,
+		AgentState: string(agentState),
+	}, nil
+}
+
+//chosenMove returns the move the bot chose, with its fields set, or an error
+//if it isn't legal.
+func chosenMove(game *boardgame.Game, player boardgame.PlayerIndex, chosen *ChosenMove) (boardgame.Move, error) {
+	move := game.MoveByName(chosen.Name)
+
+	if move == nil {
+		return nil, errors.New("there is no move named " + chosen.Name)
+	}
+
+	if err := setFields(move, chosen); err != nil {
+		return nil, err
+	}
+
+	if err := move.Legal(game.CurrentState(), player); err != nil {
+		return nil, errors.New(chosen.Name + " is not legal: " + err.Error())
+	}
+
+	return move, nil
+}
+
+func (a *Agent) randomMove(legal []boardgame.Move) boardgame.Move {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return legal[a.rand.Intn(len(legal))]
+}
+
+//exchange sends request to the bot, connecting first if necessary, and
+//waits up to the timeout for its response. If anything goes wrong the
+//connection is dropped, so a late answer can't be mistaken for the answer to
+//the next request.
+func (a *Agent) exchange(request *Request) (*Response, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.conn == nil {
+		rwc, err := a.dial(a.timeout)
+		if err != nil {
+			return nil, errors.New("couldn't connect: " + err.Error())
+		}
+		a.conn = newConn(rwc)
+	}
+
+	response, err := a.conn.exchange(request, a.timeout)
+
+	if err != nil {
+		a.disconnect()
+		return nil, err
+	}
+
+	return response, nil
+}
+
+//disconnect drops the connection, if there is one. lock must be held.
+func (a *Agent) disconnect() error {
+	if a.conn == nil {
+		return nil
+	}
+	err := a.conn.close()
+	a.conn = nil
+	return err
+}
+
+func newConn(rwc io.ReadWriteCloser) *conn {
+	result := &conn{
+		rwc:    rwc,
+		lines:  make(chan []byte),
+		closed: make(chan bool),
+	}
+	go result.readLoop()
+	return result
+}
+
+//readLoop sends each line the bot writes to lines, until it closes its end
+//or the connection is closed.
+func (c *conn) readLoop() {
+	defer close(c.lines)
+
+	scanner := bufio.NewScanner(c.rwc)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	for scanner.Scan() {
+		line := make([]byte, len(scanner.Bytes()))
+		copy(line, scanner.Bytes())
+		select {
+		case c.lines <- line:
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+func (c *conn) close() error {
+	close(c.closed)
+	return c.rwc.Close()
+}
+
+func (c *conn) exchange(request *Request, timeout time.Duration) (*Response, error) {
+
+	blob, err := json.Marshal(request)
+
+	if err != nil {
+		return nil, errors.New("couldn't encode request: " + err.Error())
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	written := make(chan error, 1)
+
+	//Writes block if the bot isn't reading, so they're subject to the
+	//timeout too.
+	go func() {
+		_, err := c.rwc.Write(append(blob, '\n'))
+		written <- err
+	}()
+
+	select {
+	case err := <-written:
+		if err != nil {
+			return nil, errors.New("couldn't send request: " + err.Error())
+		}
+	case <-timer.C:
+		return nil, errors.New("timed out sending request")
+	}
+
+	select {
+	case line, ok := <-c.lines:
+		if !ok {
+			return nil, errors.New("the bot disconnected")
+		}
+		response := &Response{}
+		if err := json.Unmarshal(line, response); err != nil {
+			return nil, errors.New("couldn't parse response: " + err.Error())
+		}
+		return response, nil
+	case <-timer.C:
+		return nil, errors.New("timed out waiting for a response")
+	}
+}
+
+//process is a running bot process, read from and written to via its stdout
+//and stdin.
+type process struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+}
+
+func startProcess(command string, args ...string) (*process, error) {
+	cmd := exec.Command(command, args...)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	return &process{
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: stdout,
+	}, nil
+}
+
+func (p *process) Read(b []byte) (int, error) {
+	return p.stdout.Read(b)
+}
+
+func (p *process) Write(b []byte) (int, error) {
+	return p.stdin.Write(b)
+}
+
+//Close stops the process.
+func (p *process) Close() error {
+	p.stdin.Close()
+	p.cmd.Process.Kill()
+	//Wait closes stdout, which stops the conn's readLoop.
+	p.cmd.Wait()
+	return nil
+}
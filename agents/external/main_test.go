@@ -0,0 +1,191 @@
+package external
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/examples/tictactoe"
+	"github.com/jkomoros/boardgame/storage/memory"
+	"github.com/workfit/tester/assert"
+)
+
+//runBot answers requests read from r on w, according to behavior:
+//"center" places a token in the center slot, "illegal" chooses a slot that
+//doesn't exist, "pass" doesn't choose a move, and "silent" never answers.
+func runBot(behavior string, r io.Reader, w io.Writer) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+
+	for scanner.Scan() {
+		var request Request
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+			panic(err)
+		}
+
+		response := &Response{}
+
+		switch {
+		case behavior == "silent":
+			continue
+		case request.Type == RequestSetUp:
+			response.AgentState = "ready"
+		case behavior == "pass":
+			response.AgentState = request.AgentState + " passed"
+		default:
+			slot := 4
+			if behavior == "illegal" {
+				slot = 99
+			}
+			response.AgentState = request.AgentState + fmt.Sprintf(" moved at %d with %d choices", request.Version, len(request.LegalMoves))
+			response.Move = &ChosenMove{
+				Name: "Place Token",
+				Fields: map[string]json.RawMessage{
+					"Slot": json.RawMessage(fmt.Sprint(slot)),
+				},
+			}
+		}
+
+		blob, _ := json.Marshal(response)
+		w.Write(append(blob, '\n'))
+	}
+}
+
+//TestHelperBot isn't a real test; it's the bot process processAgent starts,
+//which gets the behavior to use after a "--" argument.
+func TestHelperBot(t *testing.T) {
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) < 2 {
+		return
+	}
+	runBot(args[1], os.Stdin, os.Stdout)
+	os.Exit(0)
+}
+
+func processAgent(behavior string) *Agent {
+	return NewProcessAgent("bot", "Bot", os.Args[0], "-test.run=TestHelperBot", "--", behavior)
+}
+
+//socketAgent starts a bot listening on a unix socket and returns an agent
+//that connects to it, and a function to call to stop the bot.
+func socketAgent(t *testing.T, behavior string) (*Agent, func()) {
+	dir, err := ioutil.TempDir("", "external-agent")
+	assert.For(t).ThatActual(err).IsNil()
+
+	address := filepath.Join(dir, "bot.sock")
+
+	listener, err := net.Listen("unix", address)
+	assert.For(t).ThatActual(err).IsNil()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				runBot(behavior, conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	return NewSocketAgent("bot", "Bot", "unix", address), func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestGame(t *testing.T) *boardgame.Game {
+	manager, err := boardgame.NewGameManager(tictactoe.NewDelegate(), memory.NewStorageManager())
+	assert.For(t).ThatActual(err).IsNil()
+	game, err := manager.NewDefaultGame()
+	assert.For(t).ThatActual(err).IsNil()
+	return game
+}
+
+func slot(t *testing.T, move boardgame.Move) int {
+	val, err := move.ReadSetter().IntProp("Slot")
+	assert.For(t).ThatActual(err).IsNil()
+	return val
+}
+
+func TestProcessAgent(t *testing.T) {
+	agent := processAgent("center")
+	defer agent.Close()
+
+	game := newTestGame(t)
+
+	state := agent.SetUpForGame(game, 0)
+
+	assert.For(t).ThatActual(string(state)).Equals("ready")
+
+	move, newState := agent.ProposeMove(game, 0, state)
+
+	assert.For(t).ThatActual(move == nil).IsFalse()
+	assert.For(t).ThatActual(move.Info().Name()).Equals("Place Token")
+	assert.For(t).ThatActual(slot(t, move)).Equals(4)
+	assert.For(t).ThatActual(string(newState)).Equals(fmt.Sprintf("ready moved at %d with 1 choices", game.Version()))
+
+	//Player 1 has no legal moves, so the bot isn't asked.
+	move, newState = agent.ProposeMove(game, 1, state)
+
+	assert.For(t).ThatActual(move == nil).IsTrue()
+	assert.For(t).ThatActual(newState == nil).IsTrue()
+}
+
+func TestSocketAgent(t *testing.T) {
+	agent, done := socketAgent(t, "pass")
+	defer done()
+	defer agent.Close()
+
+	game := newTestGame(t)
+
+	move, newState := agent.ProposeMove(game, 0, []byte("thinking"))
+
+	assert.For(t).ThatActual(move == nil).IsTrue()
+	assert.For(t).ThatActual(string(newState)).Equals("thinking passed")
+}
+
+func TestFallbackToRandomMove(t *testing.T) {
+
+	tests := []struct {
+		description string
+		agent       *Agent
+	}{
+		{
+			"Timeout",
+			processAgent("silent").WithTimeout(200 * time.Millisecond),
+		},
+		{
+			"Illegal move",
+			processAgent("illegal"),
+		},
+		{
+			"Unreachable",
+			NewSocketAgent("bot", "Bot", "unix", filepath.Join(os.TempDir(), "no-such-bot.sock")),
+		},
+	}
+
+	for _, test := range tests {
+		game := newTestGame(t)
+
+		move, _ := test.agent.ProposeMove(game, 0, nil)
+
+		assert.For(t, test.description).ThatActual(move == nil).IsFalse()
+		assert.For(t, test.description).ThatActual(move.Legal(game.CurrentState(), 0)).IsNil()
+
+		test.agent.Close()
+	}
+}
@@ -0,0 +1,217 @@
+package external
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/base"
+)
+
+//RequestType is the kind of request sent to the external process.
+type RequestType string
+
+const (
+	//RequestSetUp is sent when a game the agent plays in is set up. The
+	//response's Move is ignored.
+	RequestSetUp RequestType = "SetUp"
+	//RequestProposeMove is sent after every move chain in a game the agent
+	//plays in where it has at least one legal move.
+	RequestProposeMove RequestType = "ProposeMove"
+)
+
+//Request is what's sent to the external process, as a single line of JSON.
+type Request struct {
+	Type RequestType
+	//GameName is the name of the game type, like "tictactoe".
+	GameName string
+	GameID   string
+	Version  int
+	//Player is the seat the agent is playing.
+	Player boardgame.PlayerIndex
+	//State is the game's current state, sanitized for Player, in the same
+	//shape the REST API's clients get.
+	State json.RawMessage
+	//LegalMoves are the moves Player may currently propose. Empty for
+	//RequestSetUp.
+	LegalMoves []*MoveDescription `json:",omitempty"`
+	//AgentState is the AgentState from the most recent response that
+	//included one, for this seat of this game.
+	AgentState string `json:",omitempty"`
+}
+
+//MoveDescription describes a move the agent may propose.
+type MoveDescription struct {
+	Name     string
+	HelpText string `json:",omitempty"`
+	Fields   []*FieldDescription
+}
+
+//FieldDescription describes a field of a move. Only fields of the types
+//ints, bools, strings, player indexes and enums are described, since those
+//are the only ones a response may set.
+type FieldDescription struct {
+	Name string
+	//Type is the boardgame.PropertyType of the field, like "TypeInt".
+	Type string
+	//EnumName is the name of the enum for fields of "TypeEnum".
+	EnumName string `json:",omitempty"`
+	//DefaultValue is the value the field will have if the response doesn't
+	//set it. For enums, it's the string value.
+	DefaultValue interface{}
+}
+
+//Response is what the external process must reply to each Request with, as
+//a single line of JSON.
+type Response struct {
+	//Move is the move to propose, or nil to not propose one.
+	Move *ChosenMove `json:",omitempty"`
+	//AgentState is opaque state to store, which will be sent back in
+	//subsequent requests for this seat of this game. If it's "", the
+	//previously stored state is kept.
+	AgentState string `json:",omitempty"`
+}
+
+//ChosenMove is the move the external process wants to propose.
+type ChosenMove struct {
+	//Name is the Name of one of the request's LegalMoves.
+	Name string
+	//Fields sets fields of the move, by name, to values of the type their
+	//FieldDescription says: numbers for ints and player indexes, booleans
+	//for bools, and strings for strings and enums. Fields not set keep
+	//their DefaultValue.
+	Fields map[string]json.RawMessage `json:",omitempty"`
+}
+
+//supportedField returns true if fields of the given type can be set by a
+//ChosenMove.
+func supportedField(fieldType boardgame.PropertyType) bool {
+	switch fieldType {
+	case boardgame.TypeInt, boardgame.TypeBool, boardgame.TypeString, boardgame.TypePlayerIndex, boardgame.TypeEnum:
+		return true
+	}
+	return false
+}
+
+//legalMoves returns the moves player may currently propose in game, with
+//their defaults set for the current state.
+func legalMoves(game *boardgame.Game, player boardgame.PlayerIndex) []boardgame.Move {
+	var result []boardgame.Move
+
+	state := game.CurrentState()
+
+	for _, move := range game.Moves() {
+		if base.IsFixUp(move) {
+			continue
+		}
+		if err := move.Legal(state, player); err != nil {
+			continue
+		}
+		result = append(result, move)
+	}
+
+	return result
+}
+
+func describeMove(move boardgame.Move) *MoveDescription {
+	result := &MoveDescription{
+		Name:     move.Info().Name(),
+		HelpText: move.HelpText(),
+	}
+
+	readSetter := move.ReadSetter()
+
+	for name, fieldType := range readSetter.Props() {
+		if !supportedField(fieldType) {
+			continue
+		}
+
+		field := &FieldDescription{
+			Name: name,
+			Type: fieldType.String(),
+		}
+
+		if fieldType == boardgame.TypeEnum {
+			enumVal, err := readSetter.ImmutableEnumProp(name)
+			if err == nil && enumVal != nil {
+				field.EnumName = enumVal.Enum().Name()
+				field.DefaultValue = enumVal.String()
+			}
+		} else {
+			field.DefaultValue, _ = readSetter.Prop(name)
+		}
+
+		result.Fields = append(result.Fields, field)
+	}
+
+	return result
+}
+
+//setFields sets the fields of move from the values in chosen.
+func setFields(move boardgame.Move, chosen *ChosenMove) error {
+	readSetter := move.ReadSetter()
+	props := readSetter.Props()
+
+	for name, raw := range chosen.Fields {
+		fieldType, ok := props[name]
+		if !ok || !supportedField(fieldType) {
+			return errors.New("Move has no settable field named " + name)
+		}
+
+		var err error
+
+		switch fieldType {
+		case boardgame.TypeInt:
+			var val int
+			if err = json.Unmarshal(raw, &val); err == nil {
+				err = readSetter.SetIntProp(name, val)
+			}
+		case boardgame.TypeBool:
+			var val bool
+			if err = json.Unmarshal(raw, &val); err == nil {
+				err = readSetter.SetBoolProp(name, val)
+			}
+		case boardgame.TypeString:
+			var val string
+			if err = json.Unmarshal(raw, &val); err == nil {
+				err = readSetter.SetStringProp(name, val)
+			}
+		case boardgame.TypePlayerIndex:
+			var val int
+			if err = json.Unmarshal(raw, &val); err == nil {
+				err = readSetter.SetPlayerIndexProp(name, boardgame.PlayerIndex(val))
+			}
+		case boardgame.TypeEnum:
+			err = setEnumField(readSetter, name, raw)
+		}
+
+		if err != nil {
+			return errors.New("Couldn't set field " + name + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+//setEnumField sets the named enum field to raw, which may be either the
+//string or the numeric value.
+func setEnumField(readSetter boardgame.PropertyReadSetter, name string, raw json.RawMessage) error {
+	enumVal, err := readSetter.EnumProp(name)
+	if err != nil {
+		return err
+	}
+
+	var str string
+
+	if err := json.Unmarshal(raw, &str); err != nil {
+		var num int
+		if err := json.Unmarshal(raw, &num); err != nil {
+			return errors.New("enum values must be strings or numbers")
+		}
+		str = strconv.Itoa(num)
+	}
+
+	//SetStringValue will also try converting to an int.
+	return enumVal.SetStringValue(str)
+}
@@ -0,0 +1,50 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+ActionSpaces is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.ActionSpaceProperties interface,
+making it easy to use moves.PlaceWorker and moves.ReturnWorkers for worker
+placement games. Like RoundRobin, you typically embed this IN ADDITION TO
+base.SubState. The workers on the spaces live in a Board you declare next to
+it, with one space per action space. The spaces themselves don't change
+during the game, so your GameDelegate describes them by implementing
+moves/interfaces.ActionSpaceDefiner.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.ActionSpaces
+        Spaces boardgame.Board `stack:"workers" board:"3"`
+    }
+
+ActionSpacePlacedSpaces and ActionSpacePlacedPlayers record, in order, the
+space and player of each worker placed since workers were last returned.
+*/
+type ActionSpaces struct {
+	ActionSpacePlacedSpaces  []int
+	ActionSpacePlacedPlayers []boardgame.PlayerIndex
+}
+
+//ActionSpacePlacementSpaces returns the value set via
+//SetActionSpacePlacements.
+func (a *ActionSpaces) ActionSpacePlacementSpaces() []int {
+	return a.ActionSpacePlacedSpaces
+}
+
+//ActionSpacePlacementPlayers returns the value set via
+//SetActionSpacePlacements.
+func (a *ActionSpaces) ActionSpacePlacementPlayers() []boardgame.PlayerIndex {
+	return a.ActionSpacePlacedPlayers
+}
+
+//SetActionSpacePlacements sets the values to return for
+//ActionSpacePlacementSpaces and ActionSpacePlacementPlayers.
+func (a *ActionSpaces) SetActionSpacePlacements(spaces []int, players []boardgame.PlayerIndex) {
+	a.ActionSpacePlacedSpaces = spaces
+	a.ActionSpacePlacedPlayers = players
+}
@@ -0,0 +1,96 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+Auction is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.AuctionProperties interface, making
+it easy to use moves.PlaceBid, moves.PassBid and moves.ResolveAuction. Like
+RoundRobin, you typically embed this IN ADDITION TO base.SubState.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.Auction
+    }
+
+AuctionBid and AuctionBidder are the current high bid and high bidder. Once the
+auction is resolved they hold the winning bid and winner, until the next
+auction starts. AuctionPassed are the players who have passed in the current
+auction. AuctionTurns counts the bids and passes made so far.
+
+AuctionSealed holds each player's bid in a sealed-bid auction, indexed by
+player. It's hidden from every player until ResolveAuction reveals the winner
+via AuctionBid and AuctionBidder.
+*/
+type Auction struct {
+	AuctionBid        int
+	AuctionBidder     boardgame.PlayerIndex
+	AuctionPassed     []boardgame.PlayerIndex
+	AuctionTurns      int
+	AuctionSealed     []int `sanitize:"hidden"`
+	AuctionInProgress bool
+}
+
+//AuctionHighBid returns the value set via SetAuctionHighBid.
+func (a *Auction) AuctionHighBid() int {
+	return a.AuctionBid
+}
+
+//AuctionHighBidder returns the value set via SetAuctionHighBidder.
+func (a *Auction) AuctionHighBidder() boardgame.PlayerIndex {
+	return a.AuctionBidder
+}
+
+//AuctionPassedPlayers returns the value set via SetAuctionPassedPlayers.
+func (a *Auction) AuctionPassedPlayers() []boardgame.PlayerIndex {
+	return a.AuctionPassed
+}
+
+//AuctionTurnCount returns the value set via SetAuctionTurnCount.
+func (a *Auction) AuctionTurnCount() int {
+	return a.AuctionTurns
+}
+
+//AuctionSealedBids returns the value set via SetAuctionSealedBids.
+func (a *Auction) AuctionSealedBids() []int {
+	return a.AuctionSealed
+}
+
+//AuctionHasStarted returns the value set via SetAuctionHasStarted.
+func (a *Auction) AuctionHasStarted() bool {
+	return a.AuctionInProgress
+}
+
+//SetAuctionHighBid sets the value to return for AuctionHighBid.
+func (a *Auction) SetAuctionHighBid(bid int) {
+	a.AuctionBid = bid
+}
+
+//SetAuctionHighBidder sets the value to return for AuctionHighBidder.
+func (a *Auction) SetAuctionHighBidder(player boardgame.PlayerIndex) {
+	a.AuctionBidder = player
+}
+
+//SetAuctionPassedPlayers sets the value to return for AuctionPassedPlayers.
+func (a *Auction) SetAuctionPassedPlayers(players []boardgame.PlayerIndex) {
+	a.AuctionPassed = players
+}
+
+//SetAuctionTurnCount sets the value to return for AuctionTurnCount.
+func (a *Auction) SetAuctionTurnCount(count int) {
+	a.AuctionTurns = count
+}
+
+//SetAuctionSealedBids sets the value to return for AuctionSealedBids.
+func (a *Auction) SetAuctionSealedBids(bids []int) {
+	a.AuctionSealed = bids
+}
+
+//SetAuctionHasStarted sets the value to return for AuctionHasStarted.
+func (a *Auction) SetAuctionHasStarted(hasStarted bool) {
+	a.AuctionInProgress = hasStarted
+}
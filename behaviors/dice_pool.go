@@ -0,0 +1,57 @@
+package behaviors
+
+/*
+DicePool is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.DicePoolProperties interface,
+making it easy to use moves.RollDice, moves.RerollSelected and moves.LockDie.
+Like RoundRobin, you typically embed this IN ADDITION TO base.SubState. The
+dice themselves live in a stack you declare next to it.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.DicePool
+        Dice boardgame.Stack `stack:"dice"`
+    }
+
+DicePoolRolled is true once the pool has been rolled, until your game calls
+SetDiceRolled(false), typically when the turn ends. DicePoolRerolls is how
+many times it's been rerolled since. DicePoolLocked is which dice are locked,
+indexed by slot in the dice stack; dice past its end aren't locked.
+*/
+type DicePool struct {
+	DicePoolRolled  bool
+	DicePoolRerolls int
+	DicePoolLocked  []bool
+}
+
+//DiceRolled returns the value set via SetDiceRolled.
+func (d *DicePool) DiceRolled() bool {
+	return d.DicePoolRolled
+}
+
+//DiceRerolls returns the value set via SetDiceRerolls.
+func (d *DicePool) DiceRerolls() int {
+	return d.DicePoolRerolls
+}
+
+//DiceLocked returns the value set via SetDiceLocked.
+func (d *DicePool) DiceLocked() []bool {
+	return d.DicePoolLocked
+}
+
+//SetDiceRolled sets the value to return for DiceRolled.
+func (d *DicePool) SetDiceRolled(rolled bool) {
+	d.DicePoolRolled = rolled
+}
+
+//SetDiceRerolls sets the value to return for DiceRerolls.
+func (d *DicePool) SetDiceRerolls(rerolls int) {
+	d.DicePoolRerolls = rerolls
+}
+
+//SetDiceLocked sets the value to return for DiceLocked.
+func (d *DicePool) SetDiceLocked(locked []bool) {
+	d.DicePoolLocked = locked
+}
@@ -0,0 +1,46 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+Draft is designed to be embedded in your GameState anonymously to automatically
+satisfy the moves/interfaces.DraftProperties interface, making it easy to use
+moves.DraftPick and moves.RotateHands. Like RoundRobin, you typically embed
+this IN ADDITION TO base.SubState.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.Draft
+        DraftTransit boardgame.Stack `stack:"cards" sanitize:"hidden"`
+    }
+
+DraftPicked are the players who have picked a card since hands were last
+passed. DraftRoundCount is how many times every hand has been picked empty.
+*/
+type Draft struct {
+	DraftPicked     []boardgame.PlayerIndex
+	DraftRoundCount int
+}
+
+//DraftPickedPlayers returns the value set via SetDraftPickedPlayers.
+func (d *Draft) DraftPickedPlayers() []boardgame.PlayerIndex {
+	return d.DraftPicked
+}
+
+//DraftRound returns the value set via SetDraftRound.
+func (d *Draft) DraftRound() int {
+	return d.DraftRoundCount
+}
+
+//SetDraftPickedPlayers sets the value to return for DraftPickedPlayers.
+func (d *Draft) SetDraftPickedPlayers(players []boardgame.PlayerIndex) {
+	d.DraftPicked = players
+}
+
+//SetDraftRound sets the value to return for DraftRound.
+func (d *Draft) SetDraftRound(round int) {
+	d.DraftRoundCount = round
+}
@@ -72,6 +72,13 @@ your game logic that a seat is filled. If it is sees that your game logic has a
 legal move type that is moves.SeatPlayer (or a move that embeds that move
 struct), then it will propose that move whenever there is a player to seat.
 
+The inverse works the same way. When a seated user leaves the game (or is
+removed by the game's owner), the server proposes moves.UnseatPlayer (or a move
+that embeds it) if your game logic has one, which marks the seat as empty and
+open again, and marks the player as inactive if your player states embed
+InactivePlayer. Alternatively, the server may hand the seat to an agent, in
+which case the seat stays filled and no move is made.
+
 You can control when the server tries to seat a player by controlling when that
 move type is legal. For example, if it always OK to seat a player at any point,
 you'd configure it so that move is legal in any phase. If you wanted to only
@@ -113,7 +120,9 @@ moves.CloseEmptySeat will mark as closed any seats that are currently empty,
 which effectively says "even though there are more seats, no more people may be
 seated". moves.InactivateEmptySeat marks any empty seat as inactive, which
 effectively communicates "until I say otherwise, just pretend like the empty
-seats aren't there".
+seats aren't there". moves.InactivatePlayer marks a specific player as
+inactive; the server proposes it when a game type's inactivity policy says that
+players who take too long to move should be skipped.
 
 Because of these concepts, when you want to know the number of logical players
 in your game at any moment, Game.NumPlayers() is often not what you want.
@@ -53,3 +53,56 @@ func TestSeater(t *testing.T) {
 	_, ok := b.(interfaces.Seater)
 	assert.For(t).ThatActual(ok).IsTrue()
 }
+
+//testBankLimiter limits each resource in it to its value.
+type testBankLimiter map[int]int
+
+func (t testBankLimiter) BankResourceLimit(resource int) int {
+	return t[resource]
+}
+
+func TestResources(t *testing.T) {
+	var b interface{}
+	b = &Resources{}
+	_, ok := b.(interfaces.ResourceProperties)
+	assert.For(t).ThatActual(ok).IsTrue()
+
+	b = &ResourceBank{}
+	_, ok = b.(interfaces.ResourceBankProperties)
+	assert.For(t).ThatActual(ok).IsTrue()
+
+	const (
+		wood = iota
+		gold
+	)
+
+	bank := &ResourceBank{}
+	limiter := testBankLimiter{wood: 3}
+
+	player := &Resources{}
+
+	cost := Cost{wood: 2, gold: 5}
+
+	assert.For(t).ThatActual(player.CanAfford(cost)).IsFalse()
+	assert.For(t).ThatActual(player.Pay(cost, bank, limiter)).IsNotNil()
+	assert.For(t).ThatActual(player.ResourceCount(gold)).Equals(0)
+
+	//Gold isn't limited, so only the wood comes out of the bank.
+	assert.For(t).ThatActual(cost.Gain(player, bank, limiter)).IsNil()
+	assert.For(t).ThatActual(bank.BankResourceCount(limiter, wood)).Equals(1)
+	assert.For(t).ThatActual(cost.Available(bank, limiter)).IsFalse()
+	assert.For(t).ThatActual(cost.Gain(player, bank, limiter)).IsNotNil()
+	assert.For(t).ThatActual(player.ResourceCount(wood)).Equals(2)
+
+	assert.For(t).ThatActual(player.Pay(Cost{wood: 1, gold: 5}, bank, limiter)).IsNil()
+	assert.For(t).ThatActual(player.ResourceCount(wood)).Equals(1)
+	assert.For(t).ThatActual(player.ResourceCount(gold)).Equals(0)
+	assert.For(t).ThatActual(bank.BankResourceCount(limiter, wood)).Equals(2)
+	assert.For(t).ThatActual(bank.BankResourceCount(limiter, gold)).Equals(0)
+
+	//Setting a count doesn't change copies of the state that share the
+	//slice.
+	shared := player.ResourceCounts
+	player.SetResourceCount(wood, 7)
+	assert.For(t).ThatActual(shared[wood]).Equals(1)
+}
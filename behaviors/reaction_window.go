@@ -0,0 +1,92 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+ReactionWindow is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.ReactionProperties interface,
+making it easy to use moves.OpenReactionWindow, moves.React,
+moves.PassReaction and moves.ResolveReactions. Like RoundRobin, you typically
+embed this IN ADDITION TO base.SubState.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.ReactionWindow
+    }
+
+ReactionInProgress is true while a window is open. ReactionNextResponder is
+the player who may respond or pass next, ReactionLastToAct is the player who
+opened the window or most recently responded, and ReactionPassed counts the
+passes since then. ReactionStackedPlayers and ReactionStackedValues are the
+stack of the opening action and the responses to it, with the most recent
+last.
+*/
+type ReactionWindow struct {
+	ReactionInProgress     bool
+	ReactionNextResponder  boardgame.PlayerIndex
+	ReactionLastToAct      boardgame.PlayerIndex
+	ReactionPassed         int
+	ReactionStackedPlayers []boardgame.PlayerIndex
+	ReactionStackedValues  []int
+}
+
+//ReactionWindowOpen returns the value set via SetReactionWindowOpen.
+func (r *ReactionWindow) ReactionWindowOpen() bool {
+	return r.ReactionInProgress
+}
+
+//ReactionResponder returns the value set via SetReactionResponder.
+func (r *ReactionWindow) ReactionResponder() boardgame.PlayerIndex {
+	return r.ReactionNextResponder
+}
+
+//ReactionLastActor returns the value set via SetReactionLastActor.
+func (r *ReactionWindow) ReactionLastActor() boardgame.PlayerIndex {
+	return r.ReactionLastToAct
+}
+
+//ReactionPassCount returns the value set via SetReactionPassCount.
+func (r *ReactionWindow) ReactionPassCount() int {
+	return r.ReactionPassed
+}
+
+//ReactionStackPlayers returns the value set via SetReactionStack.
+func (r *ReactionWindow) ReactionStackPlayers() []boardgame.PlayerIndex {
+	return r.ReactionStackedPlayers
+}
+
+//ReactionStackValues returns the value set via SetReactionStack.
+func (r *ReactionWindow) ReactionStackValues() []int {
+	return r.ReactionStackedValues
+}
+
+//SetReactionWindowOpen sets the value to return for ReactionWindowOpen.
+func (r *ReactionWindow) SetReactionWindowOpen(open bool) {
+	r.ReactionInProgress = open
+}
+
+//SetReactionResponder sets the value to return for ReactionResponder.
+func (r *ReactionWindow) SetReactionResponder(player boardgame.PlayerIndex) {
+	r.ReactionNextResponder = player
+}
+
+//SetReactionLastActor sets the value to return for ReactionLastActor.
+func (r *ReactionWindow) SetReactionLastActor(player boardgame.PlayerIndex) {
+	r.ReactionLastToAct = player
+}
+
+//SetReactionPassCount sets the value to return for ReactionPassCount.
+func (r *ReactionWindow) SetReactionPassCount(count int) {
+	r.ReactionPassed = count
+}
+
+//SetReactionStack sets the values to return for ReactionStackPlayers and
+//ReactionStackValues.
+func (r *ReactionWindow) SetReactionStack(players []boardgame.PlayerIndex, values []int) {
+	r.ReactionStackedPlayers = players
+	r.ReactionStackedValues = values
+}
@@ -0,0 +1,239 @@
+package behaviors
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/jkomoros/boardgame/enum"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//ResourceEnumName is the name of the enum Resources, ResourceBank and Cost
+//expect to enumerate the types of resources in your game.
+const ResourceEnumName = "resource"
+
+/*
+Cost is an amount of each of several resources, keyed by the resource's value
+in your "resource" enum. It's what a move like moves.PayCost costs, or what
+moves.GainResources gains. Resources not in the map cost nothing.
+
+    //Example
+    behaviors.Cost{
+        resourceWood:  1,
+        resourceBrick: 1,
+    }
+*/
+type Cost map[int]int
+
+//resources returns the resources in the cost, in ascending order.
+func (c Cost) resources() []int {
+	result := make([]int, 0, len(c))
+	for resource := range c {
+		result = append(result, resource)
+	}
+	sort.Ints(result)
+	return result
+}
+
+//Valid returns an error if any resource in the cost isn't a value in the
+//given enum, or if any amount is negative.
+func (c Cost) Valid(resources enum.Enum) error {
+	if resources == nil {
+		return errors.New("There is no resource enum")
+	}
+	for _, resource := range c.resources() {
+		if !resources.Valid(resource) {
+			return errors.New(strconv.Itoa(resource) + " is not a valid resource")
+		}
+		if c[resource] < 0 {
+			return errors.New("The amount of " + resources.String(resource) + " is negative")
+		}
+	}
+	return nil
+}
+
+//CanAfford returns true if holder has at least as much of each resource as
+//the cost.
+func (c Cost) CanAfford(holder interfaces.ResourceProperties) bool {
+	for resource, amount := range c {
+		if holder.ResourceCount(resource) < amount {
+			return false
+		}
+	}
+	return true
+}
+
+//bankLimited returns whether limiter limits the bank's supply of resource.
+//Nothing is limited if either bank or limiter is nil.
+func bankLimited(bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter, resource int) bool {
+	return bank != nil && limiter != nil && limiter.BankResourceLimit(resource) > 0
+}
+
+//bankCount returns how much of resource is left in bank, given the limits
+//from limiter, or 0 if it isn't limited.
+func bankCount(bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter, resource int) int {
+	if !bankLimited(bank, limiter, resource) {
+		return 0
+	}
+	return limiter.BankResourceLimit(resource) - bank.BankResourceTaken(resource)
+}
+
+//Available returns true if bank has at least as much of each resource as the
+//cost. Resources limiter doesn't limit are always available, as is
+//everything if bank or limiter is nil.
+func (c Cost) Available(bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter) bool {
+	for resource, amount := range c {
+		if bankLimited(bank, limiter, resource) && bankCount(bank, limiter, resource) < amount {
+			return false
+		}
+	}
+	return true
+}
+
+//Pay removes the cost from holder, returning the resources limiter limits to
+//bank. bank and limiter may be nil. Returns an error, without changing
+//anything, if holder can't afford it.
+func (c Cost) Pay(holder interfaces.ResourceProperties, bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter) error {
+	if !c.CanAfford(holder) {
+		return errors.New("Not enough resources to pay the cost")
+	}
+	for _, resource := range c.resources() {
+		holder.SetResourceCount(resource, holder.ResourceCount(resource)-c[resource])
+		if bankLimited(bank, limiter, resource) {
+			bank.SetBankResourceTaken(resource, bank.BankResourceTaken(resource)-c[resource])
+		}
+	}
+	return nil
+}
+
+//Gain adds the cost to holder, taking the resources limiter limits from
+//bank. bank and limiter may be nil. Returns an error, without changing
+//anything, if the bank doesn't have enough.
+func (c Cost) Gain(holder interfaces.ResourceProperties, bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter) error {
+	if !c.Available(bank, limiter) {
+		return errors.New("The bank doesn't have enough resources")
+	}
+	for _, resource := range c.resources() {
+		holder.SetResourceCount(resource, holder.ResourceCount(resource)+c[resource])
+		if bankLimited(bank, limiter, resource) {
+			bank.SetBankResourceTaken(resource, bank.BankResourceTaken(resource)+c[resource])
+		}
+	}
+	return nil
+}
+
+/*
+Resources is designed to be embedded in your PlayerState anonymously to
+automatically satisfy the moves/interfaces.ResourceProperties interface,
+making it easy to use moves.GainResources, moves.PayCost and
+moves.ExchangeResources. Like RoundRobin, you typically embed this IN
+ADDITION TO base.SubState. It expects there to be an enum called 'resource'
+that enumerates the types of resources, with small non-negative values.
+
+    //Example
+    type playerState struct {
+        base.SubState
+        behaviors.Resources
+    }
+
+ResourceCounts is how much of each resource the player has, indexed by the
+resource's value.
+*/
+type Resources struct {
+	ResourceCounts []int
+}
+
+//ResourceCount returns the value set via SetResourceCount.
+func (r *Resources) ResourceCount(resource int) int {
+	if resource < 0 || resource >= len(r.ResourceCounts) {
+		return 0
+	}
+	return r.ResourceCounts[resource]
+}
+
+//SetResourceCount sets the value to return for ResourceCount.
+func (r *Resources) SetResourceCount(resource int, count int) {
+	if resource < 0 {
+		return
+	}
+	r.ResourceCounts = setInt(r.ResourceCounts, resource, count)
+}
+
+//CanAfford is a convenience for cost.CanAfford(r).
+func (r *Resources) CanAfford(cost Cost) bool {
+	return cost.CanAfford(r)
+}
+
+//Pay is a convenience for cost.Pay(r, bank, limiter). bank and limiter may
+//be nil.
+func (r *Resources) Pay(cost Cost, bank interfaces.ResourceBankProperties, limiter interfaces.ResourceBankLimiter) error {
+	return cost.Pay(r, bank, limiter)
+}
+
+/*
+ResourceBank is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.ResourceBankProperties interface.
+It's the shared supply of resources that moves.GainResources takes from and
+moves.PayCost pays into. Like Resources, it expects an enum called
+'resource'. How much of each resource the bank starts with doesn't change
+during the game, so your GameDelegate says so by implementing
+moves/interfaces.ResourceBankLimiter.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.ResourceBank
+    }
+
+    func (g *gameDelegate) BankResourceLimit(resource int) int {
+        switch resource {
+        case resourceWood, resourceBrick:
+            return 19
+        }
+        return 0
+    }
+
+Resources are unlimited unless the GameDelegate gives them a limit.
+BankTaken records how much of each limited resource has been taken from the
+bank and not yet paid back, indexed by the resource's value. Use
+BankResourceCount to get how much is left.
+*/
+type ResourceBank struct {
+	BankTaken []int
+}
+
+//BankResourceTaken returns the value set via SetBankResourceTaken.
+func (b *ResourceBank) BankResourceTaken(resource int) int {
+	if resource < 0 || resource >= len(b.BankTaken) {
+		return 0
+	}
+	return b.BankTaken[resource]
+}
+
+//SetBankResourceTaken sets the value to return for BankResourceTaken.
+func (b *ResourceBank) SetBankResourceTaken(resource int, count int) {
+	if resource < 0 {
+		return
+	}
+	b.BankTaken = setInt(b.BankTaken, resource, count)
+}
+
+//BankResourceCount returns how much of resource is left in the bank, given
+//the limits from limiter, typically your GameDelegate. Returns 0 for
+//resources limiter doesn't limit.
+func (b *ResourceBank) BankResourceCount(limiter interfaces.ResourceBankLimiter, resource int) int {
+	return bankCount(b, limiter, resource)
+}
+
+//setInt returns a copy of ints with index set to value, lengthened with
+//zeroes if necessary. It copies so that states that share the slice aren't
+//changed.
+func setInt(ints []int, index int, value int) []int {
+	result := append([]int{}, ints...)
+	for len(result) <= index {
+		result = append(result, 0)
+	}
+	result[index] = value
+	return result
+}
@@ -5,7 +5,8 @@ package behaviors
 //real player or not. When used in conjunction with moves.SeatPlayer, it allows
 //your game logic to detect when new players are seated (when they join the
 //game), as well as to control when that happens. Implements
-//moves/interfaces.Seater . See the package doc of this package for more.
+//moves/interfaces.Seater and moves/interfaces.Unseater. See the package doc of
+//this package for more.
 type Seat struct {
 	SeatFilled bool
 	SeatClosed bool
@@ -29,6 +30,13 @@ func (s *Seat) SetSeatFilled() {
 	s.SeatClosed = true
 }
 
+//SetSeatEmpty sets that the player who was sitting in the seat has left. The
+//seat is no longer filled, and is open for a new player to sit in it.
+func (s *Seat) SetSeatEmpty() {
+	s.SeatFilled = false
+	s.SeatClosed = false
+}
+
 //SetSeatClosed sets that the seat is closed and should not be filled by any
 //players, even if it is not filled. This tells the engine to not seat any more
 //players here.
@@ -0,0 +1,54 @@
+package behaviors
+
+/*
+SecretChoice is a struct designed to be embedded anonymously in your
+PlayerStates for games where every player secretly chooses at the same time,
+and then all of the choices are revealed at once. It satisfies the
+moves/interfaces.SecretChooser interface, making it easy to use
+moves.CommitAction and moves.RevealActions.
+
+SecretChoiceValue is the choice the player has committed to. It's sanitized
+so other players can't see it; they can only see SecretChoiceCommitted, which
+says whether the player has made their choice yet. Once every active player
+has committed, moves.RevealActions copies each choice to SecretChoiceRevealed,
+which everyone can see, and clears SecretChoiceCommitted so the next round of
+choices can begin.
+*/
+type SecretChoice struct {
+	SecretChoiceValue     int `sanitize:"hidden"`
+	SecretChoiceCommitted bool
+	SecretChoiceRevealed  int
+}
+
+//HasCommittedChoice returns whether the player has committed a choice that
+//hasn't been revealed yet. Satisfies interfaces.SecretChooser.
+func (s *SecretChoice) HasCommittedChoice() bool {
+	return s.SecretChoiceCommitted
+}
+
+//CommittedChoice returns the choice set via CommitChoice. Satisfies
+//interfaces.SecretChooser.
+func (s *SecretChoice) CommittedChoice() int {
+	return s.SecretChoiceValue
+}
+
+//RevealedChoice returns the choice made visible by the last RevealChoice.
+//Satisfies interfaces.SecretChooser.
+func (s *SecretChoice) RevealedChoice() int {
+	return s.SecretChoiceRevealed
+}
+
+//CommitChoice secretly records choice. Satisfies interfaces.SecretChooser.
+func (s *SecretChoice) CommitChoice(choice int) {
+	s.SecretChoiceValue = choice
+	s.SecretChoiceCommitted = true
+}
+
+//RevealChoice makes the committed choice visible to everyone as
+//RevealedChoice, and readies the player to commit their next choice.
+//Satisfies interfaces.SecretChooser.
+func (s *SecretChoice) RevealChoice() {
+	s.SecretChoiceRevealed = s.SecretChoiceValue
+	s.SecretChoiceValue = 0
+	s.SecretChoiceCommitted = false
+}
@@ -0,0 +1,96 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+PendingTrade is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.TradeProperties interface, making it
+easy to use moves.OfferTrade, moves.AcceptTrade, moves.DeclineTrade and
+moves.CancelTrade. Like RoundRobin, you typically embed this IN ADDITION TO
+base.SubState.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.PendingTrade
+    }
+
+While TradeOpen is true, TradeProposer has offered a trade to TradeRecipient.
+TradeOfferedComponents are indexes into the proposer's stack of the components
+they'll give, and TradeRequestedComponents are indexes into the recipient's
+stack of the components they'll get in return. TradeOfferedResources and
+TradeRequestedResources are counts of each resource, in the order the
+resources were configured with moves.WithTradeResources.
+*/
+type PendingTrade struct {
+	TradeOpen                bool
+	TradeProposer            boardgame.PlayerIndex
+	TradeRecipient           boardgame.PlayerIndex
+	TradeOfferedComponents   []int
+	TradeRequestedComponents []int
+	TradeOfferedResources    []int
+	TradeRequestedResources  []int
+}
+
+//TradePending returns true between OpenTrade and CloseTrade.
+func (p *PendingTrade) TradePending() bool {
+	return p.TradeOpen
+}
+
+//TradeProposingPlayer returns the proposer passed to OpenTrade.
+func (p *PendingTrade) TradeProposingPlayer() boardgame.PlayerIndex {
+	return p.TradeProposer
+}
+
+//TradeReceivingPlayer returns the recipient passed to OpenTrade.
+func (p *PendingTrade) TradeReceivingPlayer() boardgame.PlayerIndex {
+	return p.TradeRecipient
+}
+
+//TradeOfferedComponentIndexes returns the offered components passed to
+//OpenTrade.
+func (p *PendingTrade) TradeOfferedComponentIndexes() []int {
+	return p.TradeOfferedComponents
+}
+
+//TradeRequestedComponentIndexes returns the requested components passed to
+//OpenTrade.
+func (p *PendingTrade) TradeRequestedComponentIndexes() []int {
+	return p.TradeRequestedComponents
+}
+
+//TradeOfferedResourceCounts returns the offered resources passed to
+//OpenTrade.
+func (p *PendingTrade) TradeOfferedResourceCounts() []int {
+	return p.TradeOfferedResources
+}
+
+//TradeRequestedResourceCounts returns the requested resources passed to
+//OpenTrade.
+func (p *PendingTrade) TradeRequestedResourceCounts() []int {
+	return p.TradeRequestedResources
+}
+
+//OpenTrade records a trade offer from proposer to recipient.
+func (p *PendingTrade) OpenTrade(proposer, recipient boardgame.PlayerIndex, offeredComponents, requestedComponents, offeredResources, requestedResources []int) {
+	p.TradeOpen = true
+	p.TradeProposer = proposer
+	p.TradeRecipient = recipient
+	p.TradeOfferedComponents = offeredComponents
+	p.TradeRequestedComponents = requestedComponents
+	p.TradeOfferedResources = offeredResources
+	p.TradeRequestedResources = requestedResources
+}
+
+//CloseTrade clears the pending trade, whether it was accepted, declined or
+//cancelled.
+func (p *PendingTrade) CloseTrade() {
+	p.TradeOpen = false
+	p.TradeOfferedComponents = nil
+	p.TradeRequestedComponents = nil
+	p.TradeOfferedResources = nil
+	p.TradeRequestedResources = nil
+}
@@ -0,0 +1,60 @@
+package behaviors
+
+import (
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+Trick is designed to be embedded in your GameState anonymously to
+automatically satisfy the moves/interfaces.TrickProperties interface, making it
+easy to use moves.PlayCardToTrick and moves.ResolveTrick. Like RoundRobin, you
+typically embed this IN ADDITION TO base.SubState.
+
+    //Example
+    type gameState struct {
+        base.SubState
+        behaviors.CurrentPlayerBehavior
+        behaviors.Trick
+        TrickStack boardgame.Stack `stack:"cards"`
+    }
+
+TrickLeader is the player who led the current trick, or who will lead the next
+one once the last trick has been resolved. TrickSuit is the suit of the card
+that was led. TrickPlayedBy records, in order, the player who played each card
+currently in the trick.
+*/
+type Trick struct {
+	TrickLeader   boardgame.PlayerIndex
+	TrickSuit     int
+	TrickPlayedBy []boardgame.PlayerIndex
+}
+
+//TrickLeadPlayer returns the value set via SetTrickLeadPlayer.
+func (t *Trick) TrickLeadPlayer() boardgame.PlayerIndex {
+	return t.TrickLeader
+}
+
+//TrickLedSuit returns the value set via SetTrickLedSuit.
+func (t *Trick) TrickLedSuit() int {
+	return t.TrickSuit
+}
+
+//TrickCardPlayers returns the value set via SetTrickCardPlayers.
+func (t *Trick) TrickCardPlayers() []boardgame.PlayerIndex {
+	return t.TrickPlayedBy
+}
+
+//SetTrickLeadPlayer sets the value to return for TrickLeadPlayer.
+func (t *Trick) SetTrickLeadPlayer(player boardgame.PlayerIndex) {
+	t.TrickLeader = player
+}
+
+//SetTrickLedSuit sets the value to return for TrickLedSuit.
+func (t *Trick) SetTrickLedSuit(suit int) {
+	t.TrickSuit = suit
+}
+
+//SetTrickCardPlayers sets the value to return for TrickCardPlayers.
+func (t *Trick) SetTrickCardPlayers(players []boardgame.PlayerIndex) {
+	t.TrickPlayedBy = players
+}
@@ -99,6 +99,22 @@ type ModeCommon struct {
 	//The host name the client should connect to in that mode. Something like
 	//"http://localhost:8888"
 	APIHost string `json:"apiHost,omitempty"`
+	//How to notify users that it's their turn. If nil, no notifications are
+	//sent.
+	Notifications *NotificationsConfig `json:"notifications,omitempty"`
+	//Outbound webhooks to deliver game lifecycle events to, keyed by game
+	//type.
+	Webhooks WebhooksConfig `json:"webhooks,omitempty"`
+	//How to throttle clients. If nil, no rate limits are enforced.
+	RateLimit *RateLimitConfig `json:"rateLimit,omitempty"`
+	//What to do about players who stop taking their turns, keyed by game
+	//type.
+	Inactivity InactivityConfig `json:"inactivity,omitempty"`
+	//Where to expose Prometheus metrics. If nil, they aren't exposed.
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+	//Where to serve the gRPC service, if the server has one. If nil, it
+	//isn't served.
+	GRPC *GRPCConfig `json:"grpc,omitempty"`
 }
 
 //FieldFromString returns a ModeField by doing fuzzing matching.
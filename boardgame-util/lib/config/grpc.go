@@ -0,0 +1,34 @@
+package config
+
+//GRPCConfig is a sub-struct within ConfigMode that configures the gRPC
+//service in server/grpc. If it's nil, the service isn't served.
+type GRPCConfig struct {
+	//Port is the port the service listens on. Required.
+	Port string `json:"port,omitempty"`
+}
+
+func (g *GRPCConfig) copy() *GRPCConfig {
+	if g == nil {
+		return nil
+	}
+	result := &GRPCConfig{}
+	(*result) = *g
+	return result
+}
+
+func (g *GRPCConfig) extend(other *GRPCConfig) *GRPCConfig {
+	if g == nil {
+		return other.copy()
+	}
+	result := g.copy()
+
+	if other == nil {
+		return result
+	}
+
+	if other.Port != "" {
+		result.Port = other.Port
+	}
+
+	return result
+}
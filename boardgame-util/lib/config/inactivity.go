@@ -0,0 +1,59 @@
+package config
+
+//InactivityConfig is a sub-struct within ConfigMode that configures what the
+//server does about players who stop taking their turns. The keys are game
+//type names and the values are the policy for games of that type. Game types
+//that aren't listed never time out.
+type InactivityConfig map[string]*InactivityPolicyConfig
+
+//InactivityPolicyConfig is the inactivity policy for a single game type.
+type InactivityPolicyConfig struct {
+	//WarnAfterMinutes is how long the current player may go without moving
+	//before they're sent a warning notification. If 0, no warning is sent.
+	WarnAfterMinutes int `json:"warnAfterMinutes,omitempty"`
+	//DeadlineMinutes is how long the current player may go without moving
+	//before Action is taken.
+	DeadlineMinutes int `json:"deadlineMinutes"`
+	//Action is one of "forfeit", "inactivate", or "agent".
+	Action string `json:"action"`
+	//ForfeitMove is the name of the move to propose for the "forfeit" action.
+	ForfeitMove string `json:"forfeitMove,omitempty"`
+	//Agent is the name of the agent that takes over the seat for the "agent"
+	//action.
+	Agent string `json:"agent,omitempty"`
+}
+
+func (i *InactivityPolicyConfig) copy() *InactivityPolicyConfig {
+	if i == nil {
+		return nil
+	}
+	result := &InactivityPolicyConfig{}
+	(*result) = *i
+	return result
+}
+
+func (i InactivityConfig) copy() InactivityConfig {
+	if i == nil {
+		return nil
+	}
+	result := make(InactivityConfig, len(i))
+	for gameName, policy := range i {
+		result[gameName] = policy.copy()
+	}
+	return result
+}
+
+//extend returns a copy of i where each game type configured in other replaces
+//the policy for that game type in i.
+func (i InactivityConfig) extend(other InactivityConfig) InactivityConfig {
+	if i == nil {
+		return other.copy()
+	}
+	result := i.copy()
+
+	for gameName, policy := range other.copy() {
+		result[gameName] = policy
+	}
+
+	return result
+}
@@ -36,6 +36,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 				},
@@ -62,6 +68,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 					nil,
@@ -104,6 +116,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 				},
@@ -124,6 +142,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 				},
@@ -152,6 +176,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 					nil,
@@ -176,6 +206,12 @@ func TestBaseExtend(t *testing.T) {
 						"gastring",
 						nil,
 						"https://localhost",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 					nil,
 					nil,
@@ -0,0 +1,41 @@
+package config
+
+//MetricsConfig is a sub-struct within ConfigMode that configures the
+//Prometheus metrics endpoint. If it's nil, metrics aren't exposed.
+type MetricsConfig struct {
+	//Path is the path metrics are served on. Defaults to "/metrics".
+	Path string `json:"path,omitempty"`
+	//Port, if set, serves metrics on their own port instead of alongside the
+	//API, so they can be kept off the public internet.
+	Port string `json:"port,omitempty"`
+}
+
+func (m *MetricsConfig) copy() *MetricsConfig {
+	if m == nil {
+		return nil
+	}
+	result := &MetricsConfig{}
+	(*result) = *m
+	return result
+}
+
+func (m *MetricsConfig) extend(other *MetricsConfig) *MetricsConfig {
+	if m == nil {
+		return other.copy()
+	}
+	result := m.copy()
+
+	if other == nil {
+		return result
+	}
+
+	if other.Path != "" {
+		result.Path = other.Path
+	}
+
+	if other.Port != "" {
+		result.Port = other.Port
+	}
+
+	return result
+}
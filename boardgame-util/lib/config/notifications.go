@@ -0,0 +1,75 @@
+package config
+
+//NotificationsConfig is a sub-struct within ConfigMode that configures how the
+//server tells users that it's their turn in a game they aren't currently
+//watching.
+type NotificationsConfig struct {
+	//WebHost is the scheme and host the web client is served from, e.g.
+	//"https://www.mygame.com". Used to construct links to games. If "", no
+	//links will be included in notifications.
+	WebHost string `json:"webHost,omitempty"`
+	//SMTPAddr is the "host:port" of the SMTP server to send email through.
+	//If "", email notifications are disabled.
+	SMTPAddr     string `json:"smtpAddr,omitempty"`
+	SMTPFrom     string `json:"smtpFrom,omitempty"`
+	SMTPUsername string `json:"smtpUsername,omitempty"`
+	SMTPPassword string `json:"smtpPassword,omitempty"`
+	//Webhooks is whether users may have notifications POSTed to a URL of
+	//their choosing.
+	Webhooks bool `json:"webhooks,omitempty"`
+	//WebhookAllowHosts are hosts webhooks may be POSTed to even though they
+	//are loopback, private or link-local addresses, which are otherwise
+	//refused.
+	WebhookAllowHosts []string `json:"webhookAllowHosts,omitempty"`
+}
+
+func (n *NotificationsConfig) copy() *NotificationsConfig {
+	if n == nil {
+		return nil
+	}
+	result := &NotificationsConfig{}
+	(*result) = *n
+	result.WebhookAllowHosts = append([]string(nil), n.WebhookAllowHosts...)
+	return result
+}
+
+func (n *NotificationsConfig) extend(other *NotificationsConfig) *NotificationsConfig {
+	if n == nil {
+		return other.copy()
+	}
+	result := n.copy()
+
+	if other == nil {
+		return result
+	}
+
+	if other.WebHost != "" {
+		result.WebHost = other.WebHost
+	}
+
+	if other.SMTPAddr != "" {
+		result.SMTPAddr = other.SMTPAddr
+	}
+
+	if other.SMTPFrom != "" {
+		result.SMTPFrom = other.SMTPFrom
+	}
+
+	if other.SMTPUsername != "" {
+		result.SMTPUsername = other.SMTPUsername
+	}
+
+	if other.SMTPPassword != "" {
+		result.SMTPPassword = other.SMTPPassword
+	}
+
+	if other.Webhooks {
+		result.Webhooks = true
+	}
+
+	if len(other.WebhookAllowHosts) > 0 {
+		result.WebhookAllowHosts = append([]string(nil), other.WebhookAllowHosts...)
+	}
+
+	return result
+}
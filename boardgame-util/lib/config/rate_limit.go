@@ -0,0 +1,64 @@
+package config
+
+//RateLimitConfig is a sub-struct within ConfigMode that configures how the
+//server throttles clients. Any limit that is 0 is not enforced.
+type RateLimitConfig struct {
+	//RequestsPerMinute is how many API requests a single IP address may make
+	//per minute.
+	RequestsPerMinute int `json:"requestsPerMinute,omitempty"`
+	//UserRequestsPerMinute is how many API requests a single signed-in user
+	//may make per minute, across all of their IP addresses.
+	UserRequestsPerMinute int `json:"userRequestsPerMinute,omitempty"`
+	//MaxSocketsPerUser is how many sockets a single signed-in user may have
+	//open at once.
+	MaxSocketsPerUser int `json:"maxSocketsPerUser,omitempty"`
+	//GamesPerHour is how many games a single user may create per hour.
+	GamesPerHour int `json:"gamesPerHour,omitempty"`
+	//BehindProxy should be true if the server is only reachable through a
+	//reverse proxy that sets X-Forwarded-For or X-Real-Ip. Otherwise those
+	//headers are ignored and clients are limited by the address they connect
+	//from, since any client could set them to get around RequestsPerMinute.
+	BehindProxy bool `json:"behindProxy,omitempty"`
+}
+
+func (r *RateLimitConfig) copy() *RateLimitConfig {
+	if r == nil {
+		return nil
+	}
+	result := &RateLimitConfig{}
+	(*result) = *r
+	return result
+}
+
+func (r *RateLimitConfig) extend(other *RateLimitConfig) *RateLimitConfig {
+	if r == nil {
+		return other.copy()
+	}
+	result := r.copy()
+
+	if other == nil {
+		return result
+	}
+
+	if other.RequestsPerMinute != 0 {
+		result.RequestsPerMinute = other.RequestsPerMinute
+	}
+
+	if other.UserRequestsPerMinute != 0 {
+		result.UserRequestsPerMinute = other.UserRequestsPerMinute
+	}
+
+	if other.MaxSocketsPerUser != 0 {
+		result.MaxSocketsPerUser = other.MaxSocketsPerUser
+	}
+
+	if other.GamesPerHour != 0 {
+		result.GamesPerHour = other.GamesPerHour
+	}
+
+	if other.BehindProxy {
+		result.BehindProxy = true
+	}
+
+	return result
+}
@@ -119,6 +119,12 @@ func (c *RawConfigMode) Copy() *RawConfigMode {
 
 	result.Games = result.Games.copy()
 	result.Firebase = result.Firebase.copy()
+	result.Notifications = result.Notifications.copy()
+	result.Webhooks = result.Webhooks.copy()
+	result.RateLimit = result.RateLimit.copy()
+	result.Inactivity = result.Inactivity.copy()
+	result.Metrics = result.Metrics.copy()
+	result.GRPC = result.GRPC.copy()
 
 	return result
 
@@ -213,6 +219,17 @@ func (c *RawConfigMode) Extend(other *RawConfigMode) *RawConfigMode {
 
 	result.Firebase = result.Firebase.extend(other.Firebase)
 
+	result.Notifications = result.Notifications.extend(other.Notifications)
+
+	result.Webhooks = result.Webhooks.extend(other.Webhooks)
+
+	result.RateLimit = result.RateLimit.extend(other.RateLimit)
+
+	result.Inactivity = result.Inactivity.extend(other.Inactivity)
+
+	result.Metrics = result.Metrics.extend(other.Metrics)
+	result.GRPC = result.GRPC.extend(other.GRPC)
+
 	return result
 
 }
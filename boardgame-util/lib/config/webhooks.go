@@ -0,0 +1,60 @@
+package config
+
+//WebhooksConfig is a sub-struct within ConfigMode that configures outbound
+//webhooks for game lifecycle events. The keys are game type names (or "*" for
+//every game type) and the values are the endpoints that should receive events
+//for games of that type.
+type WebhooksConfig map[string][]*WebhookConfig
+
+//WebhookConfig is a single endpoint that receives game lifecycle events.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	//Secret is used to sign each payload with HMAC-SHA256. If "", payloads
+	//are not signed.
+	Secret string `json:"secret,omitempty"`
+	//Events is the list of event types to deliver, for example
+	//"game_created", "player_seated", "move_applied", or "game_finished". If
+	//empty, all events are delivered.
+	Events []string `json:"events,omitempty"`
+}
+
+func (w *WebhookConfig) copy() *WebhookConfig {
+	if w == nil {
+		return nil
+	}
+	result := &WebhookConfig{}
+	(*result) = *w
+	result.Events = make([]string, len(w.Events))
+	copy(result.Events, w.Events)
+	return result
+}
+
+func (w WebhooksConfig) copy() WebhooksConfig {
+	if w == nil {
+		return nil
+	}
+	result := make(WebhooksConfig, len(w))
+	for gameName, endpoints := range w {
+		copied := make([]*WebhookConfig, len(endpoints))
+		for i, endpoint := range endpoints {
+			copied[i] = endpoint.copy()
+		}
+		result[gameName] = copied
+	}
+	return result
+}
+
+//extend returns a copy of w where each game type configured in other replaces
+//the endpoints for that game type in w.
+func (w WebhooksConfig) extend(other WebhooksConfig) WebhooksConfig {
+	if w == nil {
+		return other.copy()
+	}
+	result := w.copy()
+
+	for gameName, endpoints := range other.copy() {
+		result[gameName] = endpoints
+	}
+
+	return result
+}
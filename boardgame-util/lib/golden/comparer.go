@@ -252,7 +252,8 @@ func (c *comparer) ApplyNextSpecialAdminMove() (bool, error) {
 	}
 
 	//The next move was applied by admin, but wasn't already applied.
-	//That means it's either a SeatPlayer move, or a timer that fired.
+	//That means it's either a SeatPlayer or UnseatPlayer move, or a timer that
+	//fired.
 
 	//First, check if the nextMoveRec is a type of move that is a Seat
 	//Player move.
@@ -276,6 +277,18 @@ func (c *comparer) ApplyNextSpecialAdminMove() (bool, error) {
 		return true, nil
 	}
 
+	if isUnseatPlayer, ok := move.(interfaces.UnseatPlayerMover); ok && isUnseatPlayer.IsUnseatPlayerMove() {
+		index, err := move.Reader().PlayerIndexProp("TargetPlayerIndex")
+		if err != nil {
+			return false, errors.New("Couldn't get expected TargetPlayerIndex from next UnseatPlayer: " + err.Error())
+		}
+		c.storage.injectPlayerToUnseat(index)
+		if err := <-c.manager.Internals().ForceFixUp(c.game); err != nil {
+			return false, errors.New("Couldn't force inject an UnseatPlayer move: " + err.Error())
+		}
+		return true, nil
+	}
+
 	//We could be waiting for a timer to fire.
 	//If there was a timer, try to force it to fire early.
 	if c.manager.Internals().ForceNextTimer() {
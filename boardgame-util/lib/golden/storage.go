@@ -12,11 +12,13 @@ import (
 //Note: these are also duplicated in moves/seat_player.go and server/api/storage.go
 const playerToSeatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToSeat"
 const willSeatPlayerRendevousDataType = "github.com/jkomoros/boardgame/server/api.WillSeatPlayer"
+const playerToUnseatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToUnseat"
 
 //by defining the variable type, we verify we actually do implement the
 //interface. Since it flows via FetchInejctedData, there's no type
 //checking otherwise.
 var testPlayerSeat interfaces.SeatPlayerSignaler = &player{}
+var testPlayerUnseat interfaces.UnseatPlayerSignaler = &unseatPlayer{}
 
 type player struct {
 	index boardgame.PlayerIndex
@@ -31,10 +33,24 @@ func (p *player) Committed() {
 	p.s.playerToSeat = nil
 }
 
+type unseatPlayer struct {
+	index boardgame.PlayerIndex
+	s     *storageManager
+}
+
+func (p *unseatPlayer) SeatIndex() boardgame.PlayerIndex {
+	return p.index
+}
+
+func (p *unseatPlayer) Committed() {
+	p.s.playerToUnseat = nil
+}
+
 type storageManager struct {
 	*filesystem.StorageManager
-	manager      *boardgame.GameManager
-	playerToSeat *player
+	manager        *boardgame.GameManager
+	playerToSeat   *player
+	playerToUnseat *unseatPlayer
 	//A cache of whether a given gameID will call seatPlayer.
 	memoizedGameWillSeatPlayer map[string]bool
 	gameRecords                map[string]*record.Record
@@ -87,6 +103,13 @@ func (s *storageManager) FetchInjectedDataForGame(gameID string, dataType string
 		}
 		return s.playerToSeat
 	}
+	if dataType == playerToUnseatRendevousDataType {
+		if s.playerToUnseat == nil {
+			//Return an untyped nil
+			return nil
+		}
+		return s.playerToUnseat
+	}
 	return s.StorageManager.FetchInjectedDataForGame(gameID, dataType)
 }
 
@@ -100,6 +123,16 @@ func (s *storageManager) injectPlayerToSeat(index boardgame.PlayerIndex) {
 	}
 }
 
+//injectPlayerToUnseat is how you make StorageManager tell the UnseatPlayer
+//move to unseat the player at the given index. You also need to call
+//ForceFixUp after caling this.
+func (s *storageManager) injectPlayerToUnseat(index boardgame.PlayerIndex) {
+	s.playerToUnseat = &unseatPlayer{
+		index,
+		s,
+	}
+}
+
 func newStorageManager() *storageManager {
 	fsStorage := filesystem.NewStorageManager("")
 	fsStorage.DebugNoDisk = true
@@ -107,6 +140,7 @@ func newStorageManager() *storageManager {
 		fsStorage,
 		nil,
 		nil,
+		nil,
 		make(map[string]bool),
 		make(map[string]*record.Record),
 	}
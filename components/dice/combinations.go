@@ -0,0 +1,117 @@
+package dice
+
+import (
+	"sort"
+
+	"github.com/jkomoros/boardgame"
+)
+
+//Values returns the Value of each die in the stack, in order. Empty slots and
+//components that aren't dice are skipped.
+func Values(stack boardgame.ImmutableStack) []int {
+	var result []int
+	for i := 0; i < stack.Len(); i++ {
+		c := stack.ImmutableComponentAt(i)
+		if c == nil {
+			continue
+		}
+		dynamic, ok := c.ImmutableDynamicValues().(*DynamicValue)
+		if !ok {
+			continue
+		}
+		result = append(result, dynamic.Value)
+	}
+	return result
+}
+
+//Counts returns how many times each value occurs in values.
+func Counts(values []int) map[int]int {
+	result := make(map[int]int)
+	for _, value := range values {
+		result[value]++
+	}
+	return result
+}
+
+//OfAKind returns the highest value that occurs at least n times in values,
+//and whether there was one. OfAKind(values, 3) checks for three of a kind,
+//and OfAKind(values, len(values)) for a Yahtzee.
+func OfAKind(values []int, n int) (int, bool) {
+	best := 0
+	found := false
+	for value, count := range Counts(values) {
+		if count < n {
+			continue
+		}
+		if !found || value > best {
+			best = value
+			found = true
+		}
+	}
+	return best, found
+}
+
+//FullHouse returns true if values is made up of exactly two distinct values,
+//one occurring three times and the other twice.
+func FullHouse(values []int) bool {
+	counts := Counts(values)
+	if len(counts) != 2 {
+		return false
+	}
+	for _, count := range counts {
+		if count != 2 && count != 3 {
+			return false
+		}
+	}
+	return len(values) == 5
+}
+
+//LongestStraight returns the length of the longest run of consecutive values
+//in values, in any order. Duplicates don't break or extend a run.
+func LongestStraight(values []int) int {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+
+	longest := 1
+	current := 1
+	for i := 1; i < len(sorted); i++ {
+		switch sorted[i] - sorted[i-1] {
+		case 0:
+			continue
+		case 1:
+			current++
+		default:
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+	}
+	return longest
+}
+
+//Straight returns true if values contains a run of at least length
+//consecutive values. Straight(values, 4) checks for a small straight in
+//Yahtzee, and Straight(values, 5) a large one.
+func Straight(values []int, length int) bool {
+	return LongestStraight(values) >= length
+}
+
+//Sum returns the total of values.
+func Sum(values []int) int {
+	result := 0
+	for _, value := range values {
+		result += value
+	}
+	return result
+}
+
+//SumOf returns the total of just the values that equal face, like the upper
+//section of a Yahtzee score card.
+func SumOf(values []int, face int) int {
+	return Counts(values)[face] * face
+}
@@ -3,14 +3,25 @@
 Package dice is a simple package that defines die components with variable
 numbers of sides.
 
+Faces are ints. For dice whose faces are symbols rather than numbers, create
+them with SymbolDie, passing an enum whose values are the symbols, and use
+DynamicValue.Symbol to get the name of the rolled face.
+
+For games that roll a pool of dice at once, like Yahtzee or King of Tokyo,
+put the dice in a stack and use moves.RollDice, moves.RerollSelected and
+moves.LockDie. Values returns the rolled values of a stack of dice, and
+OfAKind, Straight and Sum evaluate common combinations of them.
+
 */
 package dice
 
 import (
 	"math"
 	"math/rand"
+	"sort"
 
 	"github.com/jkomoros/boardgame/base"
+	"github.com/jkomoros/boardgame/enum"
 )
 
 //go:generate boardgame-util codegen
@@ -21,7 +32,8 @@ import (
 //boardgame:codegen
 type Value struct {
 	base.ComponentValues
-	Faces []int
+	Faces   []int
+	symbols enum.Enum
 }
 
 //DynamicValue encodes which face is currently selected.
@@ -55,6 +67,40 @@ func BasicDie(min, max int) *Value {
 	}
 }
 
+//SymbolDie returns a die whose faces are values in the given enum, for dice
+//with symbols instead of numbers on their faces. faces may repeat a value to
+//put a symbol on more than one face. If no faces are provided, the die has
+//one face for each value in the enum, in ascending order. Returns nil if any
+//face isn't a valid value in the enum.
+func SymbolDie(symbols enum.Enum, faces ...int) *Value {
+	if symbols == nil {
+		return nil
+	}
+
+	if len(faces) == 0 {
+		//Values isn't in any particular order.
+		faces = symbols.Values()
+		sort.Ints(faces)
+	}
+
+	for _, face := range faces {
+		if !symbols.Valid(face) {
+			return nil
+		}
+	}
+
+	return &Value{
+		Faces:   append([]int{}, faces...),
+		symbols: symbols,
+	}
+}
+
+//Symbols returns the enum the die was created with via SymbolDie, or nil if
+//it wasn't.
+func (v *Value) Symbols() enum.Enum {
+	return v.symbols
+}
+
 //Min returns the lowest value face for this die
 func (v *Value) Min() int {
 	min := math.MaxInt64
@@ -105,3 +151,19 @@ func (d *DynamicValue) Roll(r *rand.Rand) {
 	d.Value = values.Faces[val]
 
 }
+
+//Symbol returns the name of the face currently selected, for dice created
+//with SymbolDie. Returns "" for other dice.
+func (d *DynamicValue) Symbol() string {
+	if d.ContainingComponent() == nil {
+		return ""
+	}
+
+	values, ok := d.ContainingComponent().Values().(*Value)
+
+	if !ok || values.symbols == nil {
+		return ""
+	}
+
+	return values.symbols.String(d.Value)
+}
@@ -2,6 +2,7 @@ package dice
 
 import (
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/enum"
 	"github.com/workfit/tester/assert"
 	"testing"
 )
@@ -88,3 +89,114 @@ func TestDieRoll(t *testing.T) {
 	}
 
 }
+
+func TestSymbolDie(t *testing.T) {
+
+	const (
+		symbolClaw = iota
+		symbolHeart
+		symbolEnergy
+	)
+
+	enums := enum.NewSet()
+
+	symbols := enums.MustAdd("Symbol", map[int]string{
+		symbolClaw:   "Claw",
+		symbolHeart:  "Heart",
+		symbolEnergy: "Energy",
+	})
+
+	var nilDie *Value
+
+	assert.For(t).ThatActual(SymbolDie(symbols, symbolClaw, 5)).Equals(nilDie)
+
+	all := SymbolDie(symbols)
+	assert.For(t).ThatActual(all.Faces).Equals([]int{symbolClaw, symbolHeart, symbolEnergy})
+
+	values := SymbolDie(symbols, symbolClaw, symbolClaw, symbolHeart)
+	assert.For(t).ThatActual(values.Symbols()).Equals(symbols)
+	assert.For(t).ThatActual(DefaultDie().Symbols()).IsNil()
+
+	deck := boardgame.NewDeck()
+	deck.AddComponent(values)
+
+	dynamic := &DynamicValue{}
+	dynamic.SetContainingComponent(deck.ComponentAt(0))
+
+	for i := 0; i < 10; i++ {
+		dynamic.Roll(nil)
+		assert.For(t, i).ThatActual(dynamic.Value == symbolClaw || dynamic.Value == symbolHeart).IsTrue()
+		assert.For(t, i).ThatActual(dynamic.Symbol()).Equals(symbols.String(dynamic.Value))
+	}
+}
+
+func TestCombinations(t *testing.T) {
+
+	tests := []struct {
+		values       []int
+		ofAKind      int
+		ofAKindValue int
+		fullHouse    bool
+		straight     int
+		sum          int
+	}{
+		{
+			[]int{3, 3, 5, 3, 5},
+			3,
+			3,
+			true,
+			1,
+			19,
+		},
+		{
+			[]int{2, 4, 3, 1, 4},
+			2,
+			4,
+			false,
+			4,
+			14,
+		},
+		{
+			[]int{6, 6, 6, 6, 6},
+			5,
+			6,
+			false,
+			1,
+			30,
+		},
+		{
+			[]int{5, 2, 6, 3, 4},
+			1,
+			6,
+			false,
+			5,
+			20,
+		},
+		{
+			nil,
+			0,
+			0,
+			false,
+			0,
+			0,
+		},
+	}
+
+	for i, test := range tests {
+		if test.ofAKind > 0 {
+			value, ok := OfAKind(test.values, test.ofAKind)
+			assert.For(t, i).ThatActual(ok).IsTrue()
+			assert.For(t, i).ThatActual(value).Equals(test.ofAKindValue)
+		}
+		_, ok := OfAKind(test.values, test.ofAKind+1)
+		assert.For(t, i).ThatActual(ok).IsFalse()
+		assert.For(t, i).ThatActual(FullHouse(test.values)).Equals(test.fullHouse)
+		assert.For(t, i).ThatActual(LongestStraight(test.values)).Equals(test.straight)
+		assert.For(t, i).ThatActual(Straight(test.values, test.straight)).IsTrue()
+		assert.For(t, i).ThatActual(Straight(test.values, test.straight+1)).IsFalse()
+		assert.For(t, i).ThatActual(Sum(test.values)).Equals(test.sum)
+	}
+
+	assert.For(t).ThatActual(SumOf([]int{3, 3, 5, 3, 5}, 3)).Equals(9)
+	assert.For(t).ThatActual(SumOf([]int{3, 3, 5, 3, 5}, 2)).Equals(0)
+}
@@ -64,6 +64,25 @@ func (c *Card) String() string {
 	return fmt.Sprintf("%s %s", c.Suit.String(), c.Rank.String())
 }
 
+//TrickSuit returns the card's Suit, satisfying moves/interfaces.TrickCard so
+//Cards can be used with moves.PlayCardToTrick and moves.ResolveTrick.
+func (c *Card) TrickSuit() int {
+	return c.Suit.Value()
+}
+
+//TrickRank returns the card's Rank, except that Aces are high, as they are in
+//most trick-taking games. Jokers rank above Aces. Satisfies
+//moves/interfaces.TrickCard.
+func (c *Card) TrickRank() int {
+	switch c.Rank.Value() {
+	case RankAce:
+		return RankKing + 1
+	case RankJoker:
+		return RankKing + 2
+	}
+	return c.Rank.Value()
+}
+
 //NewDeckMulti is like NewDeck, but returns count normal decks together, in
 //canonical order. Useful for e.g. casino games where there might be four
 //decks shuffled together for the draw stack.
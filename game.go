@@ -1,6 +1,7 @@
 package boardgame
 
 import (
+	"context"
 	"encoding/json"
 	"math/rand"
 	"strconv"
@@ -59,6 +60,16 @@ type Game struct {
 	proposedMoves chan *proposedMoveItem
 	//How a game can be signaled to trigger a pass of fixups
 	fixUpTriggered chan DelayedError
+	//Where requests to change which agent plays a seat go.
+	agentChanges chan *agentChangeItem
+	//Where requests to end the game early go.
+	forcedFinishes chan *forcedFinishItem
+	//Where agents send what they came up with.
+	agentResults chan *agentResult
+
+	//cancelAgents cancels the agents that are still thinking about the
+	//current version. Only used by mainLoop.
+	cancelAgents context.CancelFunc
 
 	//if true, we will not wait to propose agent moves (mainly used for
 	//testing.)
@@ -91,6 +102,30 @@ type proposedMoveItem struct {
 	ch DelayedError
 }
 
+type agentChangeItem struct {
+	player    PlayerIndex
+	agentName string
+	ch        DelayedError
+}
+
+type forcedFinishItem struct {
+	winners []PlayerIndex
+	ch      DelayedError
+}
+
+//agentResult is what an agent proposed when asked about a given version of
+//the game.
+type agentResult struct {
+	player    PlayerIndex
+	agentName string
+	version   int
+	move      Move
+	newState  []byte
+	//timedOut is true if the agent ran out of think time, in which case
+	//move and newState are nil.
+	timedOut bool
+}
+
 var defaultStringRand *rand.Rand
 
 func init() {
@@ -209,6 +244,43 @@ func (g *Game) JSONForPlayer(player PlayerIndex, state ImmutableState) (interfac
 	}, nil
 }
 
+//MoveRecordForPlayer returns record as it should be shown to player, the
+//move equivalent of JSONForPlayer. If the move the record is for implements
+//SecretMove, the result is a copy of record with HideSecrets applied to its
+//Blob. Otherwise, or if player is AdminPlayerIndex, record is returned as
+//is.
+func (g *Game) MoveRecordForPlayer(player PlayerIndex, record *MoveStorageRecord) (*MoveStorageRecord, error) {
+
+	if record == nil || player == AdminPlayerIndex {
+		return record, nil
+	}
+
+	move, err := record.inflate(g)
+
+	if err != nil {
+		return nil, errors.New("Couldn't inflate move: " + err.Error())
+	}
+
+	secretMove, ok := move.(SecretMove)
+
+	if !ok {
+		return record, nil
+	}
+
+	secretMove.HideSecrets(player)
+
+	blob, err := json.MarshalIndent(move, "", "\t")
+
+	if err != nil {
+		return nil, errors.New("Couldn't marshal move: " + err.Error())
+	}
+
+	result := *record
+	result.Blob = blob
+
+	return &result, nil
+}
+
 //MarshalJSON returns a marshaled version of the output of JSONForPlayer for
 //AdminPlayerIndex.
 func (g *Game) MarshalJSON() ([]byte, error) {
@@ -574,6 +646,186 @@ func (g *Game) triggerFixUp() DelayedError {
 	return delayed
 }
 
+//triggerAgentChange signals that we want the given player to be played by the
+//agent with the given name (or by no agent, if agentName is "") from now on.
+func (g *Game) triggerAgentChange(player PlayerIndex, agentName string) DelayedError {
+
+	errChan := make(DelayedError, 1)
+
+	game := g
+
+	if !g.modifiable {
+		game = g.manager.ModifiableGame(g.ID())
+	}
+
+	if game == nil || !game.initalized {
+		errChan <- errors.New("There was no set-up game with that ID")
+		return errChan
+	}
+
+	item := &agentChangeItem{
+		player:    player,
+		agentName: agentName,
+		ch:        errChan,
+	}
+
+	if game == g {
+		g.agentChanges <- item
+		return errChan
+	}
+
+	//We're not the modifiable copy, so once the change is made update
+	//ourselves to reflect it.
+	finalErrChan := make(DelayedError, 1)
+
+	go func() {
+		result := <-errChan
+		g.Refresh()
+		finalErrChan <- result
+	}()
+
+	game.agentChanges <- item
+
+	return finalErrChan
+}
+
+//applyAgentChange swaps out which agent is playing the given seat. Like
+//applyMove, it may only be called by mainLoop.
+func (g *Game) applyAgentChange(player PlayerIndex, agentName string) error {
+
+	baseErr := errors.NewFriendly("The seat could not be handed over")
+
+	if g.finished {
+		return errors.NewFriendly("Game was already finished")
+	}
+
+	if player < 0 || int(player) >= g.NumPlayers() {
+		return baseErr.WithError("Invalid player index: " + player.String())
+	}
+
+	var agent Agent
+
+	if agentName != "" {
+		agent = g.manager.AgentByName(agentName)
+		if agent == nil {
+			return baseErr.WithError("Couldn't find an agent named " + agentName)
+		}
+	}
+
+	previousAgents := g.agents
+
+	agents := make([]string, g.NumPlayers())
+	copy(agents, g.agents)
+	agents[player] = agentName
+
+	g.agents = agents
+
+	if agent != nil {
+		if agentState := agent.SetUpForGame(g, player); agentState != nil {
+			if err := g.manager.Storage().SaveAgentState(g.ID(), player, agentState); err != nil {
+				g.agents = previousAgents
+				return baseErr.WithError("Couldn't save state for agent: " + err.Error())
+			}
+		}
+	}
+
+	if err := g.updateStorageRecord(); err != nil {
+		g.agents = previousAgents
+		return baseErr.WithError("Storage returned an error: " + err.Error())
+	}
+
+	if agent != nil {
+		//It might be the new agent's turn right now.
+		if err := g.triggerAgents(); err != nil {
+			return baseErr.WithError("Failed to trigger agent: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+//updateStorageRecord saves the game's StorageRecord without a new state, if
+//the StorageManager is a GameUpdater.
+func (g *Game) updateStorageRecord() error {
+	updater, ok := g.manager.Storage().(GameUpdater)
+	if !ok {
+		return errors.New("The StorageManager doesn't support updating games")
+	}
+	return updater.UpdateGame(g.StorageRecord())
+}
+
+//triggerForcedFinish signals that we want the game to be finished right now,
+//with the given winners.
+func (g *Game) triggerForcedFinish(winners []PlayerIndex) DelayedError {
+
+	errChan := make(DelayedError, 1)
+
+	game := g
+
+	if !g.modifiable {
+		game = g.manager.ModifiableGame(g.ID())
+	}
+
+	if game == nil || !game.initalized {
+		errChan <- errors.New("There was no set-up game with that ID")
+		return errChan
+	}
+
+	item := &forcedFinishItem{
+		winners: winners,
+		ch:      errChan,
+	}
+
+	if game == g {
+		g.forcedFinishes <- item
+		return errChan
+	}
+
+	//We're not the modifiable copy, so once the change is made update
+	//ourselves to reflect it.
+	finalErrChan := make(DelayedError, 1)
+
+	go func() {
+		result := <-errChan
+		g.Refresh()
+		finalErrChan <- result
+	}()
+
+	game.forcedFinishes <- item
+
+	return finalErrChan
+}
+
+//applyForcedFinish marks the game as finished with the given winners without
+//applying a move. Like applyMove, it may only be called by mainLoop.
+func (g *Game) applyForcedFinish(winners []PlayerIndex) error {
+
+	baseErr := errors.NewFriendly("The game could not be finished")
+
+	if g.finished {
+		return errors.NewFriendly("Game was already finished")
+	}
+
+	for _, winner := range winners {
+		if winner < 0 || int(winner) >= g.NumPlayers() {
+			return baseErr.WithError("Invalid player index: " + winner.String())
+		}
+	}
+
+	g.finished = true
+	g.winners = winners
+
+	if err := g.updateStorageRecord(); err != nil {
+		g.finished = false
+		g.winners = nil
+		return baseErr.WithError("Storage returned an error: " + err.Error())
+	}
+
+	g.stopAgents()
+
+	return nil
+}
+
 //MainLoop should be run in a goroutine. It is what takes moves off of
 //proposedMoves and applies them. It is the only method that may call
 //applyMove.
@@ -582,10 +834,21 @@ func (g *Game) mainLoop() {
 		select {
 		case item := <-g.proposedMoves:
 			if item == nil {
+				g.stopAgents()
 				return
 			}
-			item.ch <- g.applyMove(item.move, item.proposer, false, 0, selfInitiatorSentinel)
+			item.ch <- g.applyProposedMove(item.move, item.proposer)
 			close(item.ch)
+		case item := <-g.agentChanges:
+			item.ch <- g.applyAgentChange(item.player, item.agentName)
+			close(item.ch)
+		case item := <-g.forcedFinishes:
+			item.ch <- g.applyForcedFinish(item.winners)
+			close(item.ch)
+		case result := <-g.agentResults:
+			if err := g.applyAgentResult(result); err != nil {
+				g.manager.Logger().Warnln("Agent " + result.agentName + " for player " + result.player.String() + " in game " + g.ID() + ": " + err.Error())
+			}
 		case delayed := <-g.fixUpTriggered:
 			move := g.manager.delegate.ProposeFixUpMove(g.CurrentState())
 			if move == nil {
@@ -671,6 +934,7 @@ func (g *Game) Refresh() {
 	g.version = freshGame.Version()
 	g.finished = freshGame.Finished()
 	g.winners = freshGame.Winners()
+	g.agents = freshGame.Agents()
 
 }
 
@@ -713,13 +977,23 @@ func (g *Game) ProposeMove(move Move, proposer PlayerIndex) DelayedError {
 
 }
 
-//triggerAgents is called after a PlayerMove (and its chain of fixUp moves) is called.
+//triggerAgents is called after a PlayerMove (and its chain of fixUp moves) is
+//called. It asks each agent about the current version on its own goroutine,
+//so slow agents don't hold up mainLoop; what they come back with is applied
+//by applyAgentResult. Any agents still thinking about an earlier version are
+//cancelled.
 func (g *Game) triggerAgents() error {
 
+	g.stopAgents()
+
 	if g.Finished() {
 		return nil
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+
+	g.cancelAgents = cancel
+
 	for i, name := range g.agents {
 
 		if name == "" {
@@ -738,41 +1012,192 @@ func (g *Game) triggerAgents() error {
 			return errors.New("Couldn't load state for agent #" + strconv.Itoa(i) + ": " + err.Error())
 		}
 
-		move, newState := agent.ProposeMove(g, PlayerIndex(i), agentState)
+		//Each agent gets its own read-only copy, so it can't race with
+		//mainLoop or the other agents. Its current state is loaded here, on
+		//mainLoop, so the agent doesn't read storage while mainLoop writes
+		//to it.
+		snapshot := g.manager.gameFromStorageRecord(g.StorageRecord())
+		snapshot.CurrentState()
+
+		go g.runAgent(ctx, agent, snapshot, PlayerIndex(i), agentState)
+	}
+	return nil
+}
+
+//stopAgents cancels any agents still thinking about the current version. May
+//only be called by mainLoop.
+func (g *Game) stopAgents() {
+	if g.cancelAgents != nil {
+		g.cancelAgents()
+		g.cancelAgents = nil
+	}
+}
+
+//runAgent asks agent what to do about snapshot and sends what it comes back
+//with to mainLoop, unless ctx is cancelled first. If the agent runs out of
+//think time, mainLoop is told so it can make a move for the agent instead.
+//Designed to be run in its own goroutine.
+func (g *Game) runAgent(ctx context.Context, agent Agent, snapshot *Game, player PlayerIndex, agentState []byte) {
+
+	thinkTime := DefaultAgentThinkTime
 
-		if newState != nil {
-			if err := g.Manager().Storage().SaveAgentState(g.ID(), PlayerIndex(i), newState); err != nil {
-				return errors.New("Failed to store new state for agent #" + strconv.Itoa(i) + ": " + err.Error())
+	if timed, ok := agent.(ThinkTimeAgent); ok {
+		thinkTime = timed.ThinkTime()
+	}
+
+	thinkCtx, cancel := context.WithTimeout(ctx, thinkTime)
+	defer cancel()
+
+	start := time.Now()
+
+	result := &agentResult{
+		player:    player,
+		agentName: agent.Name(),
+		version:   snapshot.Version(),
+	}
+
+	done := make(chan bool)
+
+	go func() {
+		if contextAgent, ok := agent.(ContextAgent); ok {
+			result.move, result.newState = contextAgent.ProposeMoveContext(thinkCtx, snapshot, player, agentState)
+		} else {
+			result.move, result.newState = agent.ProposeMove(snapshot, player, agentState)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-thinkCtx.Done():
+		//Agents that don't watch the context keep thinking, but whatever
+		//they come up with is ignored.
+		if ctx.Err() != nil {
+			return
+		}
+		g.manager.Logger().Warnln("Agent " + agent.Name() + " for player " + player.String() + " in game " + snapshot.ID() + " ran out of think time")
+		timedOut := &agentResult{
+			player:    player,
+			agentName: agent.Name(),
+			version:   snapshot.Version(),
+			timedOut:  true,
+		}
+		select {
+		case g.agentResults <- timedOut:
+		case <-ctx.Done():
+		}
+		return
+	}
+
+	if result.move == nil && result.newState == nil {
+		return
+	}
+
+	if result.move != nil && !g.instantAgentMoves {
+		//Slow down the playback of moves to more accurately emulate a human,
+		//counting the time the agent already spent thinking.
+		delay := agentMoveDelay(500*time.Millisecond, 2*time.Second) - time.Since(start)
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
 			}
 		}
+	}
+
+	select {
+	case g.agentResults <- result:
+	case <-ctx.Done():
+	}
+}
 
-		if move != nil {
+//agentMoveDelay returns a random duration between low and high.
+func agentMoveDelay(low time.Duration, high time.Duration) time.Duration {
+	return time.Duration(rand.Int63n(int64(high-low))) + low
+}
 
-			//Slow down the playback of moves to more accurately emulate a human.
+//applyAgentResult saves the state the agent returned and applies the move it
+//proposed, or if the agent ran out of think time, applies a legal move on its
+//behalf so the game doesn't stall. If the game has moved on since the agent
+//was asked the result is dropped; the agent will have been asked again about
+//the new version. Like applyMove, it may only be called by mainLoop.
+func (g *Game) applyAgentResult(result *agentResult) error {
 
-			//TODO: if it's already been awhile since the last move was made
-			//(e.g. the agent was thinking for awhile), then apply
-			//immediately.
+	if g.finished || result.version != g.version {
+		return nil
+	}
 
-			if g.instantAgentMoves {
-				g.ProposeMove(move, PlayerIndex(i))
-			} else {
-				g.delayedProposeMove(move, PlayerIndex(i), 500*time.Millisecond, 2*time.Second)
-			}
+	if int(result.player) >= len(g.agents) || g.agents[result.player] != result.agentName {
+		//The seat was handed to someone else while the agent was thinking.
+		return nil
+	}
+
+	if result.timedOut {
+		return g.applyAgentFallback(result.player)
+	}
+
+	if result.newState != nil {
+		if err := g.manager.Storage().SaveAgentState(g.ID(), result.player, result.newState); err != nil {
+			return errors.New("Failed to store new state for agent: " + err.Error())
 		}
 	}
+
+	if result.move == nil {
+		return nil
+	}
+
+	return g.applyProposedMove(result.move, result.player)
+}
+
+//applyAgentFallback applies the first legal player move, with its defaults
+//for the current state, for an agent that ran out of think time. If it isn't
+//player's turn, or they have no legal move, they aren't holding up the game,
+//so nothing is done. May only be called by mainLoop.
+func (g *Game) applyAgentFallback(player PlayerIndex) error {
+
+	currentState := g.CurrentState()
+
+	if g.manager.delegate.CurrentPlayerIndex(currentState) != player {
+		return nil
+	}
+
+	for _, move := range g.Moves() {
+		//Core doesn't know about fix up moves, but moves made with base
+		//and moves do.
+		if fixUpper, ok := move.(interface{ IsFixUp() bool }); ok && fixUpper.IsFixUp() {
+			continue
+		}
+		if move.Legal(currentState, player) != nil {
+			continue
+		}
+		return g.applyProposedMove(move, player)
+	}
+
 	return nil
 }
 
-func (g *Game) delayedProposeMove(move Move, proposer PlayerIndex, low time.Duration, high time.Duration) {
+//applyProposedMove applies the move, and any fix up moves that follow it, via
+//applyMove, and records how long that took and how many fix up moves there
+//were. May only be called by mainLoop.
+func (g *Game) applyProposedMove(move Move, proposer PlayerIndex) error {
 
-	diff := high - low
+	gameName := g.manager.delegate.Name()
 
-	timeToWait := time.Duration(rand.Intn(int(diff))) + low
-	go func() {
-		<-time.After(timeToWait)
-		g.ProposeMove(move, proposer)
-	}()
+	start := time.Now()
+	startVersion := g.version
+
+	err := g.applyMove(move, proposer, false, 0, selfInitiatorSentinel)
+
+	metricApplyMoveDuration.WithLabelValues(gameName).Observe(time.Since(start).Seconds())
+
+	//If the proposed move itself applied, every version after it was a fix up
+	//move.
+	if g.version > startVersion {
+		metricFixUpChainLength.WithLabelValues(gameName).Observe(float64(g.version - startVersion - 1))
+	}
+
+	return err
 }
 
 //Game applies the move to the state if it is currently legal. May only be
@@ -870,6 +1295,8 @@ func (g *Game) applyMove(move Move, proposer PlayerIndex, isFixUp bool, recurseC
 	//supposed to do.
 	newState.committed()
 
+	metricMovesApplied.WithLabelValues(g.manager.delegate.Name(), move.Info().Name()).Inc()
+
 	if recurseCount > maxRecurseCount {
 		return ErrTooManyFixUps
 	}
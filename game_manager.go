@@ -92,6 +92,46 @@ func (m *ManagerInternals) ForceFixUp(game *Game) DelayedError {
 	return game.triggerFixUp()
 }
 
+//SetAgent hands the given seat over to the agent with the given name (which
+//must be one of the manager's Agents()) from now on, or if agentName is "",
+//takes the seat away from whatever agent was playing it. The agent's state is
+//set up and saved, the game's Agents are updated in storage, and if it's the
+//agent's turn it will propose a move right away. This is how, for example,
+//server has an agent take over for a player who left mid-game.
+func (m *ManagerInternals) SetAgent(game *Game, player PlayerIndex, agentName string) DelayedError {
+	if game == nil {
+		delayed := make(DelayedError, 1)
+		delayed <- errors.New("No game provided")
+		return delayed
+	}
+	return game.triggerAgentChange(player, agentName)
+}
+
+//ForceFinish ends the game right away with the given winners (which may be
+//empty, for a draw), even though GameDelegate.CheckGameFinished doesn't say
+//it's finished. No move is applied, so the version doesn't change, and
+//StorageManager.PlayerMoveApplied isn't called. This is how, for example,
+//server lets an admin end an abandoned game.
+func (m *ManagerInternals) ForceFinish(game *Game, winners []PlayerIndex) DelayedError {
+	if game == nil {
+		delayed := make(DelayedError, 1)
+		delayed <- errors.New("No game provided")
+		return delayed
+	}
+	return game.triggerForcedFinish(winners)
+}
+
+//EvictGame forgets the modifiable copy of the game with the given id that the
+//manager keeps in memory, so that ModifiableGame and proposed moves go back
+//to storage for it. This is how, for example, server forgets a game an admin
+//deleted from storage. Finish the game first, since copies of it that are
+//already referenced keep their main loop.
+func (m *ManagerInternals) EvictGame(id string) {
+	m.manager.modifiableGamesLock.Lock()
+	delete(m.manager.modifiableGames, strings.ToUpper(id))
+	m.manager.modifiableGamesLock.Unlock()
+}
+
 //AddCommittedCallback adds a function that will be called once the state is
 //successfully saved. Typically you'd do something with this in your Move's
 //Apply() method if you wanted to note in some external system whether the move
@@ -665,6 +705,9 @@ func (g *GameManager) newGame(id, secretSalt string) *Game {
 		//Note: this is also set similarly in manager.ModifiableGame
 		proposedMoves:  make(chan *proposedMoveItem, 20),
 		fixUpTriggered: make(chan DelayedError, 10),
+		agentChanges:   make(chan *agentChangeItem, 10),
+		forcedFinishes: make(chan *forcedFinishItem, 10),
+		agentResults:   make(chan *agentResult, 10),
 		id:             id,
 		secretSalt:     secretSalt,
 		modifiable:     true,
@@ -716,6 +759,8 @@ func (g *GameManager) modifiableGameCreated(game *Game) error {
 	g.modifiableGames[id] = game
 	g.modifiableGamesLock.Unlock()
 
+	metricGamesLoaded.WithLabelValues(g.delegate.Name()).Inc()
+
 	return nil
 }
 
@@ -761,12 +806,17 @@ func (g *GameManager) ModifiableGame(id string) *Game {
 	//Note: this is also set similarly in NewGame
 	game.proposedMoves = make(chan *proposedMoveItem, 20)
 	game.fixUpTriggered = make(chan DelayedError, 10)
+	game.agentChanges = make(chan *agentChangeItem, 10)
+	game.forcedFinishes = make(chan *forcedFinishItem, 10)
+	game.agentResults = make(chan *agentResult, 10)
 	go game.mainLoop()
 
 	g.modifiableGamesLock.Lock()
 	g.modifiableGames[id] = game
 	g.modifiableGamesLock.Unlock()
 
+	metricGamesLoaded.WithLabelValues(g.delegate.Name()).Inc()
+
 	return game
 
 }
@@ -1,6 +1,7 @@
 package boardgame
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"reflect"
@@ -519,6 +520,271 @@ func TestAgent(t *testing.T) {
 
 }
 
+func TestSetAgent(t *testing.T) {
+
+	manager := newTestGameManger(t)
+
+	game, err := manager.newGameImpl("", "")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game.instantAgentMoves = true
+
+	err = game.setUp(3, nil, nil)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(game.NumAgentPlayers()).Equals(0)
+
+	err = <-manager.Internals().SetAgent(game, 1, "Invalid")
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	err = <-manager.Internals().SetAgent(game, 5, "Test")
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	err = <-manager.Internals().SetAgent(game, 1, "Test")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	err = <-manager.Internals().SetAgent(game, 2, "Test")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(game.Agents()).Equals([]string{"", "Test", "Test"})
+
+	refriedGame := manager.Game(game.ID())
+
+	assert.For(t).ThatActual(refriedGame.Agents()).Equals([]string{"", "Test", "Test"})
+
+	//Changing agents doesn't create a new version.
+	assert.For(t).ThatActual(game.Version()).Equals(0)
+
+	err = <-game.ProposeMove(game.MoveByName("Test"), 0)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	<-time.After(time.Millisecond * 50)
+
+	//The new agents played their turns.
+	assert.For(t).ThatActual(manager.Game(game.ID()).Version()).Equals(6)
+
+	//Taking the seat back from the agent works on non-modifiable games, too.
+	err = <-manager.Internals().SetAgent(refriedGame, 2, "")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(refriedGame.Agents()).Equals([]string{"", "Test", ""})
+	assert.For(t).ThatActual(game.Agents()).Equals([]string{"", "Test", ""})
+}
+
+//testSlowAgent is a testAgent that takes longer than its think time.
+type testSlowAgent struct {
+	testAgent
+}
+
+func (t *testSlowAgent) Name() string {
+	return "Slow"
+}
+
+func (t *testSlowAgent) ThinkTime() time.Duration {
+	return 20 * time.Millisecond
+}
+
+func (t *testSlowAgent) ProposeMove(game *Game, player PlayerIndex, agentState []byte) (move Move, newAgentState []byte) {
+	<-time.After(200 * time.Millisecond)
+	return t.testAgent.ProposeMove(game, player, agentState)
+}
+
+//testBlockingAgent is a testAgent that, when it's its turn, reports which
+//version it was asked about on asked, and then doesn't answer until its
+//context is cancelled.
+type testBlockingAgent struct {
+	testAgent
+	asked     chan int
+	cancelled chan error
+}
+
+func (t *testBlockingAgent) Name() string {
+	return "Blocking"
+}
+
+func (t *testBlockingAgent) ProposeMove(game *Game, player PlayerIndex, agentState []byte) (move Move, newAgentState []byte) {
+	return t.ProposeMoveContext(context.Background(), game, player, agentState)
+}
+
+func (t *testBlockingAgent) ProposeMoveContext(ctx context.Context, game *Game, player PlayerIndex, agentState []byte) (move Move, newAgentState []byte) {
+	move, _ = t.testAgent.ProposeMove(game, player, agentState)
+
+	if move == nil {
+		return nil, nil
+	}
+
+	t.asked <- game.Version()
+	<-ctx.Done()
+	t.cancelled <- ctx.Err()
+
+	return move, []byte("stale")
+}
+
+func TestAgentThinkTime(t *testing.T) {
+
+	manager := newTestGameManger(t)
+
+	manager.agentsByName["slow"] = &testSlowAgent{}
+
+	game, err := manager.newGameImpl("", "")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game.instantAgentMoves = true
+
+	err = game.setUp(3, nil, []string{"", "Slow", ""})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	err = <-game.ProposeMove(game.MoveByName("Test"), 0)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	//Read through copies, since the modifiable game is changed by mainLoop.
+	version := manager.Game(game.ID()).Version()
+
+	<-time.After(time.Millisecond * 300)
+
+	latest := manager.Game(game.ID())
+
+	//The agent's move came in too late to be used, so a legal move was made
+	//for it instead and the game moved on.
+	gameState, _ := concreteStates(latest.CurrentState())
+
+	assert.For(t).ThatActual(gameState.CurrentPlayer).Equals(PlayerIndex(2))
+
+	records, err := manager.Storage().Moves(game.ID(), version+1, latest.Version())
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(records[0].Proposer).Equals(PlayerIndex(1))
+}
+
+func TestStaleAgentMove(t *testing.T) {
+
+	manager := newTestGameManger(t)
+
+	agent := &testBlockingAgent{
+		asked:     make(chan int, 10),
+		cancelled: make(chan error, 10),
+	}
+
+	manager.agentsByName["blocking"] = agent
+
+	game, err := manager.newGameImpl("", "")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game.instantAgentMoves = true
+
+	err = game.setUp(3, nil, []string{"", "Blocking", ""})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	err = <-game.ProposeMove(game.MoveByName("Test"), 0)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	select {
+	case version := <-agent.asked:
+		assert.For(t).ThatActual(version).Equals(manager.Game(game.ID()).Version())
+	case <-time.After(time.Second):
+		t.Fatal("Agent was never asked for a move")
+	}
+
+	//The game didn't wait for the agent; the admin can move on its behalf
+	//while it's still thinking.
+	err = <-game.ProposeMove(game.MoveByName("Test"), AdminPlayerIndex)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	version := manager.Game(game.ID()).Version()
+
+	select {
+	case err := <-agent.cancelled:
+		assert.For(t).ThatActual(err).Equals(context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("Agent's context was never cancelled")
+	}
+
+	<-time.After(time.Millisecond * 50)
+
+	//The move and state the agent answered with were for an old version, so
+	//they were dropped.
+	assert.For(t).ThatActual(manager.Game(game.ID()).Version()).Equals(version)
+
+	agentState, err := manager.Storage().AgentState(game.ID(), 1)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(agentState == nil).IsTrue()
+}
+
+func TestForceFinish(t *testing.T) {
+
+	manager := newTestGameManger(t)
+
+	game, err := manager.NewGame(3, nil, nil)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	err = <-manager.Internals().ForceFinish(game, []PlayerIndex{5})
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(game.Finished()).IsFalse()
+
+	refriedGame := manager.Game(game.ID())
+
+	//Works on non-modifiable games, too.
+	err = <-manager.Internals().ForceFinish(refriedGame, []PlayerIndex{1})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(refriedGame.Finished()).IsTrue()
+	assert.For(t).ThatActual(refriedGame.Winners()).Equals([]PlayerIndex{1})
+	assert.For(t).ThatActual(game.Finished()).IsTrue()
+	assert.For(t).ThatActual(game.Version()).Equals(0)
+
+	err = <-manager.Internals().ForceFinish(game, nil)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	err = <-game.ProposeMove(game.MoveByName("Test"), 0)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+}
+
+func TestEvictGame(t *testing.T) {
+
+	manager := newTestGameManger(t)
+
+	game, err := manager.NewGame(3, nil, nil)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(<-manager.Internals().ForceFinish(game, nil)).IsNil()
+
+	storage := manager.Storage().(*testStorageManager)
+
+	storage.lock.Lock()
+	delete(storage.games, game.ID())
+	storage.lock.Unlock()
+
+	//The manager still has the game in memory until it's evicted.
+	assert.For(t).ThatActual(manager.ModifiableGame(game.ID())).Equals(game)
+
+	manager.Internals().EvictGame(game.ID())
+
+	assert.For(t).ThatActual(manager.ModifiableGame(game.ID()) == nil).IsTrue()
+	assert.For(t).ThatActual(manager.Game(game.ID()) == nil).IsTrue()
+}
+
 func TestGameSalt(t *testing.T) {
 	game := testDefaultGame(t, false)
 
@@ -0,0 +1,65 @@
+package boardgame
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//The Prometheus collectors for the engine. They aren't exposed until
+//RegisterMetrics is called (server/api does so when its metrics are
+//configured). Every metric is labeled with the name of the game type it's
+//about.
+var (
+	metricGamesLoaded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "boardgame",
+		Name:      "games_loaded_total",
+		Help:      "Number of modifiable games created or loaded into memory.",
+	}, []string{"game"})
+
+	metricMovesApplied = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "boardgame",
+		Name:      "moves_applied_total",
+		Help:      "Number of moves successfully applied, by move type.",
+	}, []string{"game", "move"})
+
+	metricFixUpChainLength = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "boardgame",
+		Name:      "fix_up_chain_length",
+		Help:      "Number of fix up moves applied after each proposed move.",
+		Buckets:   []float64{0, 1, 2, 4, 8, 16, 32, 64, 128},
+	}, []string{"game"})
+
+	metricApplyMoveDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "boardgame",
+		Name:      "apply_move_duration_seconds",
+		Help:      "How long applying a proposed move, including its fix up moves, took.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"game"})
+
+	metricTimerQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "boardgame",
+		Name:      "timer_queue_depth",
+		Help:      "Number of timers that are prepared or counting down.",
+	}, []string{"game"})
+)
+
+//RegisterMetrics registers the engine's Prometheus collectors with
+//registerer, so that whatever serves it exposes them. The collectors are
+//shared by every GameManager in the process, so it only needs to be called
+//once; registering them again with the same registerer is not an error.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{
+		metricGamesLoaded,
+		metricMovesApplied,
+		metricFixUpChainLength,
+		metricApplyMoveDuration,
+		metricTimerQueueDepth,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); ok {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
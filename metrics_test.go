@@ -0,0 +1,60 @@
+package boardgame
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/workfit/tester/assert"
+)
+
+func TestMetrics(t *testing.T) {
+
+	gameName := testGameName
+
+	loaded := testutil.ToFloat64(metricGamesLoaded.WithLabelValues(gameName))
+	applied := testutil.ToFloat64(metricMovesApplied.WithLabelValues(gameName, "Test"))
+
+	game := testDefaultGame(t, false)
+
+	assert.For(t).ThatActual(testutil.ToFloat64(metricGamesLoaded.WithLabelValues(gameName))).Equals(loaded + 1)
+
+	move := game.MoveByName("test").(*testMove)
+
+	move.AString = "foo"
+	move.ScoreIncrement = 3
+	move.TargetPlayerIndex = 0
+
+	assert.For(t).ThatActual(<-game.ProposeMove(move, AdminPlayerIndex)).IsNil()
+
+	assert.For(t).ThatActual(testutil.ToFloat64(metricMovesApplied.WithLabelValues(gameName, "Test"))).Equals(applied + 1)
+	assert.For(t).ThatActual(testutil.CollectAndCount(metricApplyMoveDuration)).DoesNotEqual(0)
+	assert.For(t).ThatActual(testutil.CollectAndCount(metricFixUpChainLength)).DoesNotEqual(0)
+}
+
+func TestRegisterMetrics(t *testing.T) {
+
+	//Importing the package shouldn't register anything globally.
+	assert.For(t).ThatActual(prometheus.DefaultRegisterer.Unregister(metricGamesLoaded)).IsFalse()
+
+	registry := prometheus.NewRegistry()
+
+	assert.For(t).ThatActual(RegisterMetrics(registry)).IsNil()
+	assert.For(t).ThatActual(RegisterMetrics(registry)).IsNil()
+
+	testDefaultGame(t, false)
+
+	families, err := registry.Gather()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	found := false
+
+	for _, family := range families {
+		if family.GetName() == "boardgame_games_loaded_total" {
+			found = true
+		}
+	}
+
+	assert.For(t).ThatActual(found).IsTrue()
+}
@@ -272,6 +272,18 @@ type Move interface {
 	ReadSetConfigurer
 }
 
+//SecretMove is an optional interface for moves with properties that some
+//players shouldn't see, like a sealed bid. The sanitization policies on
+//states only protect properties in the state, but the move itself is stored
+//and shown alongside each version, too. Game.MoveRecordForPlayer uses this
+//interface to hide those properties before a move is shown to a player.
+type SecretMove interface {
+	Move
+	//HideSecrets sets the properties of the move that player may not see
+	//to their zero value. It is never called for AdminPlayerIndex.
+	HideSecrets(player PlayerIndex)
+}
+
 //ConfigurationValidator is an interface that certain types must implement.
 //These will be called typically during NewGameManager set up, and are an
 //opportunity for the structs to report configuration errors that can only be
@@ -0,0 +1,715 @@
+package moves
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//AuctionType is how an auction run by PlaceBid, PassBid and ResolveAuction
+//works. Configure it with WithAuctionType.
+type AuctionType int
+
+const (
+	//AuctionAscending is an open auction that goes around and around from the
+	//current player, skipping players who have passed, until everyone but the
+	//high bidder has passed. Each bid must beat the high bid by MinIncrement.
+	AuctionAscending AuctionType = iota
+	//AuctionOnceAround gives each player, starting with the current player,
+	//exactly one chance to bid or pass. Each bid must beat the high bid by
+	//MinIncrement.
+	AuctionOnceAround
+	//AuctionSealed has every player make one hidden bid (or pass), in any
+	//order. The highest bid wins, with ties going to whoever comes first
+	//going around from the current player.
+	AuctionSealed
+)
+
+//String returns the name of the AuctionType.
+func (a AuctionType) String() string {
+	switch a {
+	case AuctionAscending:
+		return "Ascending"
+	case AuctionOnceAround:
+		return "Once Around"
+	case AuctionSealed:
+		return "Sealed"
+	}
+	return "Unknown Auction Type " + strconv.Itoa(int(a))
+}
+
+//auctionTyper is implemented by PlaceBid, PassBid and ResolveAuction.
+type auctionTyper interface {
+	AuctionType() AuctionType
+}
+
+//minBidder is implemented by PlaceBid.
+type minBidder interface {
+	MinBid(state boardgame.ImmutableState) int
+	MinIncrement(state boardgame.ImmutableState) int
+}
+
+//auctionNextPlayerer is implemented by ResolveAuction.
+type auctionNextPlayerer interface {
+	AuctionNextPlayer(state boardgame.ImmutableState, winner boardgame.PlayerIndex) boardgame.PlayerIndex
+}
+
+func auctionTypeFromConfig(m moveInfoer) AuctionType {
+	config := m.CustomConfiguration()
+
+	val, ok := config[configPropAuctionType]
+
+	if !ok {
+		return AuctionAscending
+	}
+
+	auctionType, ok := val.(AuctionType)
+
+	if !ok {
+		return AuctionAscending
+	}
+
+	return auctionType
+}
+
+//intFromConfig returns the int passed to auto.Config under configPropName,
+//or defaultValue if there wasn't one.
+func intFromConfig(m moveInfoer, configPropName string, defaultValue int) int {
+	config := m.CustomConfiguration()
+
+	val, ok := config[configPropName]
+
+	if !ok {
+		return defaultValue
+	}
+
+	intVal, ok := val.(int)
+
+	if !ok {
+		return defaultValue
+	}
+
+	return intVal
+}
+
+func auctionTypeImpl(topLevelStruct boardgame.Move) (AuctionType, error) {
+	typer, ok := topLevelStruct.(auctionTyper)
+
+	if !ok {
+		return AuctionAscending, errors.New("The top level struct unexpectedly didn't have AuctionType")
+	}
+
+	return typer.AuctionType(), nil
+}
+
+//auctionHasPassed returns whether player has passed in the current auction.
+func auctionHasPassed(auction interfaces.AuctionProperties, player boardgame.PlayerIndex) bool {
+	if !auction.AuctionHasStarted() {
+		return false
+	}
+	for _, passed := range auction.AuctionPassedPlayers() {
+		if passed == player {
+			return true
+		}
+	}
+	return false
+}
+
+//auctionSealedBid returns player's sealed bid in the current auction, or -1
+//if they haven't made one.
+func auctionSealedBid(auction interfaces.AuctionProperties, player boardgame.PlayerIndex) int {
+	if !auction.AuctionHasStarted() {
+		return -1
+	}
+	bids := auction.AuctionSealedBids()
+	if player < 0 || int(player) >= len(bids) {
+		return -1
+	}
+	return bids[player]
+}
+
+//auctionHighBidder returns the high bidder in the current auction, or
+//ObserverPlayerIndex if no one has bid yet.
+func auctionHighBidder(auction interfaces.AuctionProperties) boardgame.PlayerIndex {
+	if !auction.AuctionHasStarted() {
+		return boardgame.ObserverPlayerIndex
+	}
+	return auction.AuctionHighBidder()
+}
+
+//auctionDone returns nil if the current auction is ready for ResolveAuction,
+//or an error describing why it's not.
+func auctionDone(auctionType AuctionType, state boardgame.ImmutableState) error {
+	auction, ok := state.ImmutableGameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement AuctionProperties")
+	}
+
+	if !auction.AuctionHasStarted() {
+		return errors.New("No auction is in progress")
+	}
+
+	switch auctionType {
+	case AuctionOnceAround:
+		if auction.AuctionTurnCount() < activePlayerCount(state) {
+			return errors.New("Not every player has had a chance to bid")
+		}
+		return nil
+	case AuctionSealed:
+		for i := range state.ImmutablePlayerStates() {
+			player := boardgame.PlayerIndex(i)
+			if !player.Valid(state) {
+				continue
+			}
+			if auctionSealedBid(auction, player) < 0 && !auctionHasPassed(auction, player) {
+				return errors.New("Player " + player.String() + " hasn't bid yet")
+			}
+		}
+		return nil
+	}
+
+	remaining := 0
+
+	for i := range state.ImmutablePlayerStates() {
+		player := boardgame.PlayerIndex(i)
+		if !player.Valid(state) {
+			continue
+		}
+		if !auctionHasPassed(auction, player) {
+			remaining++
+		}
+	}
+
+	if auctionHighBidder(auction) == boardgame.ObserverPlayerIndex {
+		if remaining > 0 {
+			return errors.New("Not every player has passed")
+		}
+		return nil
+	}
+
+	if remaining > 1 {
+		return errors.New("More than one player is still bidding")
+	}
+
+	return nil
+}
+
+//auctionActorLegal checks that target, who must be proposer, may bid or pass
+//in the current auction.
+func auctionActorLegal(topLevelStruct boardgame.Move, state boardgame.ImmutableState, proposer boardgame.PlayerIndex, target boardgame.PlayerIndex) error {
+	auction, ok := state.ImmutableGameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement AuctionProperties")
+	}
+
+	auctionType, err := auctionTypeImpl(topLevelStruct)
+
+	if err != nil {
+		return err
+	}
+
+	target = target.EnsureValid(state)
+
+	if !target.Valid(state) || target < 0 {
+		return errors.New("The specified target player is not valid")
+	}
+
+	if !target.Equivalent(proposer) {
+		return errors.New("it's not your turn")
+	}
+
+	if auction.AuctionHasStarted() && auctionDone(auctionType, state) == nil {
+		return errors.New("The auction is over")
+	}
+
+	if auctionHasPassed(auction, target) {
+		return errors.New("You have already passed")
+	}
+
+	if auctionType == AuctionSealed {
+		if auctionSealedBid(auction, target) >= 0 {
+			return errors.New("You have already bid")
+		}
+		return nil
+	}
+
+	if !target.Equivalent(state.CurrentPlayerIndex()) {
+		return errors.New("it's not your turn")
+	}
+
+	return nil
+}
+
+//startAuction starts a new auction if one isn't already in progress, and
+//returns the gameState's AuctionProperties.
+func startAuction(state boardgame.State) (interfaces.AuctionProperties, error) {
+	auction, ok := state.GameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return nil, errors.New("GameState does not implement AuctionProperties")
+	}
+
+	if auction.AuctionHasStarted() {
+		return auction, nil
+	}
+
+	bids := make([]int, len(state.PlayerStates()))
+	for i := range bids {
+		bids[i] = -1
+	}
+
+	auction.SetAuctionHighBid(0)
+	auction.SetAuctionHighBidder(boardgame.ObserverPlayerIndex)
+	auction.SetAuctionPassedPlayers(nil)
+	auction.SetAuctionTurnCount(0)
+	auction.SetAuctionSealedBids(bids)
+	auction.SetAuctionHasStarted(true)
+
+	return auction, nil
+}
+
+//advanceAuction passes the turn on from player to the next player who hasn't
+//passed. Sealed-bid auctions don't have turns, so it does nothing for them.
+func advanceAuction(auctionType AuctionType, state boardgame.State, auction interfaces.AuctionProperties, player boardgame.PlayerIndex) error {
+
+	auction.SetAuctionTurnCount(auction.AuctionTurnCount() + 1)
+
+	if auctionType == AuctionSealed {
+		return nil
+	}
+
+	setter, ok := state.GameState().(interfaces.CurrentPlayerSetter)
+
+	if !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	next := player.Next(state)
+
+	for next != player && auctionHasPassed(auction, next) {
+		next = next.Next(state)
+	}
+
+	setter.SetCurrentPlayer(next)
+
+	return nil
+}
+
+//auctionValidConfiguration verifies the shared configuration of PlaceBid,
+//PassBid and ResolveAuction.
+func auctionValidConfiguration(topLevelStruct boardgame.Move, exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.AuctionProperties); !ok {
+		return errors.New("GameState does not implement AuctionProperties")
+	}
+
+	if _, ok := exampleState.GameState().(interfaces.CurrentPlayerSetter); !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	auctionType, err := auctionTypeImpl(topLevelStruct)
+
+	if err != nil {
+		return err
+	}
+
+	if auctionType < AuctionAscending || auctionType > AuctionSealed {
+		return errors.New("Invalid AuctionType: " + auctionType.String())
+	}
+
+	return nil
+}
+
+/*
+
+PlaceBid is a move that bids Bid in the current auction. Your GameState must
+implement interfaces.AuctionProperties (typically by embedding
+behaviors.Auction) and interfaces.CurrentPlayerSetter.
+
+The first PlaceBid or PassBid starts a new auction. In AuctionAscending and
+AuctionOnceAround auctions (see WithAuctionType) only the current player may
+bid, the first bid must be at least MinBid, and every later bid must beat the
+high bid by at least MinIncrement; after bidding the turn passes to the next
+player who hasn't passed. In AuctionSealed auctions every player may bid once,
+in any order, and the bids stay hidden until ResolveAuction.
+
+If bids are limited by something else in your game, like how much money the
+player has, embed this move and check that in your own Legal.
+
+boardgame:codegen
+*/
+type PlaceBid struct {
+	CurrentPlayer
+	Bid int
+}
+
+//AuctionType returns the value passed to auto.Config with WithAuctionType, or
+//AuctionAscending.
+func (p *PlaceBid) AuctionType() AuctionType {
+	return auctionTypeFromConfig(p)
+}
+
+//MinBid returns the value passed to auto.Config with WithMinBid, or 1.
+func (p *PlaceBid) MinBid(state boardgame.ImmutableState) int {
+	return intFromConfig(p, configPropMinBid, 1)
+}
+
+//MinIncrement returns the value passed to auto.Config with WithMinIncrement,
+//or 1.
+func (p *PlaceBid) MinIncrement(state boardgame.ImmutableState) int {
+	return intFromConfig(p, configPropMinIncrement, 1)
+}
+
+//LowestLegalBid returns the smallest Bid that would be legal right now.
+func (p *PlaceBid) LowestLegalBid(state boardgame.ImmutableState) int {
+	bidder, ok := p.TopLevelStruct().(minBidder)
+
+	if !ok {
+		return 1
+	}
+
+	minBid := bidder.MinBid(state)
+
+	auction, ok := state.ImmutableGameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return minBid
+	}
+
+	auctionType, _ := auctionTypeImpl(p.TopLevelStruct())
+
+	if auctionType == AuctionSealed || auctionHighBidder(auction) == boardgame.ObserverPlayerIndex {
+		return minBid
+	}
+
+	return auction.AuctionHighBid() + bidder.MinIncrement(state)
+}
+
+//DefaultsForState sets TargetPlayerIndex to the current player and Bid to
+//LowestLegalBid.
+func (p *PlaceBid) DefaultsForState(state boardgame.ImmutableState) {
+	p.CurrentPlayer.DefaultsForState(state)
+	p.Bid = p.LowestLegalBid(state)
+}
+
+//Legal checks that TargetPlayerIndex may bid in the current auction, and
+//that Bid is at least LowestLegalBid.
+func (p *PlaceBid) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	//We skip CurrentPlayer.Legal because in sealed-bid auctions anyone may
+	//bid.
+	if err := p.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	if err := auctionActorLegal(p.TopLevelStruct(), state, proposer, p.TargetPlayerIndex); err != nil {
+		return err
+	}
+
+	if lowest := p.LowestLegalBid(state); p.Bid < lowest {
+		return errors.New("Your bid must be at least " + strconv.Itoa(lowest))
+	}
+
+	return nil
+}
+
+//Apply records the bid and passes the turn to the next player.
+func (p *PlaceBid) Apply(state boardgame.State) error {
+	auction, err := startAuction(state)
+
+	if err != nil {
+		return err
+	}
+
+	auctionType, err := auctionTypeImpl(p.TopLevelStruct())
+
+	if err != nil {
+		return err
+	}
+
+	player := p.TargetPlayerIndex.EnsureValid(state)
+
+	if auctionType == AuctionSealed {
+		bids := append([]int{}, auction.AuctionSealedBids()...)
+		if player < 0 || int(player) >= len(bids) {
+			return errors.New("The specified target player is not valid")
+		}
+		bids[player] = p.Bid
+		auction.SetAuctionSealedBids(bids)
+	} else {
+		auction.SetAuctionHighBid(p.Bid)
+		auction.SetAuctionHighBidder(player)
+	}
+
+	return advanceAuction(auctionType, state, auction, player)
+}
+
+//HideSecrets zeroes Bid in AuctionSealed auctions for everyone but the
+//bidder, so the bid stays sealed in the move as well as in the state.
+func (p *PlaceBid) HideSecrets(player boardgame.PlayerIndex) {
+	if auctionType, _ := auctionTypeImpl(p.TopLevelStruct()); auctionType != AuctionSealed {
+		return
+	}
+	if p.TargetPlayerIndex.Equivalent(player) {
+		return
+	}
+	p.Bid = 0
+}
+
+//ValidConfiguration checks that the GameState implements
+//interfaces.AuctionProperties and interfaces.CurrentPlayerSetter, and that
+//AuctionType is valid.
+func (p *PlaceBid) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return auctionValidConfiguration(p.TopLevelStruct(), exampleState)
+}
+
+//FallbackName returns "Place Bid"
+func (p *PlaceBid) FallbackName(m *boardgame.GameManager) string {
+	return "Place Bid"
+}
+
+//FallbackHelpText returns "Bids in the current auction."
+func (p *PlaceBid) FallbackHelpText() string {
+	return "Bids in the current auction."
+}
+
+/*
+
+PassBid is a move that passes in the current auction. A player who passes may
+not bid again in that auction. Like PlaceBid, the first PassBid starts a new
+auction if one isn't already in progress, and it must be configured with the
+same WithAuctionType.
+
+boardgame:codegen
+*/
+type PassBid struct {
+	CurrentPlayer
+}
+
+//AuctionType returns the value passed to auto.Config with WithAuctionType, or
+//AuctionAscending.
+func (p *PassBid) AuctionType() AuctionType {
+	return auctionTypeFromConfig(p)
+}
+
+//Legal checks that TargetPlayerIndex may pass in the current auction.
+func (p *PassBid) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	//We skip CurrentPlayer.Legal because in sealed-bid auctions anyone may
+	//pass.
+	if err := p.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	return auctionActorLegal(p.TopLevelStruct(), state, proposer, p.TargetPlayerIndex)
+}
+
+//Apply records the pass and passes the turn to the next player.
+func (p *PassBid) Apply(state boardgame.State) error {
+	auction, err := startAuction(state)
+
+	if err != nil {
+		return err
+	}
+
+	auctionType, err := auctionTypeImpl(p.TopLevelStruct())
+
+	if err != nil {
+		return err
+	}
+
+	player := p.TargetPlayerIndex.EnsureValid(state)
+
+	passed := append([]boardgame.PlayerIndex{}, auction.AuctionPassedPlayers()...)
+	auction.SetAuctionPassedPlayers(append(passed, player))
+
+	return advanceAuction(auctionType, state, auction, player)
+}
+
+//ValidConfiguration checks that the GameState implements
+//interfaces.AuctionProperties and interfaces.CurrentPlayerSetter, and that
+//AuctionType is valid.
+func (p *PassBid) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return auctionValidConfiguration(p.TopLevelStruct(), exampleState)
+}
+
+//FallbackName returns "Pass Bid"
+func (p *PassBid) FallbackName(m *boardgame.GameManager) string {
+	return "Pass Bid"
+}
+
+//FallbackHelpText returns "Passes in the current auction."
+func (p *PassBid) FallbackHelpText() string {
+	return "Passes in the current auction."
+}
+
+/*
+
+ResolveAuction is a fix up move that applies once the current auction is over:
+in AuctionAscending auctions when everyone but the high bidder has passed, in
+AuctionOnceAround auctions when every player has had their chance, and in
+AuctionSealed auctions when every player has bid or passed. Configure it with
+the same WithAuctionType as PlaceBid and PassBid.
+
+It sets AuctionHighBid and AuctionHighBidder to the winning bid and winner
+(revealing the winner of a sealed-bid auction), ends the auction, and calls
+AuctionResolved, which you should override in your embedding move to act on the
+result. Finally it makes AuctionNextPlayer the current player. If your
+GameState also implements interfaces.RoundRobinProperties and no round robin is
+in progress, that player is set as RoundRobinStarterPlayer, too, so round
+robins that follow start with them.
+
+boardgame:codegen
+*/
+type ResolveAuction struct {
+	FixUp
+}
+
+//AuctionType returns the value passed to auto.Config with WithAuctionType, or
+//AuctionAscending.
+func (r *ResolveAuction) AuctionType() AuctionType {
+	return auctionTypeFromConfig(r)
+}
+
+//AuctionResolved does nothing. Override it in your embedding move to act on
+//the result of the auction.
+func (r *ResolveAuction) AuctionResolved(state boardgame.State, winner boardgame.PlayerIndex, bid int) error {
+	return nil
+}
+
+//AuctionNextPlayer returns who goes once the auction is resolved: by default
+//the winner, or the current player if everyone passed.
+func (r *ResolveAuction) AuctionNextPlayer(state boardgame.ImmutableState, winner boardgame.PlayerIndex) boardgame.PlayerIndex {
+	if winner == boardgame.ObserverPlayerIndex {
+		return state.CurrentPlayerIndex()
+	}
+	return winner
+}
+
+//AuctionWinner returns the player who won the current auction and their bid,
+//or ObserverPlayerIndex if everyone passed.
+func (r *ResolveAuction) AuctionWinner(state boardgame.ImmutableState) (boardgame.PlayerIndex, int, error) {
+	auction, ok := state.ImmutableGameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return boardgame.ObserverPlayerIndex, 0, errors.New("GameState does not implement AuctionProperties")
+	}
+
+	auctionType, err := auctionTypeImpl(r.TopLevelStruct())
+
+	if err != nil {
+		return boardgame.ObserverPlayerIndex, 0, err
+	}
+
+	if auctionType != AuctionSealed {
+		winner := auctionHighBidder(auction)
+		if winner == boardgame.ObserverPlayerIndex {
+			return winner, 0, nil
+		}
+		return winner, auction.AuctionHighBid(), nil
+	}
+
+	winner := boardgame.ObserverPlayerIndex
+	winningBid := -1
+
+	player := state.CurrentPlayerIndex().EnsureValid(state)
+
+	if player < 0 {
+		player = 0
+	}
+
+	for range state.ImmutablePlayerStates() {
+		if bid := auctionSealedBid(auction, player); bid > winningBid {
+			winner = player
+			winningBid = bid
+		}
+		player = player.Next(state)
+	}
+
+	if winner == boardgame.ObserverPlayerIndex {
+		return winner, 0, nil
+	}
+
+	return winner, winningBid, nil
+}
+
+//Legal returns nil once the current auction is over.
+func (r *ResolveAuction) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	auctionType, err := auctionTypeImpl(r.TopLevelStruct())
+
+	if err != nil {
+		return err
+	}
+
+	return auctionDone(auctionType, state)
+}
+
+//Apply records the winner, ends the auction, calls AuctionResolved and then
+//passes the turn to AuctionNextPlayer.
+func (r *ResolveAuction) Apply(state boardgame.State) error {
+	winner, bid, err := r.AuctionWinner(state)
+
+	if err != nil {
+		return errors.New("Couldn't determine auction winner: " + err.Error())
+	}
+
+	auction, ok := state.GameState().(interfaces.AuctionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement AuctionProperties")
+	}
+
+	auction.SetAuctionHighBid(bid)
+	auction.SetAuctionHighBidder(winner)
+	auction.SetAuctionHasStarted(false)
+
+	resolver, ok := r.TopLevelStruct().(interfaces.AuctionResolver)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement AuctionResolver")
+	}
+
+	if err := resolver.AuctionResolved(state, winner, bid); err != nil {
+		return err
+	}
+
+	nextPlayerer, ok := r.TopLevelStruct().(auctionNextPlayerer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have AuctionNextPlayer")
+	}
+
+	return goesNext(state, nextPlayerer.AuctionNextPlayer(state, winner))
+}
+
+//ValidConfiguration checks that the GameState implements
+//interfaces.AuctionProperties and interfaces.CurrentPlayerSetter, and that
+//AuctionType is valid.
+func (r *ResolveAuction) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return auctionValidConfiguration(r.TopLevelStruct(), exampleState)
+}
+
+//FallbackName returns "Resolve Auction"
+func (r *ResolveAuction) FallbackName(m *boardgame.GameManager) string {
+	return "Resolve Auction"
+}
+
+//FallbackHelpText returns "Ends the current auction once the bidding is over,
+//awarding it to the high bidder."
+func (r *ResolveAuction) FallbackHelpText() string {
+	return "Ends the current auction once the bidding is over, awarding it to the high bidder."
+}
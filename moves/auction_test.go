@@ -0,0 +1,181 @@
+package moves
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/workfit/tester/assert"
+)
+
+//boardgame:codegen
+type moveResolveAuctionAddToCounter struct {
+	ResolveAuction
+}
+
+func (m *moveResolveAuctionAddToCounter) AuctionResolved(state boardgame.State, winner boardgame.PlayerIndex, bid int) error {
+	game, _ := concreteStates(state)
+	game.Counter += bid
+	return nil
+}
+
+func auctionMoveInstaller(auctionType AuctionType) func(manager *boardgame.GameManager) []boardgame.MoveConfig {
+	return func(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+		auto := NewAutoConfigurer(manager.Delegate())
+
+		return []boardgame.MoveConfig{
+			auto.MustConfig(
+				new(PlaceBid),
+				WithAuctionType(auctionType),
+				WithMinIncrement(2),
+			),
+			auto.MustConfig(
+				new(PassBid),
+				WithAuctionType(auctionType),
+			),
+			auto.MustConfig(
+				new(moveResolveAuctionAddToCounter),
+				WithAuctionType(auctionType),
+			),
+		}
+	}
+}
+
+type auctionTester struct {
+	t    *testing.T
+	game *boardgame.Game
+}
+
+func newAuctionTester(t *testing.T, auctionType AuctionType) *auctionTester {
+	manager, err := newGameManager(auctionMoveInstaller(auctionType), false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	return &auctionTester{t, game}
+}
+
+func (a *auctionTester) bid(player boardgame.PlayerIndex, bid int) error {
+	move := a.game.MoveByName("Place Bid").(*PlaceBid)
+	move.TargetPlayerIndex = player
+	move.Bid = bid
+	return <-a.game.ProposeMove(move, player)
+}
+
+func (a *auctionTester) pass(player boardgame.PlayerIndex) error {
+	move := a.game.MoveByName("Pass Bid").(*PassBid)
+	move.TargetPlayerIndex = player
+	return <-a.game.ProposeMove(move, player)
+}
+
+func (a *auctionTester) gameState() *gameState {
+	game, _ := concreteStates(a.game.CurrentState())
+	return game
+}
+
+func TestAuctionAscending(t *testing.T) {
+	a := newAuctionTester(t, AuctionAscending)
+
+	assert.For(t).ThatActual(a.bid(1, 1)).IsNotNil()
+	assert.For(t).ThatActual(a.bid(0, 0)).IsNotNil()
+	assert.For(t).ThatActual(a.bid(0, 1)).IsNil()
+
+	assert.For(t).ThatActual(a.gameState().AuctionHasStarted()).IsTrue()
+	assert.For(t).ThatActual(a.gameState().CurrentPlayer).Equals(boardgame.PlayerIndex(1))
+
+	//Bids must go up by the min increment.
+	assert.For(t).ThatActual(a.bid(1, 2)).IsNotNil()
+	assert.For(t).ThatActual(a.bid(1, 3)).IsNil()
+	assert.For(t).ThatActual(a.pass(2)).IsNil()
+	assert.For(t).ThatActual(a.pass(3)).IsNil()
+
+	//Players who passed are skipped.
+	assert.For(t).ThatActual(a.gameState().CurrentPlayer).Equals(boardgame.PlayerIndex(0))
+
+	assert.For(t).ThatActual(a.bid(0, 5)).IsNil()
+	assert.For(t).ThatActual(a.gameState().CurrentPlayer).Equals(boardgame.PlayerIndex(1))
+	assert.For(t).ThatActual(a.pass(1)).IsNil()
+
+	//ResolveAuction should have applied.
+	game := a.gameState()
+
+	assert.For(t).ThatActual(game.AuctionHasStarted()).IsFalse()
+	assert.For(t).ThatActual(game.AuctionHighBidder()).Equals(boardgame.PlayerIndex(0))
+	assert.For(t).ThatActual(game.AuctionHighBid()).Equals(5)
+	assert.For(t).ThatActual(game.Counter).Equals(5)
+	assert.For(t).ThatActual(game.CurrentPlayer).Equals(boardgame.PlayerIndex(0))
+
+	//The next bid starts a new auction.
+	assert.For(t).ThatActual(a.bid(0, 1)).IsNil()
+	assert.For(t).ThatActual(len(a.gameState().AuctionPassedPlayers())).Equals(0)
+}
+
+func TestAuctionOnceAround(t *testing.T) {
+	a := newAuctionTester(t, AuctionOnceAround)
+
+	assert.For(t).ThatActual(a.bid(0, 2)).IsNil()
+	assert.For(t).ThatActual(a.pass(1)).IsNil()
+	assert.For(t).ThatActual(a.bid(2, 3)).IsNotNil()
+	assert.For(t).ThatActual(a.bid(2, 4)).IsNil()
+	assert.For(t).ThatActual(a.pass(3)).IsNil()
+
+	game := a.gameState()
+
+	assert.For(t).ThatActual(game.AuctionHasStarted()).IsFalse()
+	assert.For(t).ThatActual(game.AuctionHighBidder()).Equals(boardgame.PlayerIndex(2))
+	assert.For(t).ThatActual(game.Counter).Equals(4)
+	assert.For(t).ThatActual(game.CurrentPlayer).Equals(boardgame.PlayerIndex(2))
+}
+
+func TestAuctionSealed(t *testing.T) {
+	a := newAuctionTester(t, AuctionSealed)
+
+	//Anyone may bid, in any order, but only once.
+	assert.For(t).ThatActual(a.bid(2, 5)).IsNil()
+	assert.For(t).ThatActual(a.bid(2, 6)).IsNotNil()
+
+	//The bid is hidden in the move record, too, from everyone but the
+	//bidder.
+	record, err := a.game.Manager().Storage().Move(a.game.ID(), a.game.Version())
+	assert.For(t).ThatActual(err).IsNil()
+
+	for player, expected := range map[boardgame.PlayerIndex]int{0: 0, 2: 5, boardgame.ObserverPlayerIndex: 0, boardgame.AdminPlayerIndex: 5} {
+		sanitizedRecord, err := a.game.MoveRecordForPlayer(player, record)
+		assert.For(t, player).ThatActual(err).IsNil()
+		var blob struct {
+			Bid int
+		}
+		assert.For(t, player).ThatActual(json.Unmarshal(sanitizedRecord.Blob, &blob)).IsNil()
+		assert.For(t, player).ThatActual(blob.Bid).Equals(expected)
+	}
+
+	//A bid for someone else isn't legal.
+	move := a.game.MoveByName("Place Bid").(*PlaceBid)
+	move.TargetPlayerIndex = 1
+	move.Bid = 3
+	assert.For(t).ThatActual(<-a.game.ProposeMove(move, 3)).IsNotNil()
+
+	sanitized, err := a.game.CurrentState().SanitizedForPlayer(0)
+	assert.For(t).ThatActual(err).IsNil()
+	sanitizedGame, _ := concreteStates(sanitized)
+	assert.For(t).ThatActual(len(sanitizedGame.AuctionSealedBids())).Equals(0)
+	assert.For(t).ThatActual(sanitizedGame.AuctionHighBidder()).Equals(boardgame.ObserverPlayerIndex)
+
+	assert.For(t).ThatActual(a.bid(3, 7)).IsNil()
+	assert.For(t).ThatActual(a.pass(1)).IsNil()
+	assert.For(t).ThatActual(a.gameState().AuctionHasStarted()).IsTrue()
+	assert.For(t).ThatActual(a.bid(0, 7)).IsNil()
+
+	//Player 0 and 3 tied, and player 0 is first going around from the
+	//current player.
+	game := a.gameState()
+
+	assert.For(t).ThatActual(game.AuctionHasStarted()).IsFalse()
+	assert.For(t).ThatActual(game.AuctionHighBidder()).Equals(boardgame.PlayerIndex(0))
+	assert.For(t).ThatActual(game.AuctionHighBid()).Equals(7)
+	assert.For(t).ThatActual(game.Counter).Equals(7)
+}
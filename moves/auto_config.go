@@ -101,6 +101,38 @@ func (d *defaultMoveConfig) multipleItemSatisfied(tape *MoveGroupHistoryItem) (*
 	return nil, nil
 }
 
+func (d *defaultMoveConfig) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+
+	if tape == nil {
+		return []*MoveGroupHistoryItem{nil}
+	}
+
+	if tape.MoveName != d.Name() {
+		return nil
+	}
+
+	allowMultiple := false
+
+	if allowMultipler, ok := d.Constructor()().(interfaces.AllowMultipleInProgression); ok {
+		allowMultiple = allowMultipler.AllowMultipleInProgression()
+	}
+
+	if !allowMultiple {
+		return []*MoveGroupHistoryItem{tape.Rest}
+	}
+
+	//Unlike Satisfied, which greedily consumes as many as it can, stopping
+	//after any of the moves in the run is a possibility.
+	var result []*MoveGroupHistoryItem
+
+	for tape != nil && tape.MoveName == d.Name() {
+		tape = tape.Rest
+		result = append(result, tape)
+	}
+
+	return result
+}
+
 //AutoConfigurableMove is the interface that moves passed to AutoConfigurer.Config must
 //implement. These methods are interrogated to set the move name,
 //helptext,isFixUp, and legalPhases to good values. moves.Default defines
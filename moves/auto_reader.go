@@ -1394,20 +1394,23 @@ func (a *ApplyCountTimes) ReadSetConfigurer() boardgame.PropertyReadSetConfigure
 	return &ȧutoGeneratedApplyCountTimesReader{a}
 }
 
-// Implementation for CollectCountComponents
+// Implementation for PlaceBid
 
-var ȧutoGeneratedCollectCountComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedPlaceBidReaderProps = map[string]boardgame.PropertyType{
+	"Bid":               boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedCollectCountComponentsReader struct {
-	data *CollectCountComponents
+type ȧutoGeneratedPlaceBidReader struct {
+	data *PlaceBid
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCollectCountComponentsReaderProps
+func (p *ȧutoGeneratedPlaceBidReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPlaceBidReaderProps
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) Prop(name string) (interface{}, error) {
-	props := c.Props()
+func (p *ȧutoGeneratedPlaceBidReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1416,44 +1419,48 @@ func (c *ȧutoGeneratedCollectCountComponentsReader) Prop(name string) (interfac
 
 	switch propType {
 	case boardgame.TypeInt:
-		return c.IntProp(name)
+		return p.IntProp(name)
 	case boardgame.TypeBool:
-		return c.BoolProp(name)
+		return p.BoolProp(name)
 	case boardgame.TypeString:
-		return c.StringProp(name)
+		return p.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return c.PlayerIndexProp(name)
+		return p.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return c.ImmutableEnumProp(name)
+		return p.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return c.IntSliceProp(name)
+		return p.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return c.BoolSliceProp(name)
+		return p.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return c.StringSliceProp(name)
+		return p.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return c.PlayerIndexSliceProp(name)
+		return p.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return c.ImmutableStackProp(name)
+		return p.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return c.ImmutableBoardProp(name)
+		return p.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return c.ImmutableTimerProp(name)
+		return p.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) PropMutable(name string) bool {
+func (p *ȧutoGeneratedPlaceBidReader) PropMutable(name string) bool {
 	switch name {
+	case "Bid":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetProp(name string, value interface{}) error {
-	props := c.Props()
+func (p *ȧutoGeneratedPlaceBidReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1466,25 +1473,25 @@ func (c *ȧutoGeneratedCollectCountComponentsReader) SetProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -1492,25 +1499,25 @@ func (c *ȧutoGeneratedCollectCountComponentsReader) SetProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -1523,8 +1530,8 @@ func (c *ȧutoGeneratedCollectCountComponentsReader) SetProp(name string, value
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureProp(name string, value interface{}) error {
-	props := c.Props()
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1537,336 +1544,364 @@ func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureProp(name string,
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return c.ConfigureEnumProp(name, val)
+			return p.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return c.ConfigureImmutableEnumProp(name, val)
+		return p.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return c.ConfigureStackProp(name, val)
+			return p.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return c.ConfigureImmutableStackProp(name, val)
+		return p.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return c.ConfigureBoardProp(name, val)
+			return p.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return c.ConfigureImmutableBoardProp(name, val)
+		return p.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return c.ConfigureTimerProp(name, val)
+			return p.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return c.ConfigureImmutableTimerProp(name, val)
+		return p.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) IntProp(name string) (int, error) {
+func (p *ȧutoGeneratedPlaceBidReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Bid":
+		return p.data.Bid, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetIntProp(name string, value int) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Bid":
+		p.data.Bid = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) BoolProp(name string) (bool, error) {
+func (p *ȧutoGeneratedPlaceBidReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetBoolProp(name string, value bool) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) StringProp(name string) (string, error) {
+func (p *ȧutoGeneratedPlaceBidReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetStringProp(name string, value string) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlaceBidReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return p.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		p.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (p *ȧutoGeneratedPlaceBidReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (p *ȧutoGeneratedPlaceBidReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (p *ȧutoGeneratedPlaceBidReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (p *ȧutoGeneratedPlaceBidReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (p *ȧutoGeneratedPlaceBidReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlaceBidReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlaceBidReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (p *ȧutoGeneratedPlaceBidReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (p *ȧutoGeneratedPlaceBidReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (p *ȧutoGeneratedPlaceBidReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (p *ȧutoGeneratedPlaceBidReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (p *ȧutoGeneratedPlaceBidReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (p *ȧutoGeneratedPlaceBidReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (p *ȧutoGeneratedPlaceBidReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CollectCountComponents
-func (c *CollectCountComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCollectCountComponentsReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for PlaceBid
+func (p *PlaceBid) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPlaceBidReader{p}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectCountComponents
-func (c *CollectCountComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCollectCountComponentsReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PlaceBid
+func (p *PlaceBid) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPlaceBidReader{p}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectCountComponents
-func (c *CollectCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCollectCountComponentsReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PlaceBid
+func (p *PlaceBid) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPlaceBidReader{p}
 }
 
-// Implementation for CollectComponentsUntilPlayerCountLeft
+// Implementation for PassBid
 
-var ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedPassBidReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader struct {
-	data *CollectComponentsUntilPlayerCountLeft
+type ȧutoGeneratedPassBidReader struct {
+	data *PassBid
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReaderProps
+func (p *ȧutoGeneratedPassBidReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPassBidReaderProps
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) Prop(name string) (interface{}, error) {
-	props := c.Props()
+func (p *ȧutoGeneratedPassBidReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1875,44 +1910,46 @@ func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) Prop(name st
 
 	switch propType {
 	case boardgame.TypeInt:
-		return c.IntProp(name)
+		return p.IntProp(name)
 	case boardgame.TypeBool:
-		return c.BoolProp(name)
+		return p.BoolProp(name)
 	case boardgame.TypeString:
-		return c.StringProp(name)
+		return p.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return c.PlayerIndexProp(name)
+		return p.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return c.ImmutableEnumProp(name)
+		return p.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return c.IntSliceProp(name)
+		return p.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return c.BoolSliceProp(name)
+		return p.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return c.StringSliceProp(name)
+		return p.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return c.PlayerIndexSliceProp(name)
+		return p.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return c.ImmutableStackProp(name)
+		return p.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return c.ImmutableBoardProp(name)
+		return p.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return c.ImmutableTimerProp(name)
+		return p.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PropMutable(name string) bool {
+func (p *ȧutoGeneratedPassBidReader) PropMutable(name string) bool {
 	switch name {
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetProp(name string, value interface{}) error {
-	props := c.Props()
+func (p *ȧutoGeneratedPassBidReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1925,25 +1962,25 @@ func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetProp(name
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -1951,25 +1988,25 @@ func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetProp(name
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -1982,8 +2019,8 @@ func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetProp(name
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureProp(name string, value interface{}) error {
-	props := c.Props()
+func (p *ȧutoGeneratedPassBidReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1996,336 +2033,349 @@ func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigurePro
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return c.ConfigureEnumProp(name, val)
+			return p.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return c.ConfigureImmutableEnumProp(name, val)
+		return p.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return c.ConfigureStackProp(name, val)
+			return p.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return c.ConfigureImmutableStackProp(name, val)
+		return p.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return c.ConfigureBoardProp(name, val)
+			return p.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return c.ConfigureImmutableBoardProp(name, val)
+		return p.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if c.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return c.ConfigureTimerProp(name, val)
+			return p.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return c.ConfigureImmutableTimerProp(name, val)
+		return p.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) IntProp(name string) (int, error) {
+func (p *ȧutoGeneratedPassBidReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetIntProp(name string, value int) error {
+func (p *ȧutoGeneratedPassBidReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoolProp(name string) (bool, error) {
+func (p *ȧutoGeneratedPassBidReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetBoolProp(name string, value bool) error {
+func (p *ȧutoGeneratedPassBidReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StringProp(name string) (string, error) {
+func (p *ȧutoGeneratedPassBidReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetStringProp(name string, value string) error {
+func (p *ȧutoGeneratedPassBidReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPassBidReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return p.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPassBidReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		p.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (p *ȧutoGeneratedPassBidReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) EnumProp(name string) (enum.Val, error) {
+func (p *ȧutoGeneratedPassBidReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) IntSliceProp(name string) ([]int, error) {
+func (p *ȧutoGeneratedPassBidReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetIntSliceProp(name string, value []int) error {
+func (p *ȧutoGeneratedPassBidReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
+func (p *ȧutoGeneratedPassBidReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
+func (p *ȧutoGeneratedPassBidReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StringSliceProp(name string) ([]string, error) {
+func (p *ȧutoGeneratedPassBidReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetStringSliceProp(name string, value []string) error {
+func (p *ȧutoGeneratedPassBidReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPassBidReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPassBidReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (p *ȧutoGeneratedPassBidReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
+func (p *ȧutoGeneratedPassBidReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (p *ȧutoGeneratedPassBidReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
+func (p *ȧutoGeneratedPassBidReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (p *ȧutoGeneratedPassBidReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (p *ȧutoGeneratedPassBidReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
+func (p *ȧutoGeneratedPassBidReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CollectComponentsUntilPlayerCountLeft
-func (c *CollectComponentsUntilPlayerCountLeft) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for PassBid
+func (p *PassBid) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPassBidReader{p}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectComponentsUntilPlayerCountLeft
-func (c *CollectComponentsUntilPlayerCountLeft) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PassBid
+func (p *PassBid) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPassBidReader{p}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectComponentsUntilPlayerCountLeft
-func (c *CollectComponentsUntilPlayerCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PassBid
+func (p *PassBid) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPassBidReader{p}
 }
 
-// Implementation for CollectComponentsUntilGameCountReached
+// Implementation for ResolveAuction
 
-var ȧutoGeneratedCollectComponentsUntilGameCountReachedReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedResolveAuctionReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedCollectComponentsUntilGameCountReachedReader struct {
-	data *CollectComponentsUntilGameCountReached
+type ȧutoGeneratedResolveAuctionReader struct {
+	data *ResolveAuction
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCollectComponentsUntilGameCountReachedReaderProps
+func (r *ȧutoGeneratedResolveAuctionReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedResolveAuctionReaderProps
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) Prop(name string) (interface{}, error) {
-	props := c.Props()
+func (r *ȧutoGeneratedResolveAuctionReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2334,44 +2384,44 @@ func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) Prop(name s
 
 	switch propType {
 	case boardgame.TypeInt:
-		return c.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return c.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return c.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return c.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return c.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return c.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return c.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return c.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return c.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return c.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return c.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return c.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedResolveAuctionReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetProp(name string, value interface{}) error {
-	props := c.Props()
+func (r *ȧutoGeneratedResolveAuctionReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2384,25 +2434,25 @@ func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetProp(nam
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -2410,25 +2460,25 @@ func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetProp(nam
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -2441,8 +2491,8 @@ func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetProp(nam
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureProp(name string, value interface{}) error {
-	props := c.Props()
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2455,335 +2505,335 @@ func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigurePr
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return c.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return c.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return c.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if c.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return c.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return c.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return c.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return c.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return c.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return c.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if c.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return c.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return c.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if c.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return c.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return c.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if c.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return c.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return c.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedResolveAuctionReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedResolveAuctionReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedResolveAuctionReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CollectComponentsUntilGameCountReached
-func (c *CollectComponentsUntilGameCountReached) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for ResolveAuction
+func (r *ResolveAuction) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedResolveAuctionReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectComponentsUntilGameCountReached
-func (c *CollectComponentsUntilGameCountReached) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ResolveAuction
+func (r *ResolveAuction) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedResolveAuctionReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectComponentsUntilGameCountReached
-func (c *CollectComponentsUntilGameCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ResolveAuction
+func (r *ResolveAuction) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedResolveAuctionReader{r}
 }
 
-// Implementation for CollectAllComponents
+// Implementation for CollectCountComponents
 
-var ȧutoGeneratedCollectAllComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedCollectCountComponentsReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedCollectAllComponentsReader struct {
-	data *CollectAllComponents
+type ȧutoGeneratedCollectCountComponentsReader struct {
+	data *CollectCountComponents
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCollectAllComponentsReaderProps
+func (c *ȧutoGeneratedCollectCountComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCollectCountComponentsReaderProps
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) Prop(name string) (interface{}, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) Prop(name string) (interface{}, error) {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -2822,14 +2872,14 @@ func (c *ȧutoGeneratedCollectAllComponentsReader) Prop(name string) (interface{
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCollectCountComponentsReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -2900,7 +2950,7 @@ func (c *ȧutoGeneratedCollectAllComponentsReader) SetProp(name string, value in
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -3023,228 +3073,226 @@ func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureProp(name string, va
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCollectCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCollectAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCollectCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CollectAllComponents
-func (c *CollectAllComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCollectAllComponentsReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for CollectCountComponents
+func (c *CollectCountComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCollectCountComponentsReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectAllComponents
-func (c *CollectAllComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCollectAllComponentsReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectCountComponents
+func (c *CollectCountComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCollectCountComponentsReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectAllComponents
-func (c *CollectAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCollectAllComponentsReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectCountComponents
+func (c *CollectCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCollectCountComponentsReader{c}
 }
 
-// Implementation for CurrentPlayer
+// Implementation for CollectComponentsUntilPlayerCountLeft
 
-var ȧutoGeneratedCurrentPlayerReaderProps = map[string]boardgame.PropertyType{
-	"TargetPlayerIndex": boardgame.TypePlayerIndex,
-}
+var ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedCurrentPlayerReader struct {
-	data *CurrentPlayer
+type ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader struct {
+	data *CollectComponentsUntilPlayerCountLeft
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCurrentPlayerReaderProps
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReaderProps
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) Prop(name string) (interface{}, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) Prop(name string) (interface{}, error) {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -3283,16 +3331,14 @@ func (c *ȧutoGeneratedCurrentPlayerReader) Prop(name string) (interface{}, erro
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PropMutable(name string) bool {
 	switch name {
-	case "TargetPlayerIndex":
-		return true
 	}
 
 	return false
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -3363,7 +3409,7 @@ func (c *ȧutoGeneratedCurrentPlayerReader) SetProp(name string, value interface
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -3486,240 +3532,227 @@ func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
-
-	switch name {
-	case "TargetPlayerIndex":
-		return c.data.TargetPlayerIndex, nil
-
-	}
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
-
-	switch name {
-	case "TargetPlayerIndex":
-		c.data.TargetPlayerIndex = value
-		return nil
-
-	}
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCurrentPlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CurrentPlayer
-func (c *CurrentPlayer) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCurrentPlayerReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for CollectComponentsUntilPlayerCountLeft
+func (c *CollectComponentsUntilPlayerCountLeft) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CurrentPlayer
-func (c *CurrentPlayer) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCurrentPlayerReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectComponentsUntilPlayerCountLeft
+func (c *CollectComponentsUntilPlayerCountLeft) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CurrentPlayer
-func (c *CurrentPlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCurrentPlayerReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectComponentsUntilPlayerCountLeft
+func (c *CollectComponentsUntilPlayerCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCollectComponentsUntilPlayerCountLeftReader{c}
 }
 
-// Implementation for DealCountComponents
+// Implementation for CollectComponentsUntilGameCountReached
 
-var ȧutoGeneratedDealCountComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedCollectComponentsUntilGameCountReachedReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedDealCountComponentsReader struct {
-	data *DealCountComponents
+type ȧutoGeneratedCollectComponentsUntilGameCountReachedReader struct {
+	data *CollectComponentsUntilGameCountReached
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDealCountComponentsReaderProps
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCollectComponentsUntilGameCountReachedReaderProps
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) Prop(name string) (interface{}, error) {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -3728,44 +3761,44 @@ func (d *ȧutoGeneratedDealCountComponentsReader) Prop(name string) (interface{}
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return c.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return c.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return c.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return c.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return c.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return c.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return c.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return c.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return c.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return c.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return c.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return c.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -3778,25 +3811,25 @@ func (d *ȧutoGeneratedDealCountComponentsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -3804,25 +3837,25 @@ func (d *ȧutoGeneratedDealCountComponentsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -3835,8 +3868,8 @@ func (d *ȧutoGeneratedDealCountComponentsReader) SetProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -3849,336 +3882,336 @@ func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureProp(name string, val
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return c.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return c.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return c.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return c.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return c.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return c.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return c.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return c.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCollectComponentsUntilGameCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for DealCountComponents
-func (d *DealCountComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDealCountComponentsReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for CollectComponentsUntilGameCountReached
+func (c *CollectComponentsUntilGameCountReached) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealCountComponents
-func (d *DealCountComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDealCountComponentsReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectComponentsUntilGameCountReached
+func (c *CollectComponentsUntilGameCountReached) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealCountComponents
-func (d *DealCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDealCountComponentsReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectComponentsUntilGameCountReached
+func (c *CollectComponentsUntilGameCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCollectComponentsUntilGameCountReachedReader{c}
 }
 
-// Implementation for DealComponentsUntilPlayerCountReached
+// Implementation for CollectAllComponents
 
-var ȧutoGeneratedDealComponentsUntilPlayerCountReachedReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedCollectAllComponentsReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader struct {
-	data *DealComponentsUntilPlayerCountReached
+type ȧutoGeneratedCollectAllComponentsReader struct {
+	data *CollectAllComponents
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDealComponentsUntilPlayerCountReachedReaderProps
+func (c *ȧutoGeneratedCollectAllComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCollectAllComponentsReaderProps
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectAllComponentsReader) Prop(name string) (interface{}, error) {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4187,44 +4220,44 @@ func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) Prop(name st
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return c.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return c.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return c.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return c.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return c.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return c.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return c.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return c.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return c.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return c.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return c.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return c.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCollectAllComponentsReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4237,25 +4270,25 @@ func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetProp(name
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -4263,25 +4296,25 @@ func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetProp(name
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -4294,8 +4327,8 @@ func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetProp(name
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4308,336 +4341,339 @@ func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigurePro
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return c.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return c.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return c.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return c.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return c.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return c.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return c.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return c.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCollectAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCollectAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for DealComponentsUntilPlayerCountReached
-func (d *DealComponentsUntilPlayerCountReached) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for CollectAllComponents
+func (c *CollectAllComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCollectAllComponentsReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealComponentsUntilPlayerCountReached
-func (d *DealComponentsUntilPlayerCountReached) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CollectAllComponents
+func (c *CollectAllComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCollectAllComponentsReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealComponentsUntilPlayerCountReached
-func (d *DealComponentsUntilPlayerCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CollectAllComponents
+func (c *CollectAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCollectAllComponentsReader{c}
 }
 
-// Implementation for DealComponentsUntilGameCountLeft
+// Implementation for CommitAction
 
-var ȧutoGeneratedDealComponentsUntilGameCountLeftReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedCommitActionReaderProps = map[string]boardgame.PropertyType{
+	"Choice":            boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedDealComponentsUntilGameCountLeftReader struct {
-	data *DealComponentsUntilGameCountLeft
+type ȧutoGeneratedCommitActionReader struct {
+	data *CommitAction
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDealComponentsUntilGameCountLeftReaderProps
+func (c *ȧutoGeneratedCommitActionReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCommitActionReaderProps
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
+func (c *ȧutoGeneratedCommitActionReader) Prop(name string) (interface{}, error) {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4646,44 +4682,48 @@ func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) Prop(name string)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return c.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return c.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return c.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return c.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return c.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return c.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return c.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return c.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return c.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return c.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return c.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return c.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCommitActionReader) PropMutable(name string) bool {
 	switch name {
+	case "Choice":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCommitActionReader) SetProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4696,25 +4736,25 @@ func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetProp(name stri
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -4722,25 +4762,25 @@ func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetProp(name stri
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -4753,8 +4793,8 @@ func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetProp(name stri
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCommitActionReader) ConfigureProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -4767,336 +4807,362 @@ func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureProp(nam
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return c.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return c.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return c.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return c.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return c.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return c.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return c.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return c.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCommitActionReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Choice":
+		return c.data.Choice, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCommitActionReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Choice":
+		c.data.Choice = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCommitActionReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCommitActionReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCommitActionReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCommitActionReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCommitActionReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return c.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCommitActionReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		c.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCommitActionReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCommitActionReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCommitActionReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCommitActionReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCommitActionReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCommitActionReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCommitActionReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCommitActionReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCommitActionReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCommitActionReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCommitActionReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCommitActionReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCommitActionReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCommitActionReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCommitActionReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCommitActionReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCommitActionReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for DealComponentsUntilGameCountLeft
-func (d *DealComponentsUntilGameCountLeft) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for CommitAction
+func (c *CommitAction) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCommitActionReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealComponentsUntilGameCountLeft
-func (d *DealComponentsUntilGameCountLeft) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CommitAction
+func (c *CommitAction) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCommitActionReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealComponentsUntilGameCountLeft
-func (d *DealComponentsUntilGameCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CommitAction
+func (c *CommitAction) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCommitActionReader{c}
 }
 
-// Implementation for DealAllComponents
+// Implementation for RevealActions
 
-var ȧutoGeneratedDealAllComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedRevealActionsReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedDealAllComponentsReader struct {
-	data *DealAllComponents
+type ȧutoGeneratedRevealActionsReader struct {
+	data *RevealActions
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDealAllComponentsReaderProps
+func (r *ȧutoGeneratedRevealActionsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRevealActionsReaderProps
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
+func (r *ȧutoGeneratedRevealActionsReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -5105,44 +5171,44 @@ func (d *ȧutoGeneratedDealAllComponentsReader) Prop(name string) (interface{},
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedRevealActionsReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (r *ȧutoGeneratedRevealActionsReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -5155,25 +5221,25 @@ func (d *ȧutoGeneratedDealAllComponentsReader) SetProp(name string, value inter
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -5181,25 +5247,25 @@ func (d *ȧutoGeneratedDealAllComponentsReader) SetProp(name string, value inter
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -5212,8 +5278,8 @@ func (d *ȧutoGeneratedDealAllComponentsReader) SetProp(name string, value inter
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -5226,337 +5292,339 @@ func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedRevealActionsReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedRevealActionsReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedRevealActionsReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedRevealActionsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedRevealActionsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedRevealActionsReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedRevealActionsReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedRevealActionsReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedRevealActionsReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedRevealActionsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedRevealActionsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedRevealActionsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedRevealActionsReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedRevealActionsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedRevealActionsReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedRevealActionsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedRevealActionsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDealAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedRevealActionsReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for DealAllComponents
-func (d *DealAllComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDealAllComponentsReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for RevealActions
+func (r *RevealActions) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRevealActionsReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealAllComponents
-func (d *DealAllComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDealAllComponentsReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RevealActions
+func (r *RevealActions) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRevealActionsReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealAllComponents
-func (d *DealAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDealAllComponentsReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RevealActions
+func (r *RevealActions) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRevealActionsReader{r}
 }
 
-// Implementation for Default
+// Implementation for CurrentPlayer
 
-var ȧutoGeneratedDefaultReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedCurrentPlayerReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedDefaultReader struct {
-	data *Default
+type ȧutoGeneratedCurrentPlayerReader struct {
+	data *CurrentPlayer
 }
 
-func (d *ȧutoGeneratedDefaultReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDefaultReaderProps
+func (c *ȧutoGeneratedCurrentPlayerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCurrentPlayerReaderProps
 }
 
-func (d *ȧutoGeneratedDefaultReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
-	propType, ok := props[name]
+func (c *ȧutoGeneratedCurrentPlayerReader) Prop(name string) (interface{}, error) {
+	props := c.Props()
+	propType, ok := props[name]
 
 	if !ok {
 		return nil, errors.New("No such property with that name: " + name)
@@ -5564,44 +5632,46 @@ func (d *ȧutoGeneratedDefaultReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return c.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return c.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return c.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return c.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return c.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return c.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return c.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return c.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return c.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return c.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return c.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return c.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCurrentPlayerReader) PropMutable(name string) bool {
 	switch name {
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCurrentPlayerReader) SetProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -5614,25 +5684,25 @@ func (d *ȧutoGeneratedDefaultReader) SetProp(name string, value interface{}) er
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -5640,25 +5710,25 @@ func (d *ȧutoGeneratedDefaultReader) SetProp(name string, value interface{}) er
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -5671,8 +5741,8 @@ func (d *ȧutoGeneratedDefaultReader) SetProp(name string, value interface{}) er
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureProp(name string, value interface{}) error {
+	props := c.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -5685,337 +5755,348 @@ func (d *ȧutoGeneratedDefaultReader) ConfigureProp(name string, value interface
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return c.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return c.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return c.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return c.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return c.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return c.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return c.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return c.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return c.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return c.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return c.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return c.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return c.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return c.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if c.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return c.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return c.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return c.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		c.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCurrentPlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCurrentPlayerReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for Default
-func (d *Default) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDefaultReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for CurrentPlayer
+func (c *CurrentPlayer) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCurrentPlayerReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Default
-func (d *Default) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDefaultReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CurrentPlayer
+func (c *CurrentPlayer) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCurrentPlayerReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Default
-func (d *Default) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDefaultReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CurrentPlayer
+func (c *CurrentPlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCurrentPlayerReader{c}
 }
 
-// Implementation for DefaultComponent
+// Implementation for DealCountComponents
 
-var ȧutoGeneratedDefaultComponentReaderProps = map[string]boardgame.PropertyType{
-	"ComponentIndex": boardgame.TypeInt,
-}
+var ȧutoGeneratedDealCountComponentsReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedDefaultComponentReader struct {
-	data *DefaultComponent
+type ȧutoGeneratedDealCountComponentsReader struct {
+	data *DealCountComponents
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDefaultComponentReaderProps
+func (d *ȧutoGeneratedDealCountComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDealCountComponentsReaderProps
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) Prop(name string) (interface{}, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) Prop(name string) (interface{}, error) {
 	props := d.Props()
 	propType, ok := props[name]
 
@@ -6054,16 +6135,14 @@ func (d *ȧutoGeneratedDefaultComponentReader) Prop(name string) (interface{}, e
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDealCountComponentsReader) PropMutable(name string) bool {
 	switch name {
-	case "ComponentIndex":
-		return true
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetProp(name string, value interface{}) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetProp(name string, value interface{}) error {
 	props := d.Props()
 	propType, ok := props[name]
 
@@ -6134,7 +6213,7 @@ func (d *ȧutoGeneratedDefaultComponentReader) SetProp(name string, value interf
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureProp(name string, value interface{}) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureProp(name string, value interface{}) error {
 	props := d.Props()
 	propType, ok := props[name]
 
@@ -6257,240 +6336,227 @@ func (d *ȧutoGeneratedDefaultComponentReader) ConfigureProp(name string, value
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) IntProp(name string) (int, error) {
-
-	switch name {
-	case "ComponentIndex":
-		return d.data.ComponentIndex, nil
-
-	}
+func (d *ȧutoGeneratedDealCountComponentsReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetIntProp(name string, value int) error {
-
-	switch name {
-	case "ComponentIndex":
-		d.data.ComponentIndex = value
-		return nil
-
-	}
+func (d *ȧutoGeneratedDealCountComponentsReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDealCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDefaultComponentReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDealCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for DefaultComponent
-func (d *DefaultComponent) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDefaultComponentReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for DealCountComponents
+func (d *DealCountComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDealCountComponentsReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DefaultComponent
-func (d *DefaultComponent) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDefaultComponentReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealCountComponents
+func (d *DealCountComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDealCountComponentsReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DefaultComponent
-func (d *DefaultComponent) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDefaultComponentReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealCountComponents
+func (d *DealCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDealCountComponentsReader{d}
 }
 
-// Implementation for FinishTurn
+// Implementation for DealComponentsUntilPlayerCountReached
 
-var ȧutoGeneratedFinishTurnReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedDealComponentsUntilPlayerCountReachedReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedFinishTurnReader struct {
-	data *FinishTurn
+type ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader struct {
+	data *DealComponentsUntilPlayerCountReached
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedFinishTurnReaderProps
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDealComponentsUntilPlayerCountReachedReaderProps
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) Prop(name string) (interface{}, error) {
-	props := f.Props()
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -6499,44 +6565,44 @@ func (f *ȧutoGeneratedFinishTurnReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return f.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return f.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return f.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return f.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return f.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return f.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return f.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return f.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return f.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return f.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return f.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return f.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -6549,25 +6615,25 @@ func (f *ȧutoGeneratedFinishTurnReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -6575,25 +6641,25 @@ func (f *ȧutoGeneratedFinishTurnReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -6606,8 +6672,8 @@ func (f *ȧutoGeneratedFinishTurnReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -6620,337 +6686,337 @@ func (f *ȧutoGeneratedFinishTurnReader) ConfigureProp(name string, value interf
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return f.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return f.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return f.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return f.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return f.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return f.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return f.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return f.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFinishTurnReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for FinishTurn
-func (f *FinishTurn) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedFinishTurnReader{f}
+//Reader returns an autp-generated boardgame.PropertyReader for DealComponentsUntilPlayerCountReached
+func (d *DealComponentsUntilPlayerCountReached) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FinishTurn
-func (f *FinishTurn) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedFinishTurnReader{f}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealComponentsUntilPlayerCountReached
+func (d *DealComponentsUntilPlayerCountReached) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FinishTurn
-func (f *FinishTurn) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedFinishTurnReader{f}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealComponentsUntilPlayerCountReached
+func (d *DealComponentsUntilPlayerCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDealComponentsUntilPlayerCountReachedReader{d}
 }
 
-// Implementation for FixUp
+// Implementation for DealComponentsUntilGameCountLeft
 
-var ȧutoGeneratedFixUpReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedDealComponentsUntilGameCountLeftReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedFixUpReader struct {
-	data *FixUp
+type ȧutoGeneratedDealComponentsUntilGameCountLeftReader struct {
+	data *DealComponentsUntilGameCountLeft
 }
 
-func (f *ȧutoGeneratedFixUpReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedFixUpReaderProps
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDealComponentsUntilGameCountLeftReaderProps
 }
 
-func (f *ȧutoGeneratedFixUpReader) Prop(name string) (interface{}, error) {
-	props := f.Props()
-	propType, ok := props[name]
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
+	propType, ok := props[name]
 
 	if !ok {
 		return nil, errors.New("No such property with that name: " + name)
@@ -6958,44 +7024,44 @@ func (f *ȧutoGeneratedFixUpReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return f.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return f.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return f.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return f.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return f.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return f.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return f.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return f.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return f.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return f.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return f.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return f.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7008,25 +7074,25 @@ func (f *ȧutoGeneratedFixUpReader) SetProp(name string, value interface{}) erro
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -7034,25 +7100,25 @@ func (f *ȧutoGeneratedFixUpReader) SetProp(name string, value interface{}) erro
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -7065,8 +7131,8 @@ func (f *ȧutoGeneratedFixUpReader) SetProp(name string, value interface{}) erro
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7079,336 +7145,336 @@ func (f *ȧutoGeneratedFixUpReader) ConfigureProp(name string, value interface{}
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return f.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return f.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return f.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return f.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return f.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return f.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return f.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return f.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDealComponentsUntilGameCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for FixUp
-func (f *FixUp) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedFixUpReader{f}
+//Reader returns an autp-generated boardgame.PropertyReader for DealComponentsUntilGameCountLeft
+func (d *DealComponentsUntilGameCountLeft) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FixUp
-func (f *FixUp) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedFixUpReader{f}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealComponentsUntilGameCountLeft
+func (d *DealComponentsUntilGameCountLeft) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FixUp
-func (f *FixUp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedFixUpReader{f}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealComponentsUntilGameCountLeft
+func (d *DealComponentsUntilGameCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDealComponentsUntilGameCountLeftReader{d}
 }
 
-// Implementation for FixUpMulti
+// Implementation for DealAllComponents
 
-var ȧutoGeneratedFixUpMultiReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedDealAllComponentsReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedFixUpMultiReader struct {
-	data *FixUpMulti
+type ȧutoGeneratedDealAllComponentsReader struct {
+	data *DealAllComponents
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedFixUpMultiReaderProps
+func (d *ȧutoGeneratedDealAllComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDealAllComponentsReaderProps
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) Prop(name string) (interface{}, error) {
-	props := f.Props()
+func (d *ȧutoGeneratedDealAllComponentsReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7417,44 +7483,44 @@ func (f *ȧutoGeneratedFixUpMultiReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return f.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return f.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return f.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return f.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return f.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return f.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return f.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return f.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return f.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return f.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return f.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return f.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDealAllComponentsReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealAllComponentsReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7467,25 +7533,25 @@ func (f *ȧutoGeneratedFixUpMultiReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -7493,25 +7559,25 @@ func (f *ȧutoGeneratedFixUpMultiReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -7524,8 +7590,8 @@ func (f *ȧutoGeneratedFixUpMultiReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureProp(name string, value interface{}) error {
-	props := f.Props()
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7538,338 +7604,336 @@ func (f *ȧutoGeneratedFixUpMultiReader) ConfigureProp(name string, value interf
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return f.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return f.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return f.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return f.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return f.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return f.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return f.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return f.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return f.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return f.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return f.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return f.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return f.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if f.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return f.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return f.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDealAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (f *ȧutoGeneratedFixUpMultiReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDealAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for FixUpMulti
-func (f *FixUpMulti) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedFixUpMultiReader{f}
+//Reader returns an autp-generated boardgame.PropertyReader for DealAllComponents
+func (d *DealAllComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDealAllComponentsReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FixUpMulti
-func (f *FixUpMulti) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedFixUpMultiReader{f}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DealAllComponents
+func (d *DealAllComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDealAllComponentsReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FixUpMulti
-func (f *FixUpMulti) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedFixUpMultiReader{f}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DealAllComponents
+func (d *DealAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDealAllComponentsReader{d}
 }
 
-// Implementation for ActivateInactivePlayer
+// Implementation for Default
 
-var ȧutoGeneratedActivateInactivePlayerReaderProps = map[string]boardgame.PropertyType{
-	"TargetPlayerIndex": boardgame.TypePlayerIndex,
-}
+var ȧutoGeneratedDefaultReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedActivateInactivePlayerReader struct {
-	data *ActivateInactivePlayer
+type ȧutoGeneratedDefaultReader struct {
+	data *Default
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedActivateInactivePlayerReaderProps
+func (d *ȧutoGeneratedDefaultReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDefaultReaderProps
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) Prop(name string) (interface{}, error) {
-	props := a.Props()
+func (d *ȧutoGeneratedDefaultReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7878,46 +7942,44 @@ func (a *ȧutoGeneratedActivateInactivePlayerReader) Prop(name string) (interfac
 
 	switch propType {
 	case boardgame.TypeInt:
-		return a.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return a.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return a.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return a.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return a.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return a.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return a.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return a.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return a.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return a.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return a.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return a.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDefaultReader) PropMutable(name string) bool {
 	switch name {
-	case "TargetPlayerIndex":
-		return true
 	}
 
 	return false
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetProp(name string, value interface{}) error {
-	props := a.Props()
+func (d *ȧutoGeneratedDefaultReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -7930,25 +7992,25 @@ func (a *ȧutoGeneratedActivateInactivePlayerReader) SetProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return a.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return a.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return a.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return a.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -7956,25 +8018,25 @@ func (a *ȧutoGeneratedActivateInactivePlayerReader) SetProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return a.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return a.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return a.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return a.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -7987,8 +8049,8 @@ func (a *ȧutoGeneratedActivateInactivePlayerReader) SetProp(name string, value
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureProp(name string, value interface{}) error {
-	props := a.Props()
+func (d *ȧutoGeneratedDefaultReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8001,349 +8063,338 @@ func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureProp(name string,
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return a.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return a.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return a.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return a.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if a.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return a.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return a.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return a.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return a.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return a.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return a.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if a.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return a.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return a.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if a.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return a.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return a.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if a.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return a.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return a.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDefaultReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDefaultReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDefaultReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDefaultReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDefaultReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDefaultReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
-
-	switch name {
-	case "TargetPlayerIndex":
-		return a.data.TargetPlayerIndex, nil
-
-	}
+func (d *ȧutoGeneratedDefaultReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
-
-	switch name {
-	case "TargetPlayerIndex":
-		a.data.TargetPlayerIndex = value
-		return nil
-
-	}
+func (d *ȧutoGeneratedDefaultReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDefaultReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDefaultReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDefaultReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDefaultReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDefaultReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDefaultReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDefaultReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDefaultReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDefaultReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDefaultReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDefaultReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDefaultReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDefaultReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDefaultReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDefaultReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDefaultReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (a *ȧutoGeneratedActivateInactivePlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDefaultReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for ActivateInactivePlayer
-func (a *ActivateInactivePlayer) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+//Reader returns an autp-generated boardgame.PropertyReader for Default
+func (d *Default) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDefaultReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ActivateInactivePlayer
-func (a *ActivateInactivePlayer) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Default
+func (d *Default) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDefaultReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ActivateInactivePlayer
-func (a *ActivateInactivePlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Default
+func (d *Default) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDefaultReader{d}
 }
 
-// Implementation for Increment
+// Implementation for DefaultComponent
 
-var ȧutoGeneratedIncrementReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedDefaultComponentReaderProps = map[string]boardgame.PropertyType{
+	"ComponentIndex": boardgame.TypeInt,
+}
 
-type ȧutoGeneratedIncrementReader struct {
-	data *Increment
+type ȧutoGeneratedDefaultComponentReader struct {
+	data *DefaultComponent
 }
 
-func (i *ȧutoGeneratedIncrementReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedIncrementReaderProps
+func (d *ȧutoGeneratedDefaultComponentReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDefaultComponentReaderProps
 }
 
-func (i *ȧutoGeneratedIncrementReader) Prop(name string) (interface{}, error) {
-	props := i.Props()
+func (d *ȧutoGeneratedDefaultComponentReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8352,44 +8403,46 @@ func (i *ȧutoGeneratedIncrementReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return i.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return i.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return i.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return i.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return i.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return i.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return i.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return i.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return i.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return i.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return i.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return i.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedIncrementReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDefaultComponentReader) PropMutable(name string) bool {
 	switch name {
+	case "ComponentIndex":
+		return true
 	}
 
 	return false
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetProp(name string, value interface{}) error {
-	props := i.Props()
+func (d *ȧutoGeneratedDefaultComponentReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8402,25 +8455,25 @@ func (i *ȧutoGeneratedIncrementReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return i.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return i.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return i.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -8428,25 +8481,25 @@ func (i *ȧutoGeneratedIncrementReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return i.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return i.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return i.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -8459,8 +8512,8 @@ func (i *ȧutoGeneratedIncrementReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureProp(name string, value interface{}) error {
-	props := i.Props()
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8473,338 +8526,351 @@ func (i *ȧutoGeneratedIncrementReader) ConfigureProp(name string, value interfa
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return i.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return i.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return i.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if i.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return i.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return i.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return i.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return i.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return i.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if i.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return i.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return i.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if i.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return i.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return i.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if i.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return i.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return i.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedIncrementReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "ComponentIndex":
+		return d.data.ComponentIndex, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "ComponentIndex":
+		d.data.ComponentIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDefaultComponentReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDefaultComponentReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedIncrementReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDefaultComponentReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for Increment
-func (i *Increment) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedIncrementReader{i}
+//Reader returns an autp-generated boardgame.PropertyReader for DefaultComponent
+func (d *DefaultComponent) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDefaultComponentReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Increment
-func (i *Increment) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedIncrementReader{i}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DefaultComponent
+func (d *DefaultComponent) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDefaultComponentReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Increment
-func (i *Increment) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedIncrementReader{i}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DefaultComponent
+func (d *DefaultComponent) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDefaultComponentReader{d}
 }
 
-// Implementation for errorMove
+// Implementation for RollDice
 
-var ȧutoGeneratedErrorMoveReaderProps = map[string]boardgame.PropertyType{
-	"Message": boardgame.TypeString,
+var ȧutoGeneratedRollDiceReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
 }
 
-type ȧutoGeneratedErrorMoveReader struct {
-	data *errorMove
+type ȧutoGeneratedRollDiceReader struct {
+	data *RollDice
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedErrorMoveReaderProps
+func (r *ȧutoGeneratedRollDiceReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRollDiceReaderProps
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) Prop(name string) (interface{}, error) {
-	props := e.Props()
+func (r *ȧutoGeneratedRollDiceReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8813,46 +8879,46 @@ func (e *ȧutoGeneratedErrorMoveReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return e.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return e.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return e.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return e.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return e.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return e.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return e.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return e.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return e.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return e.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return e.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return e.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedRollDiceReader) PropMutable(name string) bool {
 	switch name {
-	case "Message":
+	case "TargetPlayerIndex":
 		return true
 	}
 
 	return false
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetProp(name string, value interface{}) error {
-	props := e.Props()
+func (r *ȧutoGeneratedRollDiceReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8865,25 +8931,25 @@ func (e *ȧutoGeneratedErrorMoveReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return e.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return e.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return e.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return e.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -8891,25 +8957,25 @@ func (e *ȧutoGeneratedErrorMoveReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return e.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return e.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return e.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return e.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -8922,8 +8988,8 @@ func (e *ȧutoGeneratedErrorMoveReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureProp(name string, value interface{}) error {
-	props := e.Props()
+func (r *ȧutoGeneratedRollDiceReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -8936,349 +9002,352 @@ func (e *ȧutoGeneratedErrorMoveReader) ConfigureProp(name string, value interfa
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return e.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return e.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return e.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return e.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if e.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return e.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return e.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return e.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return e.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return e.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return e.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if e.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return e.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return e.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if e.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return e.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return e.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if e.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return e.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return e.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedRollDiceReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedRollDiceReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedRollDiceReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedRollDiceReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedRollDiceReader) StringProp(name string) (string, error) {
 
-	switch name {
-	case "Message":
-		return e.data.Message, nil
+	return "", errors.New("No such String prop: " + name)
 
-	}
+}
 
-	return "", errors.New("No such String prop: " + name)
+func (r *ȧutoGeneratedRollDiceReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedRollDiceReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
-	case "Message":
-		e.data.Message = value
-		return nil
+	case "TargetPlayerIndex":
+		return r.data.TargetPlayerIndex, nil
 
 	}
 
-	return errors.New("No such String prop: " + name)
+	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
-
-	return 0, errors.New("No such PlayerIndex prop: " + name)
+func (r *ȧutoGeneratedRollDiceReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
-}
+	switch name {
+	case "TargetPlayerIndex":
+		r.data.TargetPlayerIndex = value
+		return nil
 
-func (e *ȧutoGeneratedErrorMoveReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedRollDiceReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedRollDiceReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedRollDiceReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedRollDiceReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedRollDiceReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedRollDiceReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedRollDiceReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedRollDiceReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedRollDiceReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedRollDiceReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedRollDiceReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedRollDiceReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedRollDiceReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedRollDiceReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedRollDiceReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedRollDiceReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (e *ȧutoGeneratedErrorMoveReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedRollDiceReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for errorMove
-func (e *errorMove) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedErrorMoveReader{e}
+//Reader returns an autp-generated boardgame.PropertyReader for RollDice
+func (r *RollDice) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRollDiceReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for errorMove
-func (e *errorMove) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedErrorMoveReader{e}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RollDice
+func (r *RollDice) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRollDiceReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for errorMove
-func (e *errorMove) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedErrorMoveReader{e}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RollDice
+func (r *RollDice) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRollDiceReader{r}
 }
 
-// Implementation for MoveCountComponents
+// Implementation for RerollSelected
 
-var ȧutoGeneratedMoveCountComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedRerollSelectedReaderProps = map[string]boardgame.PropertyType{
+	"ComponentIndexes":  boardgame.TypeIntSlice,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedMoveCountComponentsReader struct {
-	data *MoveCountComponents
+type ȧutoGeneratedRerollSelectedReader struct {
+	data *RerollSelected
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveCountComponentsReaderProps
+func (r *ȧutoGeneratedRerollSelectedReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRerollSelectedReaderProps
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) Prop(name string) (interface{}, error) {
-	props := m.Props()
+func (r *ȧutoGeneratedRerollSelectedReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9287,44 +9356,48 @@ func (m *ȧutoGeneratedMoveCountComponentsReader) Prop(name string) (interface{}
 
 	switch propType {
 	case boardgame.TypeInt:
-		return m.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return m.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return m.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return m.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return m.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return m.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return m.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return m.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return m.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return m.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return m.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return m.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedRerollSelectedReader) PropMutable(name string) bool {
 	switch name {
+	case "ComponentIndexes":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetProp(name string, value interface{}) error {
-	props := m.Props()
+func (r *ȧutoGeneratedRerollSelectedReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9337,25 +9410,25 @@ func (m *ȧutoGeneratedMoveCountComponentsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -9363,25 +9436,25 @@ func (m *ȧutoGeneratedMoveCountComponentsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -9394,8 +9467,8 @@ func (m *ȧutoGeneratedMoveCountComponentsReader) SetProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureProp(name string, value interface{}) error {
-	props := m.Props()
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9408,336 +9481,365 @@ func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureProp(name string, val
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if m.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return m.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return m.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if m.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return m.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return m.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if m.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return m.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return m.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if m.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return m.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return m.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return r.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		r.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) IntSliceProp(name string) ([]int, error) {
+
+	switch name {
+	case "ComponentIndexes":
+		return r.data.ComponentIndexes, nil
+
+	}
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetIntSliceProp(name string, value []int) error {
+
+	switch name {
+	case "ComponentIndexes":
+		r.data.ComponentIndexes = value
+		return nil
+
+	}
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedRerollSelectedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedRerollSelectedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedRerollSelectedReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for MoveCountComponents
-func (m *MoveCountComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveCountComponentsReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for RerollSelected
+func (r *RerollSelected) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRerollSelectedReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveCountComponents
-func (m *MoveCountComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveCountComponentsReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RerollSelected
+func (r *RerollSelected) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRerollSelectedReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveCountComponents
-func (m *MoveCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveCountComponentsReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RerollSelected
+func (r *RerollSelected) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRerollSelectedReader{r}
 }
 
-// Implementation for MoveComponentsUntilCountReached
+// Implementation for LockDie
 
-var ȧutoGeneratedMoveComponentsUntilCountReachedReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedLockDieReaderProps = map[string]boardgame.PropertyType{
+	"ComponentIndex":    boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedMoveComponentsUntilCountReachedReader struct {
-	data *MoveComponentsUntilCountReached
+type ȧutoGeneratedLockDieReader struct {
+	data *LockDie
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveComponentsUntilCountReachedReaderProps
+func (l *ȧutoGeneratedLockDieReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedLockDieReaderProps
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) Prop(name string) (interface{}, error) {
-	props := m.Props()
+func (l *ȧutoGeneratedLockDieReader) Prop(name string) (interface{}, error) {
+	props := l.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9746,44 +9848,48 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) Prop(name string)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return m.IntProp(name)
+		return l.IntProp(name)
 	case boardgame.TypeBool:
-		return m.BoolProp(name)
+		return l.BoolProp(name)
 	case boardgame.TypeString:
-		return m.StringProp(name)
+		return l.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return m.PlayerIndexProp(name)
+		return l.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return m.ImmutableEnumProp(name)
+		return l.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return m.IntSliceProp(name)
+		return l.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return m.BoolSliceProp(name)
+		return l.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return m.StringSliceProp(name)
+		return l.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return m.PlayerIndexSliceProp(name)
+		return l.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return m.ImmutableStackProp(name)
+		return l.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return m.ImmutableBoardProp(name)
+		return l.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return m.ImmutableTimerProp(name)
+		return l.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PropMutable(name string) bool {
+func (l *ȧutoGeneratedLockDieReader) PropMutable(name string) bool {
 	switch name {
+	case "ComponentIndex":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetProp(name string, value interface{}) error {
-	props := m.Props()
+func (l *ȧutoGeneratedLockDieReader) SetProp(name string, value interface{}) error {
+	props := l.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9796,25 +9902,25 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetProp(name strin
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return l.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return l.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return l.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return l.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -9822,25 +9928,25 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetProp(name strin
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return l.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return l.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return l.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return l.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -9853,8 +9959,8 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetProp(name strin
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureProp(name string, value interface{}) error {
-	props := m.Props()
+func (l *ȧutoGeneratedLockDieReader) ConfigureProp(name string, value interface{}) error {
+	props := l.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -9867,336 +9973,365 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureProp(name
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return l.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return l.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return l.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return l.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if m.PropMutable(name) {
+		if l.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return m.ConfigureEnumProp(name, val)
+			return l.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return m.ConfigureImmutableEnumProp(name, val)
+		return l.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return l.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return l.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return l.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return l.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if m.PropMutable(name) {
+		if l.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return m.ConfigureStackProp(name, val)
+			return l.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return m.ConfigureImmutableStackProp(name, val)
+		return l.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if m.PropMutable(name) {
+		if l.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return m.ConfigureBoardProp(name, val)
+			return l.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return m.ConfigureImmutableBoardProp(name, val)
+		return l.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if m.PropMutable(name) {
+		if l.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return m.ConfigureTimerProp(name, val)
+			return l.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return m.ConfigureImmutableTimerProp(name, val)
+		return l.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) IntProp(name string) (int, error) {
+func (l *ȧutoGeneratedLockDieReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "ComponentIndex":
+		return l.data.ComponentIndex, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetIntProp(name string, value int) error {
+func (l *ȧutoGeneratedLockDieReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "ComponentIndex":
+		l.data.ComponentIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoolProp(name string) (bool, error) {
+func (l *ȧutoGeneratedLockDieReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetBoolProp(name string, value bool) error {
+func (l *ȧutoGeneratedLockDieReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StringProp(name string) (string, error) {
+func (l *ȧutoGeneratedLockDieReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetStringProp(name string, value string) error {
+func (l *ȧutoGeneratedLockDieReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (l *ȧutoGeneratedLockDieReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return l.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (l *ȧutoGeneratedLockDieReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		l.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (l *ȧutoGeneratedLockDieReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) EnumProp(name string) (enum.Val, error) {
+func (l *ȧutoGeneratedLockDieReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) IntSliceProp(name string) ([]int, error) {
+func (l *ȧutoGeneratedLockDieReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetIntSliceProp(name string, value []int) error {
+func (l *ȧutoGeneratedLockDieReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
+func (l *ȧutoGeneratedLockDieReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
+func (l *ȧutoGeneratedLockDieReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StringSliceProp(name string) ([]string, error) {
+func (l *ȧutoGeneratedLockDieReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetStringSliceProp(name string, value []string) error {
+func (l *ȧutoGeneratedLockDieReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (l *ȧutoGeneratedLockDieReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (l *ȧutoGeneratedLockDieReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (l *ȧutoGeneratedLockDieReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
+func (l *ȧutoGeneratedLockDieReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (l *ȧutoGeneratedLockDieReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
+func (l *ȧutoGeneratedLockDieReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (l *ȧutoGeneratedLockDieReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (l *ȧutoGeneratedLockDieReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
+func (l *ȧutoGeneratedLockDieReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for MoveComponentsUntilCountReached
-func (m *MoveComponentsUntilCountReached) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for LockDie
+func (l *LockDie) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedLockDieReader{l}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveComponentsUntilCountReached
-func (m *MoveComponentsUntilCountReached) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for LockDie
+func (l *LockDie) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedLockDieReader{l}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveComponentsUntilCountReached
-func (m *MoveComponentsUntilCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for LockDie
+func (l *LockDie) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedLockDieReader{l}
 }
 
-// Implementation for MoveComponentsUntilCountLeft
+// Implementation for DraftPick
 
-var ȧutoGeneratedMoveComponentsUntilCountLeftReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedDraftPickReaderProps = map[string]boardgame.PropertyType{
+	"ComponentIndex":    boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedMoveComponentsUntilCountLeftReader struct {
-	data *MoveComponentsUntilCountLeft
+type ȧutoGeneratedDraftPickReader struct {
+	data *DraftPick
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveComponentsUntilCountLeftReaderProps
+func (d *ȧutoGeneratedDraftPickReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDraftPickReaderProps
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) Prop(name string) (interface{}, error) {
-	props := m.Props()
+func (d *ȧutoGeneratedDraftPickReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -10205,44 +10340,48 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) Prop(name string) (in
 
 	switch propType {
 	case boardgame.TypeInt:
-		return m.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return m.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return m.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return m.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return m.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return m.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return m.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return m.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return m.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return m.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return m.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return m.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDraftPickReader) PropMutable(name string) bool {
 	switch name {
+	case "ComponentIndex":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetProp(name string, value interface{}) error {
-	props := m.Props()
+func (d *ȧutoGeneratedDraftPickReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -10255,25 +10394,25 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetProp(name string,
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -10281,25 +10420,25 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetProp(name string,
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -10312,7 +10451,5592 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetProp(name string,
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureProp(name string, value interface{}) error {
+func (d *ȧutoGeneratedDraftPickReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return d.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return d.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return d.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return d.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return d.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return d.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return d.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return d.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return d.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return d.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return d.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return d.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return d.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return d.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (d *ȧutoGeneratedDraftPickReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "ComponentIndex":
+		return d.data.ComponentIndex, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "ComponentIndex":
+		d.data.ComponentIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return d.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		d.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDraftPickReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for DraftPick
+func (d *DraftPick) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDraftPickReader{d}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DraftPick
+func (d *DraftPick) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDraftPickReader{d}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DraftPick
+func (d *DraftPick) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDraftPickReader{d}
+}
+
+// Implementation for RotateHands
+
+var ȧutoGeneratedRotateHandsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedRotateHandsReader struct {
+	data *RotateHands
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRotateHandsReaderProps
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return r.IntProp(name)
+	case boardgame.TypeBool:
+		return r.BoolProp(name)
+	case boardgame.TypeString:
+		return r.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return r.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return r.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return r.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return r.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return r.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return r.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return r.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return r.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return r.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return r.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return r.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return r.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return r.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return r.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return r.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return r.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return r.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRotateHandsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for RotateHands
+func (r *RotateHands) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRotateHandsReader{r}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RotateHands
+func (r *RotateHands) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRotateHandsReader{r}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RotateHands
+func (r *RotateHands) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRotateHandsReader{r}
+}
+
+// Implementation for FinishTurn
+
+var ȧutoGeneratedFinishTurnReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedFinishTurnReader struct {
+	data *FinishTurn
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedFinishTurnReaderProps
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) Prop(name string) (interface{}, error) {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return f.IntProp(name)
+	case boardgame.TypeBool:
+		return f.BoolProp(name)
+	case boardgame.TypeString:
+		return f.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return f.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return f.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return f.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return f.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return f.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return f.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return f.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return f.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return f.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return f.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return f.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return f.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return f.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return f.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return f.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return f.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return f.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFinishTurnReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for FinishTurn
+func (f *FinishTurn) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedFinishTurnReader{f}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FinishTurn
+func (f *FinishTurn) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedFinishTurnReader{f}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FinishTurn
+func (f *FinishTurn) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedFinishTurnReader{f}
+}
+
+// Implementation for FixUp
+
+var ȧutoGeneratedFixUpReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedFixUpReader struct {
+	data *FixUp
+}
+
+func (f *ȧutoGeneratedFixUpReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedFixUpReaderProps
+}
+
+func (f *ȧutoGeneratedFixUpReader) Prop(name string) (interface{}, error) {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return f.IntProp(name)
+	case boardgame.TypeBool:
+		return f.BoolProp(name)
+	case boardgame.TypeString:
+		return f.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return f.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return f.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return f.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return f.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return f.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return f.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return f.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return f.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return f.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return f.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return f.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return f.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return f.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return f.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return f.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return f.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return f.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for FixUp
+func (f *FixUp) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedFixUpReader{f}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FixUp
+func (f *FixUp) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedFixUpReader{f}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FixUp
+func (f *FixUp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedFixUpReader{f}
+}
+
+// Implementation for FixUpMulti
+
+var ȧutoGeneratedFixUpMultiReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedFixUpMultiReader struct {
+	data *FixUpMulti
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedFixUpMultiReaderProps
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) Prop(name string) (interface{}, error) {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return f.IntProp(name)
+	case boardgame.TypeBool:
+		return f.BoolProp(name)
+	case boardgame.TypeString:
+		return f.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return f.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return f.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return f.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return f.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return f.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return f.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return f.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return f.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return f.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureProp(name string, value interface{}) error {
+	props := f.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return f.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return f.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return f.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return f.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return f.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return f.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return f.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return f.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return f.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return f.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return f.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return f.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return f.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if f.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return f.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return f.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (f *ȧutoGeneratedFixUpMultiReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for FixUpMulti
+func (f *FixUpMulti) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedFixUpMultiReader{f}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for FixUpMulti
+func (f *FixUpMulti) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedFixUpMultiReader{f}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for FixUpMulti
+func (f *FixUpMulti) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedFixUpMultiReader{f}
+}
+
+// Implementation for ActivateInactivePlayer
+
+var ȧutoGeneratedActivateInactivePlayerReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedActivateInactivePlayerReader struct {
+	data *ActivateInactivePlayer
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedActivateInactivePlayerReaderProps
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) Prop(name string) (interface{}, error) {
+	props := a.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return a.IntProp(name)
+	case boardgame.TypeBool:
+		return a.BoolProp(name)
+	case boardgame.TypeString:
+		return a.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return a.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return a.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return a.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return a.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return a.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return a.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return a.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return a.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return a.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetProp(name string, value interface{}) error {
+	props := a.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return a.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return a.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return a.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return a.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return a.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return a.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return a.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return a.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureProp(name string, value interface{}) error {
+	props := a.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return a.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return a.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return a.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return a.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if a.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return a.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return a.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return a.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return a.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return a.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return a.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if a.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return a.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return a.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if a.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return a.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return a.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if a.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return a.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return a.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return a.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		a.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (a *ȧutoGeneratedActivateInactivePlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for ActivateInactivePlayer
+func (a *ActivateInactivePlayer) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ActivateInactivePlayer
+func (a *ActivateInactivePlayer) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ActivateInactivePlayer
+func (a *ActivateInactivePlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedActivateInactivePlayerReader{a}
+}
+
+// Implementation for InactivatePlayer
+
+var ȧutoGeneratedInactivatePlayerReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedInactivatePlayerReader struct {
+	data *InactivatePlayer
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedInactivatePlayerReaderProps
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) Prop(name string) (interface{}, error) {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return i.IntProp(name)
+	case boardgame.TypeBool:
+		return i.BoolProp(name)
+	case boardgame.TypeString:
+		return i.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return i.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return i.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return i.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return i.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return i.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return i.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return i.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return i.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return i.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return i.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return i.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return i.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return i.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return i.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return i.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return i.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return i.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return i.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return i.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return i.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return i.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return i.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return i.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return i.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return i.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return i.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return i.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return i.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		i.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedInactivatePlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for InactivatePlayer
+func (i *InactivatePlayer) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedInactivatePlayerReader{i}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for InactivatePlayer
+func (i *InactivatePlayer) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedInactivatePlayerReader{i}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for InactivatePlayer
+func (i *InactivatePlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedInactivatePlayerReader{i}
+}
+
+// Implementation for Increment
+
+var ȧutoGeneratedIncrementReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedIncrementReader struct {
+	data *Increment
+}
+
+func (i *ȧutoGeneratedIncrementReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedIncrementReaderProps
+}
+
+func (i *ȧutoGeneratedIncrementReader) Prop(name string) (interface{}, error) {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return i.IntProp(name)
+	case boardgame.TypeBool:
+		return i.BoolProp(name)
+	case boardgame.TypeString:
+		return i.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return i.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return i.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return i.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return i.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return i.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return i.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return i.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return i.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return i.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedIncrementReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return i.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return i.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return i.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return i.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return i.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return i.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return i.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return i.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return i.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return i.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return i.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return i.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return i.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return i.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return i.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return i.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if i.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return i.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return i.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedIncrementReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (i *ȧutoGeneratedIncrementReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for Increment
+func (i *Increment) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedIncrementReader{i}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Increment
+func (i *Increment) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedIncrementReader{i}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Increment
+func (i *Increment) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedIncrementReader{i}
+}
+
+// Implementation for errorMove
+
+var ȧutoGeneratedErrorMoveReaderProps = map[string]boardgame.PropertyType{
+	"Message": boardgame.TypeString,
+}
+
+type ȧutoGeneratedErrorMoveReader struct {
+	data *errorMove
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedErrorMoveReaderProps
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) Prop(name string) (interface{}, error) {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return e.IntProp(name)
+	case boardgame.TypeBool:
+		return e.BoolProp(name)
+	case boardgame.TypeString:
+		return e.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return e.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return e.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return e.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return e.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return e.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return e.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return e.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return e.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return e.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) PropMutable(name string) bool {
+	switch name {
+	case "Message":
+		return true
+	}
+
+	return false
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetProp(name string, value interface{}) error {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return e.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return e.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return e.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return e.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return e.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return e.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureProp(name string, value interface{}) error {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return e.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return e.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return e.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return e.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return e.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return e.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return e.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return e.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return e.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return e.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return e.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return e.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return e.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return e.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) StringProp(name string) (string, error) {
+
+	switch name {
+	case "Message":
+		return e.data.Message, nil
+
+	}
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetStringProp(name string, value string) error {
+
+	switch name {
+	case "Message":
+		e.data.Message = value
+		return nil
+
+	}
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedErrorMoveReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for errorMove
+func (e *errorMove) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedErrorMoveReader{e}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for errorMove
+func (e *errorMove) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedErrorMoveReader{e}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for errorMove
+func (e *errorMove) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedErrorMoveReader{e}
+}
+
+// Implementation for MoveCountComponents
+
+var ȧutoGeneratedMoveCountComponentsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveCountComponentsReader struct {
+	data *MoveCountComponents
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveCountComponentsReaderProps
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveCountComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for MoveCountComponents
+func (m *MoveCountComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveCountComponentsReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveCountComponents
+func (m *MoveCountComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveCountComponentsReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveCountComponents
+func (m *MoveCountComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveCountComponentsReader{m}
+}
+
+// Implementation for MoveComponentsUntilCountReached
+
+var ȧutoGeneratedMoveComponentsUntilCountReachedReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveComponentsUntilCountReachedReader struct {
+	data *MoveComponentsUntilCountReached
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveComponentsUntilCountReachedReaderProps
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountReachedReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for MoveComponentsUntilCountReached
+func (m *MoveComponentsUntilCountReached) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveComponentsUntilCountReached
+func (m *MoveComponentsUntilCountReached) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveComponentsUntilCountReached
+func (m *MoveComponentsUntilCountReached) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveComponentsUntilCountReachedReader{m}
+}
+
+// Implementation for MoveComponentsUntilCountLeft
+
+var ȧutoGeneratedMoveComponentsUntilCountLeftReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveComponentsUntilCountLeftReader struct {
+	data *MoveComponentsUntilCountLeft
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveComponentsUntilCountLeftReaderProps
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for MoveComponentsUntilCountLeft
+func (m *MoveComponentsUntilCountLeft) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveComponentsUntilCountLeft
+func (m *MoveComponentsUntilCountLeft) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveComponentsUntilCountLeft
+func (m *MoveComponentsUntilCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+}
+
+// Implementation for MoveAllComponents
+
+var ȧutoGeneratedMoveAllComponentsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveAllComponentsReader struct {
+	data *MoveAllComponents
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveAllComponentsReaderProps
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -10326,336 +16050,7423 @@ func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureProp(name st
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for MoveAllComponents
+func (m *MoveAllComponents) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveAllComponentsReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveAllComponents
+func (m *MoveAllComponents) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveAllComponentsReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveAllComponents
+func (m *MoveAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveAllComponentsReader{m}
+}
+
+// Implementation for NoOp
+
+var ȧutoGeneratedNoOpReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedNoOpReader struct {
+	data *NoOp
+}
+
+func (n *ȧutoGeneratedNoOpReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedNoOpReaderProps
+}
+
+func (n *ȧutoGeneratedNoOpReader) Prop(name string) (interface{}, error) {
+	props := n.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return n.IntProp(name)
+	case boardgame.TypeBool:
+		return n.BoolProp(name)
+	case boardgame.TypeString:
+		return n.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return n.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return n.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return n.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return n.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return n.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return n.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return n.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return n.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return n.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (n *ȧutoGeneratedNoOpReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetProp(name string, value interface{}) error {
+	props := n.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return n.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return n.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return n.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return n.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return n.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return n.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return n.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return n.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureProp(name string, value interface{}) error {
+	props := n.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return n.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return n.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return n.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return n.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if n.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return n.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return n.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return n.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return n.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return n.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return n.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if n.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return n.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return n.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if n.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return n.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return n.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if n.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return n.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return n.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (n *ȧutoGeneratedNoOpReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (n *ȧutoGeneratedNoOpReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for NoOp
+func (n *NoOp) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedNoOpReader{n}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for NoOp
+func (n *NoOp) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedNoOpReader{n}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for NoOp
+func (n *NoOp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedNoOpReader{n}
+}
+
+// Implementation for Done
+
+var ȧutoGeneratedDoneReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedDoneReader struct {
+	data *Done
+}
+
+func (d *ȧutoGeneratedDoneReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDoneReaderProps
+}
+
+func (d *ȧutoGeneratedDoneReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return d.IntProp(name)
+	case boardgame.TypeBool:
+		return d.BoolProp(name)
+	case boardgame.TypeString:
+		return d.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return d.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return d.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return d.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return d.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return d.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return d.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return d.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return d.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return d.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (d *ȧutoGeneratedDoneReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (d *ȧutoGeneratedDoneReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return d.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return d.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return d.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return d.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return d.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return d.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return d.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return d.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return d.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return d.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return d.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return d.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return d.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return d.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return d.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return d.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return d.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return d.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return d.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if d.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return d.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return d.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (d *ȧutoGeneratedDoneReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (d *ȧutoGeneratedDoneReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for Done
+func (d *Done) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDoneReader{d}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Done
+func (d *Done) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDoneReader{d}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Done
+func (d *Done) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDoneReader{d}
+}
+
+// Implementation for OpenReactionWindow
+
+var ȧutoGeneratedOpenReactionWindowReaderProps = map[string]boardgame.PropertyType{
+	"Action":            boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedOpenReactionWindowReader struct {
+	data *OpenReactionWindow
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedOpenReactionWindowReaderProps
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) Prop(name string) (interface{}, error) {
+	props := o.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return o.IntProp(name)
+	case boardgame.TypeBool:
+		return o.BoolProp(name)
+	case boardgame.TypeString:
+		return o.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return o.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return o.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return o.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return o.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return o.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return o.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return o.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return o.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return o.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) PropMutable(name string) bool {
+	switch name {
+	case "Action":
+		return true
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetProp(name string, value interface{}) error {
+	props := o.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return o.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return o.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return o.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return o.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return o.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return o.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return o.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return o.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureProp(name string, value interface{}) error {
+	props := o.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return o.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return o.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return o.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return o.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if o.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return o.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return o.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return o.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return o.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return o.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return o.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if o.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return o.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return o.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if o.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return o.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return o.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if o.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return o.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return o.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Action":
+		return o.data.Action, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Action":
+		o.data.Action = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return o.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		o.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (o *ȧutoGeneratedOpenReactionWindowReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for OpenReactionWindow
+func (o *OpenReactionWindow) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedOpenReactionWindowReader{o}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for OpenReactionWindow
+func (o *OpenReactionWindow) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedOpenReactionWindowReader{o}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for OpenReactionWindow
+func (o *OpenReactionWindow) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedOpenReactionWindowReader{o}
+}
+
+// Implementation for React
+
+var ȧutoGeneratedReactReaderProps = map[string]boardgame.PropertyType{
+	"Response":          boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedReactReader struct {
+	data *React
+}
+
+func (r *ȧutoGeneratedReactReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedReactReaderProps
+}
+
+func (r *ȧutoGeneratedReactReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return r.IntProp(name)
+	case boardgame.TypeBool:
+		return r.BoolProp(name)
+	case boardgame.TypeString:
+		return r.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return r.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return r.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return r.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return r.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return r.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return r.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return r.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return r.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return r.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedReactReader) PropMutable(name string) bool {
+	switch name {
+	case "Response":
+		return true
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (r *ȧutoGeneratedReactReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return r.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return r.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return r.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return r.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return r.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return r.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return r.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return r.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedReactReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Response":
+		return r.data.Response, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Response":
+		r.data.Response = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return r.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		r.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedReactReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for React
+func (r *React) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedReactReader{r}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for React
+func (r *React) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedReactReader{r}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for React
+func (r *React) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedReactReader{r}
+}
+
+// Implementation for PassReaction
+
+var ȧutoGeneratedPassReactionReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedPassReactionReader struct {
+	data *PassReaction
+}
+
+func (p *ȧutoGeneratedPassReactionReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPassReactionReaderProps
+}
+
+func (p *ȧutoGeneratedPassReactionReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return p.IntProp(name)
+	case boardgame.TypeBool:
+		return p.BoolProp(name)
+	case boardgame.TypeString:
+		return p.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return p.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return p.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return p.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return p.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return p.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return p.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return p.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return p.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return p.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPassReactionReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return p.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return p.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return p.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return p.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return p.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return p.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return p.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return p.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPassReactionReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return p.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		p.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPassReactionReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for PassReaction
+func (p *PassReaction) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPassReactionReader{p}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PassReaction
+func (p *PassReaction) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPassReactionReader{p}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PassReaction
+func (p *PassReaction) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPassReactionReader{p}
+}
+
+// Implementation for ResolveReactions
+
+var ȧutoGeneratedResolveReactionsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedResolveReactionsReader struct {
+	data *ResolveReactions
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedResolveReactionsReaderProps
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return r.IntProp(name)
+	case boardgame.TypeBool:
+		return r.BoolProp(name)
+	case boardgame.TypeString:
+		return r.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return r.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return r.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return r.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return r.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return r.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return r.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return r.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return r.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return r.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return r.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return r.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return r.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return r.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return r.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return r.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return r.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return r.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedResolveReactionsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for ResolveReactions
+func (r *ResolveReactions) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedResolveReactionsReader{r}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ResolveReactions
+func (r *ResolveReactions) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedResolveReactionsReader{r}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ResolveReactions
+func (r *ResolveReactions) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedResolveReactionsReader{r}
+}
+
+// Implementation for GainResources
+
+var ȧutoGeneratedGainResourcesReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedGainResourcesReader struct {
+	data *GainResources
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedGainResourcesReaderProps
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) Prop(name string) (interface{}, error) {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return g.IntProp(name)
+	case boardgame.TypeBool:
+		return g.BoolProp(name)
+	case boardgame.TypeString:
+		return g.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return g.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return g.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return g.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return g.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return g.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return g.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return g.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return g.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return g.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetProp(name string, value interface{}) error {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return g.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return g.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return g.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return g.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return g.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return g.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureProp(name string, value interface{}) error {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return g.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return g.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return g.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return g.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return g.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return g.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return g.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return g.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return g.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return g.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return g.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return g.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return g.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return g.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return g.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		g.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGainResourcesReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for GainResources
+func (g *GainResources) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedGainResourcesReader{g}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for GainResources
+func (g *GainResources) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedGainResourcesReader{g}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for GainResources
+func (g *GainResources) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedGainResourcesReader{g}
+}
+
+// Implementation for PayCost
+
+var ȧutoGeneratedPayCostReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedPayCostReader struct {
+	data *PayCost
+}
+
+func (p *ȧutoGeneratedPayCostReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPayCostReaderProps
+}
+
+func (p *ȧutoGeneratedPayCostReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return p.IntProp(name)
+	case boardgame.TypeBool:
+		return p.BoolProp(name)
+	case boardgame.TypeString:
+		return p.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return p.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return p.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return p.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return p.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return p.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return p.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return p.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return p.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return p.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPayCostReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return p.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return p.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return p.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return p.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return p.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return p.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return p.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return p.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPayCostReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return p.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		p.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPayCostReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for PayCost
+func (p *PayCost) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPayCostReader{p}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PayCost
+func (p *PayCost) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPayCostReader{p}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PayCost
+func (p *PayCost) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPayCostReader{p}
+}
+
+// Implementation for ExchangeResources
+
+var ȧutoGeneratedExchangeResourcesReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedExchangeResourcesReader struct {
+	data *ExchangeResources
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedExchangeResourcesReaderProps
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) Prop(name string) (interface{}, error) {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return e.IntProp(name)
+	case boardgame.TypeBool:
+		return e.BoolProp(name)
+	case boardgame.TypeString:
+		return e.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return e.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return e.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return e.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return e.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return e.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return e.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return e.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return e.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return e.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetProp(name string, value interface{}) error {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return e.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return e.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return e.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return e.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return e.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return e.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureProp(name string, value interface{}) error {
+	props := e.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return e.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return e.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return e.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return e.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return e.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return e.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return e.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return e.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return e.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return e.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return e.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return e.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return e.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if e.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return e.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return e.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return e.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		e.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (e *ȧutoGeneratedExchangeResourcesReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for ExchangeResources
+func (e *ExchangeResources) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedExchangeResourcesReader{e}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ExchangeResources
+func (e *ExchangeResources) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedExchangeResourcesReader{e}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ExchangeResources
+func (e *ExchangeResources) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedExchangeResourcesReader{e}
+}
+
+// Implementation for RoundRobin
+
+var ȧutoGeneratedRoundRobinReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedRoundRobinReader struct {
+	data *RoundRobin
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRoundRobinReaderProps
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return r.IntProp(name)
+	case boardgame.TypeBool:
+		return r.BoolProp(name)
+	case boardgame.TypeString:
+		return r.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return r.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return r.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return r.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return r.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return r.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return r.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return r.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return r.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return r.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return r.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return r.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return r.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return r.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return r.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return r.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return r.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return r.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for RoundRobin
+func (r *RoundRobin) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRoundRobinReader{r}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RoundRobin
+func (r *RoundRobin) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRoundRobinReader{r}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RoundRobin
+func (r *RoundRobin) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRoundRobinReader{r}
+}
+
+// Implementation for RoundRobinNumRounds
+
+var ȧutoGeneratedRoundRobinNumRoundsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedRoundRobinNumRoundsReader struct {
+	data *RoundRobinNumRounds
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedRoundRobinNumRoundsReaderProps
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return r.IntProp(name)
+	case boardgame.TypeBool:
+		return r.BoolProp(name)
+	case boardgame.TypeString:
+		return r.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return r.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return r.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return r.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return r.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return r.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return r.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return r.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return r.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return r.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return r.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return r.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return r.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return r.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return r.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return r.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return r.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return r.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return r.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return r.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return r.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return r.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return r.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if r.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return r.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return r.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (r *ȧutoGeneratedRoundRobinNumRoundsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for RoundRobinNumRounds
+func (r *RoundRobinNumRounds) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RoundRobinNumRounds
+func (r *RoundRobinNumRounds) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RoundRobinNumRounds
+func (r *RoundRobinNumRounds) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+}
+
+// Implementation for SeatPlayer
+
+var ȧutoGeneratedSeatPlayerReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedSeatPlayerReader struct {
+	data *SeatPlayer
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedSeatPlayerReaderProps
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) Prop(name string) (interface{}, error) {
+	props := s.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return s.IntProp(name)
+	case boardgame.TypeBool:
+		return s.BoolProp(name)
+	case boardgame.TypeString:
+		return s.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return s.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return s.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return s.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return s.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return s.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return s.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return s.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return s.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return s.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetProp(name string, value interface{}) error {
+	props := s.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return s.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return s.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return s.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return s.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return s.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return s.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return s.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return s.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureProp(name string, value interface{}) error {
+	props := s.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return s.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return s.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return s.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return s.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if s.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return s.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return s.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return s.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return s.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return s.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return s.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if s.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return s.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return s.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if s.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return s.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return s.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if s.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return s.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return s.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return s.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		s.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (s *ȧutoGeneratedSeatPlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for SeatPlayer
+func (s *SeatPlayer) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedSeatPlayerReader{s}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for SeatPlayer
+func (s *SeatPlayer) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedSeatPlayerReader{s}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for SeatPlayer
+func (s *SeatPlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedSeatPlayerReader{s}
+}
+
+// Implementation for UnseatPlayer
+
+var ȧutoGeneratedUnseatPlayerReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedUnseatPlayerReader struct {
+	data *UnseatPlayer
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedUnseatPlayerReaderProps
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) Prop(name string) (interface{}, error) {
+	props := u.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return u.IntProp(name)
+	case boardgame.TypeBool:
+		return u.BoolProp(name)
+	case boardgame.TypeString:
+		return u.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return u.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return u.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return u.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return u.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return u.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return u.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return u.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return u.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return u.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetProp(name string, value interface{}) error {
+	props := u.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return u.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return u.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return u.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return u.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return u.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return u.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return u.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return u.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureProp(name string, value interface{}) error {
+	props := u.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return u.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return u.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return u.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return u.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if u.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return u.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return u.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return u.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return u.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return u.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return u.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if u.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return u.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return u.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if u.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return u.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return u.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if u.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return u.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return u.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return u.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		u.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (u *ȧutoGeneratedUnseatPlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for UnseatPlayer
+func (u *UnseatPlayer) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedUnseatPlayerReader{u}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for UnseatPlayer
+func (u *UnseatPlayer) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedUnseatPlayerReader{u}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for UnseatPlayer
+func (u *UnseatPlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedUnseatPlayerReader{u}
+}
+
+// Implementation for CloseEmptySeat
+
+var ȧutoGeneratedCloseEmptySeatReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedCloseEmptySeatReader struct {
+	data *CloseEmptySeat
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCloseEmptySeatReaderProps
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) Prop(name string) (interface{}, error) {
+	props := c.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return c.IntProp(name)
+	case boardgame.TypeBool:
+		return c.BoolProp(name)
+	case boardgame.TypeString:
+		return c.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return c.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return c.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return c.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return c.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return c.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return c.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return c.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return c.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return c.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetProp(name string, value interface{}) error {
+	props := c.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return c.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return c.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return c.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return c.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return c.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return c.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return c.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return c.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureProp(name string, value interface{}) error {
+	props := c.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return c.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return c.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return c.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return c.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if c.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return c.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return c.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return c.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return c.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return c.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return c.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if c.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return c.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return c.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if c.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return c.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return c.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if c.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return c.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return c.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return c.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		c.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (c *ȧutoGeneratedCloseEmptySeatReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for CloseEmptySeat
+func (c *CloseEmptySeat) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCloseEmptySeatReader{c}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CloseEmptySeat
+func (c *CloseEmptySeat) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCloseEmptySeatReader{c}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CloseEmptySeat
+func (c *CloseEmptySeat) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCloseEmptySeatReader{c}
+}
+
+// Implementation for InactivateEmptySeat
+
+var ȧutoGeneratedInactivateEmptySeatReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedInactivateEmptySeatReader struct {
+	data *InactivateEmptySeat
+}
+
+func (i *ȧutoGeneratedInactivateEmptySeatReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedInactivateEmptySeatReaderProps
+}
+
+func (i *ȧutoGeneratedInactivateEmptySeatReader) Prop(name string) (interface{}, error) {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return i.IntProp(name)
+	case boardgame.TypeBool:
+		return i.BoolProp(name)
+	case boardgame.TypeString:
+		return i.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return i.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return i.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return i.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return i.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return i.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return i.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return i.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return i.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return i.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedInactivateEmptySeatReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return i.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return i.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return i.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if m.PropMutable(name) {
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return i.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return i.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return i.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureProp(name string, value interface{}) error {
+	props := i.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return i.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return i.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return i.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return i.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if i.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return m.ConfigureEnumProp(name, val)
+			return i.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return m.ConfigureImmutableEnumProp(name, val)
+		return i.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return i.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return i.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return i.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return i.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if m.PropMutable(name) {
+		if i.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return m.ConfigureStackProp(name, val)
+			return i.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return m.ConfigureImmutableStackProp(name, val)
+		return i.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if m.PropMutable(name) {
+		if i.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return m.ConfigureBoardProp(name, val)
+			return i.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return m.ConfigureImmutableBoardProp(name, val)
+		return i.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if m.PropMutable(name) {
+		if i.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return m.ConfigureTimerProp(name, val)
+			return i.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return m.ConfigureImmutableTimerProp(name, val)
+		return i.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) IntProp(name string) (int, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetIntProp(name string, value int) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoolProp(name string) (bool, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetBoolProp(name string, value bool) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StringProp(name string) (string, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetStringProp(name string, value string) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return i.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		i.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) EnumProp(name string) (enum.Val, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) IntSliceProp(name string) ([]int, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetIntSliceProp(name string, value []int) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoolSliceProp(name string) ([]bool, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetBoolSliceProp(name string, value []bool) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StringSliceProp(name string) ([]string, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetStringSliceProp(name string, value []string) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) StackProp(name string) (boardgame.Stack, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) BoardProp(name string) (boardgame.Board, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveComponentsUntilCountLeftReader) TimerProp(name string) (boardgame.Timer, error) {
+func (i *ȧutoGeneratedInactivateEmptySeatReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for MoveComponentsUntilCountLeft
-func (m *MoveComponentsUntilCountLeft) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for InactivateEmptySeat
+func (i *InactivateEmptySeat) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedInactivateEmptySeatReader{i}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveComponentsUntilCountLeft
-func (m *MoveComponentsUntilCountLeft) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for InactivateEmptySeat
+func (i *InactivateEmptySeat) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedInactivateEmptySeatReader{i}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveComponentsUntilCountLeft
-func (m *MoveComponentsUntilCountLeft) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveComponentsUntilCountLeftReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for InactivateEmptySeat
+func (i *InactivateEmptySeat) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedInactivateEmptySeatReader{i}
 }
 
-// Implementation for MoveAllComponents
+// Implementation for WaitForEnoughPlayers
 
-var ȧutoGeneratedMoveAllComponentsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedWaitForEnoughPlayersReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveAllComponentsReader struct {
-	data *MoveAllComponents
+type ȧutoGeneratedWaitForEnoughPlayersReader struct {
+	data *WaitForEnoughPlayers
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveAllComponentsReaderProps
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedWaitForEnoughPlayersReaderProps
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) Prop(name string) (interface{}, error) {
-	props := m.Props()
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) Prop(name string) (interface{}, error) {
+	props := w.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -10664,44 +23475,44 @@ func (m *ȧutoGeneratedMoveAllComponentsReader) Prop(name string) (interface{},
 
 	switch propType {
 	case boardgame.TypeInt:
-		return m.IntProp(name)
+		return w.IntProp(name)
 	case boardgame.TypeBool:
-		return m.BoolProp(name)
+		return w.BoolProp(name)
 	case boardgame.TypeString:
-		return m.StringProp(name)
+		return w.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return m.PlayerIndexProp(name)
+		return w.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return m.ImmutableEnumProp(name)
+		return w.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return m.IntSliceProp(name)
+		return w.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return m.BoolSliceProp(name)
+		return w.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return m.StringSliceProp(name)
+		return w.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return m.PlayerIndexSliceProp(name)
+		return w.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return m.ImmutableStackProp(name)
+		return w.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return m.ImmutableBoardProp(name)
+		return w.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return m.ImmutableTimerProp(name)
+		return w.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) PropMutable(name string) bool {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetProp(name string, value interface{}) error {
-	props := m.Props()
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetProp(name string, value interface{}) error {
+	props := w.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -10714,25 +23525,25 @@ func (m *ȧutoGeneratedMoveAllComponentsReader) SetProp(name string, value inter
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return w.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return w.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return w.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return w.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -10740,25 +23551,25 @@ func (m *ȧutoGeneratedMoveAllComponentsReader) SetProp(name string, value inter
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return w.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return w.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return w.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return w.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -10771,8 +23582,8 @@ func (m *ȧutoGeneratedMoveAllComponentsReader) SetProp(name string, value inter
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureProp(name string, value interface{}) error {
-	props := m.Props()
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureProp(name string, value interface{}) error {
+	props := w.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -10785,336 +23596,336 @@ func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureProp(name string, value
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return m.SetIntProp(name, val)
+		return w.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return m.SetBoolProp(name, val)
+		return w.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return m.SetStringProp(name, val)
+		return w.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexProp(name, val)
+		return w.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if m.PropMutable(name) {
+		if w.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return m.ConfigureEnumProp(name, val)
+			return w.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return m.ConfigureImmutableEnumProp(name, val)
+		return w.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return m.SetIntSliceProp(name, val)
+		return w.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return m.SetBoolSliceProp(name, val)
+		return w.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return m.SetStringSliceProp(name, val)
+		return w.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return m.SetPlayerIndexSliceProp(name, val)
+		return w.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if m.PropMutable(name) {
+		if w.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return m.ConfigureStackProp(name, val)
+			return w.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return m.ConfigureImmutableStackProp(name, val)
+		return w.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if m.PropMutable(name) {
+		if w.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return m.ConfigureBoardProp(name, val)
+			return w.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return m.ConfigureImmutableBoardProp(name, val)
+		return w.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if m.PropMutable(name) {
+		if w.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return m.ConfigureTimerProp(name, val)
+			return w.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return m.ConfigureImmutableTimerProp(name, val)
+		return w.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) IntProp(name string) (int, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetIntProp(name string, value int) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) BoolProp(name string) (bool, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetBoolProp(name string, value bool) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) StringProp(name string) (string, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetStringProp(name string, value string) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) EnumProp(name string) (enum.Val, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) IntSliceProp(name string) ([]int, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetIntSliceProp(name string, value []int) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) BoolSliceProp(name string) ([]bool, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetBoolSliceProp(name string, value []bool) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) StringSliceProp(name string) ([]string, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetStringSliceProp(name string, value []string) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) StackProp(name string) (boardgame.Stack, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) BoardProp(name string) (boardgame.Board, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveAllComponentsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (w *ȧutoGeneratedWaitForEnoughPlayersReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for MoveAllComponents
-func (m *MoveAllComponents) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveAllComponentsReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for WaitForEnoughPlayers
+func (w *WaitForEnoughPlayers) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for MoveAllComponents
-func (m *MoveAllComponents) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveAllComponentsReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for WaitForEnoughPlayers
+func (w *WaitForEnoughPlayers) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for MoveAllComponents
-func (m *MoveAllComponents) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveAllComponentsReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for WaitForEnoughPlayers
+func (w *WaitForEnoughPlayers) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
 }
 
-// Implementation for NoOp
+// Implementation for ShuffleStack
 
-var ȧutoGeneratedNoOpReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedShuffleStackReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedNoOpReader struct {
-	data *NoOp
+type ȧutoGeneratedShuffleStackReader struct {
+	data *ShuffleStack
 }
 
-func (n *ȧutoGeneratedNoOpReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedNoOpReaderProps
+func (s *ȧutoGeneratedShuffleStackReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedShuffleStackReaderProps
 }
 
-func (n *ȧutoGeneratedNoOpReader) Prop(name string) (interface{}, error) {
-	props := n.Props()
+func (s *ȧutoGeneratedShuffleStackReader) Prop(name string) (interface{}, error) {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11123,44 +23934,44 @@ func (n *ȧutoGeneratedNoOpReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return n.IntProp(name)
+		return s.IntProp(name)
 	case boardgame.TypeBool:
-		return n.BoolProp(name)
+		return s.BoolProp(name)
 	case boardgame.TypeString:
-		return n.StringProp(name)
+		return s.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return n.PlayerIndexProp(name)
+		return s.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return n.ImmutableEnumProp(name)
+		return s.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return n.IntSliceProp(name)
+		return s.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return n.BoolSliceProp(name)
+		return s.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return n.StringSliceProp(name)
+		return s.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return n.PlayerIndexSliceProp(name)
+		return s.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return n.ImmutableStackProp(name)
+		return s.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return n.ImmutableBoardProp(name)
+		return s.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return n.ImmutableTimerProp(name)
+		return s.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (n *ȧutoGeneratedNoOpReader) PropMutable(name string) bool {
+func (s *ȧutoGeneratedShuffleStackReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetProp(name string, value interface{}) error {
-	props := n.Props()
+func (s *ȧutoGeneratedShuffleStackReader) SetProp(name string, value interface{}) error {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11173,25 +23984,25 @@ func (n *ȧutoGeneratedNoOpReader) SetProp(name string, value interface{}) error
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return n.SetIntProp(name, val)
+		return s.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return n.SetBoolProp(name, val)
+		return s.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return n.SetStringProp(name, val)
+		return s.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return n.SetPlayerIndexProp(name, val)
+		return s.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -11199,25 +24010,25 @@ func (n *ȧutoGeneratedNoOpReader) SetProp(name string, value interface{}) error
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return n.SetIntSliceProp(name, val)
+		return s.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return n.SetBoolSliceProp(name, val)
+		return s.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return n.SetStringSliceProp(name, val)
+		return s.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return n.SetPlayerIndexSliceProp(name, val)
+		return s.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -11230,8 +24041,8 @@ func (n *ȧutoGeneratedNoOpReader) SetProp(name string, value interface{}) error
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureProp(name string, value interface{}) error {
-	props := n.Props()
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureProp(name string, value interface{}) error {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11244,336 +24055,336 @@ func (n *ȧutoGeneratedNoOpReader) ConfigureProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return n.SetIntProp(name, val)
+		return s.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return n.SetBoolProp(name, val)
+		return s.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return n.SetStringProp(name, val)
+		return s.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return n.SetPlayerIndexProp(name, val)
+		return s.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if n.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return n.ConfigureEnumProp(name, val)
+			return s.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return n.ConfigureImmutableEnumProp(name, val)
+		return s.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return n.SetIntSliceProp(name, val)
+		return s.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return n.SetBoolSliceProp(name, val)
+		return s.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return n.SetStringSliceProp(name, val)
+		return s.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return n.SetPlayerIndexSliceProp(name, val)
+		return s.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if n.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return n.ConfigureStackProp(name, val)
+			return s.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return n.ConfigureImmutableStackProp(name, val)
+		return s.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if n.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return n.ConfigureBoardProp(name, val)
+			return s.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return n.ConfigureImmutableBoardProp(name, val)
+		return s.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if n.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return n.ConfigureTimerProp(name, val)
+			return s.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return n.ConfigureImmutableTimerProp(name, val)
+		return s.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (n *ȧutoGeneratedNoOpReader) IntProp(name string) (int, error) {
+func (s *ȧutoGeneratedShuffleStackReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetIntProp(name string, value int) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) BoolProp(name string) (bool, error) {
+func (s *ȧutoGeneratedShuffleStackReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetBoolProp(name string, value bool) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) StringProp(name string) (string, error) {
+func (s *ȧutoGeneratedShuffleStackReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetStringProp(name string, value string) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (s *ȧutoGeneratedShuffleStackReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (s *ȧutoGeneratedShuffleStackReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) EnumProp(name string) (enum.Val, error) {
+func (s *ȧutoGeneratedShuffleStackReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) IntSliceProp(name string) ([]int, error) {
+func (s *ȧutoGeneratedShuffleStackReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetIntSliceProp(name string, value []int) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) BoolSliceProp(name string) ([]bool, error) {
+func (s *ȧutoGeneratedShuffleStackReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetBoolSliceProp(name string, value []bool) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) StringSliceProp(name string) ([]string, error) {
+func (s *ȧutoGeneratedShuffleStackReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetStringSliceProp(name string, value []string) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (s *ȧutoGeneratedShuffleStackReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (s *ȧutoGeneratedShuffleStackReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (s *ȧutoGeneratedShuffleStackReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) StackProp(name string) (boardgame.Stack, error) {
+func (s *ȧutoGeneratedShuffleStackReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (s *ȧutoGeneratedShuffleStackReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) BoardProp(name string) (boardgame.Board, error) {
+func (s *ȧutoGeneratedShuffleStackReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (s *ȧutoGeneratedShuffleStackReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (n *ȧutoGeneratedNoOpReader) TimerProp(name string) (boardgame.Timer, error) {
+func (s *ȧutoGeneratedShuffleStackReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for NoOp
-func (n *NoOp) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedNoOpReader{n}
+//Reader returns an autp-generated boardgame.PropertyReader for ShuffleStack
+func (s *ShuffleStack) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedShuffleStackReader{s}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for NoOp
-func (n *NoOp) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedNoOpReader{n}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ShuffleStack
+func (s *ShuffleStack) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedShuffleStackReader{s}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for NoOp
-func (n *NoOp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedNoOpReader{n}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ShuffleStack
+func (s *ShuffleStack) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedShuffleStackReader{s}
 }
 
-// Implementation for Done
+// Implementation for StartPhase
 
-var ȧutoGeneratedDoneReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedStartPhaseReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedDoneReader struct {
-	data *Done
+type ȧutoGeneratedStartPhaseReader struct {
+	data *StartPhase
 }
 
-func (d *ȧutoGeneratedDoneReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedDoneReaderProps
+func (s *ȧutoGeneratedStartPhaseReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedStartPhaseReaderProps
 }
 
-func (d *ȧutoGeneratedDoneReader) Prop(name string) (interface{}, error) {
-	props := d.Props()
+func (s *ȧutoGeneratedStartPhaseReader) Prop(name string) (interface{}, error) {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11582,44 +24393,44 @@ func (d *ȧutoGeneratedDoneReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return d.IntProp(name)
+		return s.IntProp(name)
 	case boardgame.TypeBool:
-		return d.BoolProp(name)
+		return s.BoolProp(name)
 	case boardgame.TypeString:
-		return d.StringProp(name)
+		return s.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return d.PlayerIndexProp(name)
+		return s.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return d.ImmutableEnumProp(name)
+		return s.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return d.IntSliceProp(name)
+		return s.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return d.BoolSliceProp(name)
+		return s.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return d.StringSliceProp(name)
+		return s.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return d.PlayerIndexSliceProp(name)
+		return s.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return d.ImmutableStackProp(name)
+		return s.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return d.ImmutableBoardProp(name)
+		return s.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return d.ImmutableTimerProp(name)
+		return s.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDoneReader) PropMutable(name string) bool {
+func (s *ȧutoGeneratedStartPhaseReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (d *ȧutoGeneratedDoneReader) SetProp(name string, value interface{}) error {
-	props := d.Props()
+func (s *ȧutoGeneratedStartPhaseReader) SetProp(name string, value interface{}) error {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11632,25 +24443,25 @@ func (d *ȧutoGeneratedDoneReader) SetProp(name string, value interface{}) error
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return s.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return s.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return s.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return s.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -11658,25 +24469,25 @@ func (d *ȧutoGeneratedDoneReader) SetProp(name string, value interface{}) error
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return s.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return s.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return s.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return s.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -11689,8 +24500,8 @@ func (d *ȧutoGeneratedDoneReader) SetProp(name string, value interface{}) error
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureProp(name string, value interface{}) error {
-	props := d.Props()
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureProp(name string, value interface{}) error {
+	props := s.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -11703,336 +24514,343 @@ func (d *ȧutoGeneratedDoneReader) ConfigureProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return d.SetIntProp(name, val)
+		return s.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return d.SetBoolProp(name, val)
+		return s.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return d.SetStringProp(name, val)
+		return s.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexProp(name, val)
+		return s.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if d.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return d.ConfigureEnumProp(name, val)
+			return s.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return d.ConfigureImmutableEnumProp(name, val)
+		return s.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return d.SetIntSliceProp(name, val)
+		return s.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return d.SetBoolSliceProp(name, val)
+		return s.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return d.SetStringSliceProp(name, val)
+		return s.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return d.SetPlayerIndexSliceProp(name, val)
+		return s.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if d.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return d.ConfigureStackProp(name, val)
+			return s.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return d.ConfigureImmutableStackProp(name, val)
+		return s.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if d.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return d.ConfigureBoardProp(name, val)
+			return s.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return d.ConfigureImmutableBoardProp(name, val)
+		return s.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if d.PropMutable(name) {
+		if s.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return d.ConfigureTimerProp(name, val)
+			return s.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return d.ConfigureImmutableTimerProp(name, val)
+		return s.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (d *ȧutoGeneratedDoneReader) IntProp(name string) (int, error) {
+func (s *ȧutoGeneratedStartPhaseReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetIntProp(name string, value int) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) BoolProp(name string) (bool, error) {
+func (s *ȧutoGeneratedStartPhaseReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetBoolProp(name string, value bool) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) StringProp(name string) (string, error) {
+func (s *ȧutoGeneratedStartPhaseReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetStringProp(name string, value string) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (s *ȧutoGeneratedStartPhaseReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (s *ȧutoGeneratedStartPhaseReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) EnumProp(name string) (enum.Val, error) {
+func (s *ȧutoGeneratedStartPhaseReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) IntSliceProp(name string) ([]int, error) {
+func (s *ȧutoGeneratedStartPhaseReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetIntSliceProp(name string, value []int) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) BoolSliceProp(name string) ([]bool, error) {
+func (s *ȧutoGeneratedStartPhaseReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetBoolSliceProp(name string, value []bool) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) StringSliceProp(name string) ([]string, error) {
+func (s *ȧutoGeneratedStartPhaseReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetStringSliceProp(name string, value []string) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (s *ȧutoGeneratedStartPhaseReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (s *ȧutoGeneratedStartPhaseReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (s *ȧutoGeneratedStartPhaseReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) StackProp(name string) (boardgame.Stack, error) {
+func (s *ȧutoGeneratedStartPhaseReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (s *ȧutoGeneratedStartPhaseReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) BoardProp(name string) (boardgame.Board, error) {
+func (s *ȧutoGeneratedStartPhaseReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (s *ȧutoGeneratedStartPhaseReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (d *ȧutoGeneratedDoneReader) TimerProp(name string) (boardgame.Timer, error) {
+func (s *ȧutoGeneratedStartPhaseReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for Done
-func (d *Done) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedDoneReader{d}
+//Reader returns an autp-generated boardgame.PropertyReader for StartPhase
+func (s *StartPhase) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedStartPhaseReader{s}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for Done
-func (d *Done) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedDoneReader{d}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for StartPhase
+func (s *StartPhase) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedStartPhaseReader{s}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for Done
-func (d *Done) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedDoneReader{d}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for StartPhase
+func (s *StartPhase) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedStartPhaseReader{s}
 }
 
-// Implementation for RoundRobin
+// Implementation for OfferTrade
 
-var ȧutoGeneratedRoundRobinReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedOfferTradeReaderProps = map[string]boardgame.PropertyType{
+	"OfferedComponents":   boardgame.TypeIntSlice,
+	"OfferedResources":    boardgame.TypeIntSlice,
+	"Recipient":           boardgame.TypePlayerIndex,
+	"RequestedComponents": boardgame.TypeIntSlice,
+	"RequestedResources":  boardgame.TypeIntSlice,
+	"TargetPlayerIndex":   boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedRoundRobinReader struct {
-	data *RoundRobin
+type ȧutoGeneratedOfferTradeReader struct {
+	data *OfferTrade
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedRoundRobinReaderProps
+func (o *ȧutoGeneratedOfferTradeReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedOfferTradeReaderProps
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) Prop(name string) (interface{}, error) {
-	props := r.Props()
+func (o *ȧutoGeneratedOfferTradeReader) Prop(name string) (interface{}, error) {
+	props := o.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12041,44 +24859,56 @@ func (r *ȧutoGeneratedRoundRobinReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return r.IntProp(name)
+		return o.IntProp(name)
 	case boardgame.TypeBool:
-		return r.BoolProp(name)
+		return o.BoolProp(name)
 	case boardgame.TypeString:
-		return r.StringProp(name)
+		return o.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return r.PlayerIndexProp(name)
+		return o.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return r.ImmutableEnumProp(name)
+		return o.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return r.IntSliceProp(name)
+		return o.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return r.BoolSliceProp(name)
+		return o.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return r.StringSliceProp(name)
+		return o.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return r.PlayerIndexSliceProp(name)
+		return o.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return r.ImmutableStackProp(name)
+		return o.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return r.ImmutableBoardProp(name)
+		return o.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return r.ImmutableTimerProp(name)
+		return o.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) PropMutable(name string) bool {
+func (o *ȧutoGeneratedOfferTradeReader) PropMutable(name string) bool {
 	switch name {
+	case "OfferedComponents":
+		return true
+	case "OfferedResources":
+		return true
+	case "Recipient":
+		return true
+	case "RequestedComponents":
+		return true
+	case "RequestedResources":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetProp(name string, value interface{}) error {
-	props := r.Props()
+func (o *ȧutoGeneratedOfferTradeReader) SetProp(name string, value interface{}) error {
+	props := o.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12091,25 +24921,25 @@ func (r *ȧutoGeneratedRoundRobinReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return r.SetIntProp(name, val)
+		return o.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return r.SetBoolProp(name, val)
+		return o.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return r.SetStringProp(name, val)
+		return o.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexProp(name, val)
+		return o.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -12117,25 +24947,25 @@ func (r *ȧutoGeneratedRoundRobinReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return r.SetIntSliceProp(name, val)
+		return o.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return r.SetBoolSliceProp(name, val)
+		return o.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return r.SetStringSliceProp(name, val)
+		return o.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexSliceProp(name, val)
+		return o.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -12148,8 +24978,8 @@ func (r *ȧutoGeneratedRoundRobinReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureProp(name string, value interface{}) error {
-	props := r.Props()
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureProp(name string, value interface{}) error {
+	props := o.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12162,336 +24992,384 @@ func (r *ȧutoGeneratedRoundRobinReader) ConfigureProp(name string, value interf
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return r.SetIntProp(name, val)
+		return o.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return r.SetBoolProp(name, val)
+		return o.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return r.SetStringProp(name, val)
+		return o.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexProp(name, val)
+		return o.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if r.PropMutable(name) {
+		if o.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return r.ConfigureEnumProp(name, val)
+			return o.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return r.ConfigureImmutableEnumProp(name, val)
+		return o.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return r.SetIntSliceProp(name, val)
+		return o.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return r.SetBoolSliceProp(name, val)
+		return o.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return r.SetStringSliceProp(name, val)
+		return o.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexSliceProp(name, val)
+		return o.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if r.PropMutable(name) {
+		if o.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return r.ConfigureStackProp(name, val)
+			return o.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return r.ConfigureImmutableStackProp(name, val)
+		return o.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if r.PropMutable(name) {
+		if o.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return r.ConfigureBoardProp(name, val)
+			return o.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return r.ConfigureImmutableBoardProp(name, val)
+		return o.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if r.PropMutable(name) {
+		if o.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return r.ConfigureTimerProp(name, val)
+			return o.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return r.ConfigureImmutableTimerProp(name, val)
+		return o.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) IntProp(name string) (int, error) {
+func (o *ȧutoGeneratedOfferTradeReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetIntProp(name string, value int) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) BoolProp(name string) (bool, error) {
+func (o *ȧutoGeneratedOfferTradeReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetBoolProp(name string, value bool) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) StringProp(name string) (string, error) {
+func (o *ȧutoGeneratedOfferTradeReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetStringProp(name string, value string) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (o *ȧutoGeneratedOfferTradeReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "Recipient":
+		return o.data.Recipient, nil
+	case "TargetPlayerIndex":
+		return o.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "Recipient":
+		o.data.Recipient = value
+		return nil
+	case "TargetPlayerIndex":
+		o.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (o *ȧutoGeneratedOfferTradeReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) EnumProp(name string) (enum.Val, error) {
+func (o *ȧutoGeneratedOfferTradeReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) IntSliceProp(name string) ([]int, error) {
+func (o *ȧutoGeneratedOfferTradeReader) IntSliceProp(name string) ([]int, error) {
+
+	switch name {
+	case "OfferedComponents":
+		return o.data.OfferedComponents, nil
+	case "OfferedResources":
+		return o.data.OfferedResources, nil
+	case "RequestedComponents":
+		return o.data.RequestedComponents, nil
+	case "RequestedResources":
+		return o.data.RequestedResources, nil
+
+	}
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetIntSliceProp(name string, value []int) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetIntSliceProp(name string, value []int) error {
+
+	switch name {
+	case "OfferedComponents":
+		o.data.OfferedComponents = value
+		return nil
+	case "OfferedResources":
+		o.data.OfferedResources = value
+		return nil
+	case "RequestedComponents":
+		o.data.RequestedComponents = value
+		return nil
+	case "RequestedResources":
+		o.data.RequestedResources = value
+		return nil
+
+	}
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) BoolSliceProp(name string) ([]bool, error) {
+func (o *ȧutoGeneratedOfferTradeReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetBoolSliceProp(name string, value []bool) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) StringSliceProp(name string) ([]string, error) {
+func (o *ȧutoGeneratedOfferTradeReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetStringSliceProp(name string, value []string) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (o *ȧutoGeneratedOfferTradeReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (o *ȧutoGeneratedOfferTradeReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (o *ȧutoGeneratedOfferTradeReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) StackProp(name string) (boardgame.Stack, error) {
+func (o *ȧutoGeneratedOfferTradeReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (o *ȧutoGeneratedOfferTradeReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) BoardProp(name string) (boardgame.Board, error) {
+func (o *ȧutoGeneratedOfferTradeReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (o *ȧutoGeneratedOfferTradeReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (o *ȧutoGeneratedOfferTradeReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinReader) TimerProp(name string) (boardgame.Timer, error) {
+func (o *ȧutoGeneratedOfferTradeReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for RoundRobin
-func (r *RoundRobin) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedRoundRobinReader{r}
+//Reader returns an autp-generated boardgame.PropertyReader for OfferTrade
+func (o *OfferTrade) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedOfferTradeReader{o}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RoundRobin
-func (r *RoundRobin) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedRoundRobinReader{r}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for OfferTrade
+func (o *OfferTrade) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedOfferTradeReader{o}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RoundRobin
-func (r *RoundRobin) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedRoundRobinReader{r}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for OfferTrade
+func (o *OfferTrade) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedOfferTradeReader{o}
 }
 
-// Implementation for RoundRobinNumRounds
+// Implementation for AcceptTrade
 
-var ȧutoGeneratedRoundRobinNumRoundsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedAcceptTradeReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedRoundRobinNumRoundsReader struct {
-	data *RoundRobinNumRounds
+type ȧutoGeneratedAcceptTradeReader struct {
+	data *AcceptTrade
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedRoundRobinNumRoundsReaderProps
+func (a *ȧutoGeneratedAcceptTradeReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedAcceptTradeReaderProps
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) Prop(name string) (interface{}, error) {
-	props := r.Props()
+func (a *ȧutoGeneratedAcceptTradeReader) Prop(name string) (interface{}, error) {
+	props := a.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12500,44 +25378,46 @@ func (r *ȧutoGeneratedRoundRobinNumRoundsReader) Prop(name string) (interface{}
 
 	switch propType {
 	case boardgame.TypeInt:
-		return r.IntProp(name)
+		return a.IntProp(name)
 	case boardgame.TypeBool:
-		return r.BoolProp(name)
+		return a.BoolProp(name)
 	case boardgame.TypeString:
-		return r.StringProp(name)
+		return a.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return r.PlayerIndexProp(name)
+		return a.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return r.ImmutableEnumProp(name)
+		return a.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return r.IntSliceProp(name)
+		return a.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return r.BoolSliceProp(name)
+		return a.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return r.StringSliceProp(name)
+		return a.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return r.PlayerIndexSliceProp(name)
+		return a.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return r.ImmutableStackProp(name)
+		return a.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return r.ImmutableBoardProp(name)
+		return a.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return r.ImmutableTimerProp(name)
+		return a.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PropMutable(name string) bool {
+func (a *ȧutoGeneratedAcceptTradeReader) PropMutable(name string) bool {
 	switch name {
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetProp(name string, value interface{}) error {
-	props := r.Props()
+func (a *ȧutoGeneratedAcceptTradeReader) SetProp(name string, value interface{}) error {
+	props := a.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12550,25 +25430,25 @@ func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return r.SetIntProp(name, val)
+		return a.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return r.SetBoolProp(name, val)
+		return a.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return r.SetStringProp(name, val)
+		return a.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexProp(name, val)
+		return a.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -12576,25 +25456,25 @@ func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return r.SetIntSliceProp(name, val)
+		return a.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return r.SetBoolSliceProp(name, val)
+		return a.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return r.SetStringSliceProp(name, val)
+		return a.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexSliceProp(name, val)
+		return a.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -12607,8 +25487,8 @@ func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureProp(name string, value interface{}) error {
-	props := r.Props()
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureProp(name string, value interface{}) error {
+	props := a.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12621,338 +25501,351 @@ func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureProp(name string, val
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return r.SetIntProp(name, val)
+		return a.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return r.SetBoolProp(name, val)
+		return a.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return r.SetStringProp(name, val)
+		return a.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexProp(name, val)
+		return a.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if r.PropMutable(name) {
+		if a.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return r.ConfigureEnumProp(name, val)
+			return a.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return r.ConfigureImmutableEnumProp(name, val)
+		return a.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return r.SetIntSliceProp(name, val)
+		return a.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return r.SetBoolSliceProp(name, val)
+		return a.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return r.SetStringSliceProp(name, val)
+		return a.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return r.SetPlayerIndexSliceProp(name, val)
+		return a.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if r.PropMutable(name) {
+		if a.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return r.ConfigureStackProp(name, val)
+			return a.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return r.ConfigureImmutableStackProp(name, val)
+		return a.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if r.PropMutable(name) {
+		if a.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return r.ConfigureBoardProp(name, val)
+			return a.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return r.ConfigureImmutableBoardProp(name, val)
+		return a.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if r.PropMutable(name) {
+		if a.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return r.ConfigureTimerProp(name, val)
+			return a.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return r.ConfigureImmutableTimerProp(name, val)
+		return a.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) IntProp(name string) (int, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetIntProp(name string, value int) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoolProp(name string) (bool, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetBoolProp(name string, value bool) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StringProp(name string) (string, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetStringProp(name string, value string) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return a.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		a.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) EnumProp(name string) (enum.Val, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) IntSliceProp(name string) ([]int, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetIntSliceProp(name string, value []int) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoolSliceProp(name string) ([]bool, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetBoolSliceProp(name string, value []bool) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StringSliceProp(name string) ([]string, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetStringSliceProp(name string, value []string) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (a *ȧutoGeneratedAcceptTradeReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) StackProp(name string) (boardgame.Stack, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) BoardProp(name string) (boardgame.Board, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (a *ȧutoGeneratedAcceptTradeReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (r *ȧutoGeneratedRoundRobinNumRoundsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (a *ȧutoGeneratedAcceptTradeReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for RoundRobinNumRounds
-func (r *RoundRobinNumRounds) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+//Reader returns an autp-generated boardgame.PropertyReader for AcceptTrade
+func (a *AcceptTrade) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedAcceptTradeReader{a}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for RoundRobinNumRounds
-func (r *RoundRobinNumRounds) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for AcceptTrade
+func (a *AcceptTrade) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedAcceptTradeReader{a}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for RoundRobinNumRounds
-func (r *RoundRobinNumRounds) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedRoundRobinNumRoundsReader{r}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for AcceptTrade
+func (a *AcceptTrade) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedAcceptTradeReader{a}
 }
 
-// Implementation for SeatPlayer
+// Implementation for DeclineTrade
 
-var ȧutoGeneratedSeatPlayerReaderProps = map[string]boardgame.PropertyType{
+var ȧutoGeneratedDeclineTradeReaderProps = map[string]boardgame.PropertyType{
 	"TargetPlayerIndex": boardgame.TypePlayerIndex,
 }
 
-type ȧutoGeneratedSeatPlayerReader struct {
-	data *SeatPlayer
+type ȧutoGeneratedDeclineTradeReader struct {
+	data *DeclineTrade
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedSeatPlayerReaderProps
+func (d *ȧutoGeneratedDeclineTradeReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedDeclineTradeReaderProps
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) Prop(name string) (interface{}, error) {
-	props := s.Props()
+func (d *ȧutoGeneratedDeclineTradeReader) Prop(name string) (interface{}, error) {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -12961,36 +25854,36 @@ func (s *ȧutoGeneratedSeatPlayerReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return s.IntProp(name)
+		return d.IntProp(name)
 	case boardgame.TypeBool:
-		return s.BoolProp(name)
+		return d.BoolProp(name)
 	case boardgame.TypeString:
-		return s.StringProp(name)
+		return d.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return s.PlayerIndexProp(name)
+		return d.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return s.ImmutableEnumProp(name)
+		return d.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return s.IntSliceProp(name)
+		return d.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return s.BoolSliceProp(name)
+		return d.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return s.StringSliceProp(name)
+		return d.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return s.PlayerIndexSliceProp(name)
+		return d.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return s.ImmutableStackProp(name)
+		return d.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return s.ImmutableBoardProp(name)
+		return d.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return s.ImmutableTimerProp(name)
+		return d.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) PropMutable(name string) bool {
+func (d *ȧutoGeneratedDeclineTradeReader) PropMutable(name string) bool {
 	switch name {
 	case "TargetPlayerIndex":
 		return true
@@ -12999,8 +25892,8 @@ func (s *ȧutoGeneratedSeatPlayerReader) PropMutable(name string) bool {
 	return false
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetProp(name string, value interface{}) error {
-	props := s.Props()
+func (d *ȧutoGeneratedDeclineTradeReader) SetProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -13013,25 +25906,25 @@ func (s *ȧutoGeneratedSeatPlayerReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -13039,25 +25932,25 @@ func (s *ȧutoGeneratedSeatPlayerReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -13070,8 +25963,8 @@ func (s *ȧutoGeneratedSeatPlayerReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureProp(name string, value interface{}) error {
-	props := s.Props()
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureProp(name string, value interface{}) error {
+	props := d.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -13084,156 +25977,156 @@ func (s *ȧutoGeneratedSeatPlayerReader) ConfigureProp(name string, value interf
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return d.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return d.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return d.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return d.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if s.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return s.ConfigureEnumProp(name, val)
+			return d.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return s.ConfigureImmutableEnumProp(name, val)
+		return d.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return d.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return d.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return d.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return d.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if s.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return s.ConfigureStackProp(name, val)
+			return d.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return s.ConfigureImmutableStackProp(name, val)
+		return d.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if s.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return s.ConfigureBoardProp(name, val)
+			return d.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return s.ConfigureImmutableBoardProp(name, val)
+		return d.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if s.PropMutable(name) {
+		if d.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return s.ConfigureTimerProp(name, val)
+			return d.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return s.ConfigureImmutableTimerProp(name, val)
+		return d.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) IntProp(name string) (int, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetIntProp(name string, value int) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) BoolProp(name string) (bool, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetBoolProp(name string, value bool) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) StringProp(name string) (string, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetStringProp(name string, value string) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
 	case "TargetPlayerIndex":
-		return s.data.TargetPlayerIndex, nil
+		return d.data.TargetPlayerIndex, nil
 
 	}
 
@@ -13241,11 +26134,11 @@ func (s *ȧutoGeneratedSeatPlayerReader) PlayerIndexProp(name string) (boardgame
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	switch name {
 	case "TargetPlayerIndex":
-		s.data.TargetPlayerIndex = value
+		d.data.TargetPlayerIndex = value
 		return nil
 
 	}
@@ -13254,180 +26147,180 @@ func (s *ȧutoGeneratedSeatPlayerReader) SetPlayerIndexProp(name string, value b
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) EnumProp(name string) (enum.Val, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) IntSliceProp(name string) ([]int, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetIntSliceProp(name string, value []int) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) BoolSliceProp(name string) ([]bool, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetBoolSliceProp(name string, value []bool) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) StringSliceProp(name string) ([]string, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetStringSliceProp(name string, value []string) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (d *ȧutoGeneratedDeclineTradeReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) StackProp(name string) (boardgame.Stack, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) BoardProp(name string) (boardgame.Board, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (d *ȧutoGeneratedDeclineTradeReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedSeatPlayerReader) TimerProp(name string) (boardgame.Timer, error) {
+func (d *ȧutoGeneratedDeclineTradeReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for SeatPlayer
-func (s *SeatPlayer) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedSeatPlayerReader{s}
+//Reader returns an autp-generated boardgame.PropertyReader for DeclineTrade
+func (d *DeclineTrade) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedDeclineTradeReader{d}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for SeatPlayer
-func (s *SeatPlayer) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedSeatPlayerReader{s}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for DeclineTrade
+func (d *DeclineTrade) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedDeclineTradeReader{d}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for SeatPlayer
-func (s *SeatPlayer) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedSeatPlayerReader{s}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for DeclineTrade
+func (d *DeclineTrade) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedDeclineTradeReader{d}
 }
 
-// Implementation for CloseEmptySeat
+// Implementation for CancelTrade
 
-var ȧutoGeneratedCloseEmptySeatReaderProps = map[string]boardgame.PropertyType{
+var ȧutoGeneratedCancelTradeReaderProps = map[string]boardgame.PropertyType{
 	"TargetPlayerIndex": boardgame.TypePlayerIndex,
 }
 
-type ȧutoGeneratedCloseEmptySeatReader struct {
-	data *CloseEmptySeat
+type ȧutoGeneratedCancelTradeReader struct {
+	data *CancelTrade
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedCloseEmptySeatReaderProps
+func (c *ȧutoGeneratedCancelTradeReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedCancelTradeReaderProps
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) Prop(name string) (interface{}, error) {
+func (c *ȧutoGeneratedCancelTradeReader) Prop(name string) (interface{}, error) {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -13466,7 +26359,7 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) Prop(name string) (interface{}, err
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) PropMutable(name string) bool {
+func (c *ȧutoGeneratedCancelTradeReader) PropMutable(name string) bool {
 	switch name {
 	case "TargetPlayerIndex":
 		return true
@@ -13475,7 +26368,7 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) PropMutable(name string) bool {
 	return false
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -13546,7 +26439,7 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) SetProp(name string, value interfac
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureProp(name string, value interface{}) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureProp(name string, value interface{}) error {
 	props := c.Props()
 	propType, ok := props[name]
 
@@ -13669,43 +26562,43 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureProp(name string, value in
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) IntProp(name string) (int, error) {
+func (c *ȧutoGeneratedCancelTradeReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetIntProp(name string, value int) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) BoolProp(name string) (bool, error) {
+func (c *ȧutoGeneratedCancelTradeReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetBoolProp(name string, value bool) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) StringProp(name string) (string, error) {
+func (c *ȧutoGeneratedCancelTradeReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetStringProp(name string, value string) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCancelTradeReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
 	case "TargetPlayerIndex":
@@ -13717,7 +26610,7 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) PlayerIndexProp(name string) (board
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	switch name {
 	case "TargetPlayerIndex":
@@ -13730,181 +26623,182 @@ func (c *ȧutoGeneratedCloseEmptySeatReader) SetPlayerIndexProp(name string, val
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (c *ȧutoGeneratedCancelTradeReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) EnumProp(name string) (enum.Val, error) {
+func (c *ȧutoGeneratedCancelTradeReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) IntSliceProp(name string) ([]int, error) {
+func (c *ȧutoGeneratedCancelTradeReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetIntSliceProp(name string, value []int) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) BoolSliceProp(name string) ([]bool, error) {
+func (c *ȧutoGeneratedCancelTradeReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetBoolSliceProp(name string, value []bool) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) StringSliceProp(name string) ([]string, error) {
+func (c *ȧutoGeneratedCancelTradeReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetStringSliceProp(name string, value []string) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (c *ȧutoGeneratedCancelTradeReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (c *ȧutoGeneratedCancelTradeReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (c *ȧutoGeneratedCancelTradeReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) StackProp(name string) (boardgame.Stack, error) {
+func (c *ȧutoGeneratedCancelTradeReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (c *ȧutoGeneratedCancelTradeReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) BoardProp(name string) (boardgame.Board, error) {
+func (c *ȧutoGeneratedCancelTradeReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (c *ȧutoGeneratedCancelTradeReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (c *ȧutoGeneratedCancelTradeReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (c *ȧutoGeneratedCloseEmptySeatReader) TimerProp(name string) (boardgame.Timer, error) {
+func (c *ȧutoGeneratedCancelTradeReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for CloseEmptySeat
-func (c *CloseEmptySeat) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedCloseEmptySeatReader{c}
+//Reader returns an autp-generated boardgame.PropertyReader for CancelTrade
+func (c *CancelTrade) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedCancelTradeReader{c}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CloseEmptySeat
-func (c *CloseEmptySeat) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedCloseEmptySeatReader{c}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for CancelTrade
+func (c *CancelTrade) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedCancelTradeReader{c}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CloseEmptySeat
-func (c *CloseEmptySeat) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedCloseEmptySeatReader{c}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for CancelTrade
+func (c *CancelTrade) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedCancelTradeReader{c}
 }
 
-// Implementation for InactivateEmptySeat
+// Implementation for PlayCardToTrick
 
-var ȧutoGeneratedInactivateEmptySeatReaderProps = map[string]boardgame.PropertyType{
+var ȧutoGeneratedPlayCardToTrickReaderProps = map[string]boardgame.PropertyType{
+	"ComponentIndex":    boardgame.TypeInt,
 	"TargetPlayerIndex": boardgame.TypePlayerIndex,
 }
 
-type ȧutoGeneratedInactivateEmptySeatReader struct {
-	data *InactivateEmptySeat
+type ȧutoGeneratedPlayCardToTrickReader struct {
+	data *PlayCardToTrick
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedInactivateEmptySeatReaderProps
+func (p *ȧutoGeneratedPlayCardToTrickReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPlayCardToTrickReaderProps
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) Prop(name string) (interface{}, error) {
-	props := i.Props()
+func (p *ȧutoGeneratedPlayCardToTrickReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -13913,37 +26807,39 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) Prop(name string) (interface{}
 
 	switch propType {
 	case boardgame.TypeInt:
-		return i.IntProp(name)
+		return p.IntProp(name)
 	case boardgame.TypeBool:
-		return i.BoolProp(name)
+		return p.BoolProp(name)
 	case boardgame.TypeString:
-		return i.StringProp(name)
+		return p.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return i.PlayerIndexProp(name)
+		return p.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return i.ImmutableEnumProp(name)
+		return p.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return i.IntSliceProp(name)
+		return p.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return i.BoolSliceProp(name)
+		return p.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return i.StringSliceProp(name)
+		return p.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return i.PlayerIndexSliceProp(name)
+		return p.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return i.ImmutableStackProp(name)
+		return p.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return i.ImmutableBoardProp(name)
+		return p.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return i.ImmutableTimerProp(name)
+		return p.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) PropMutable(name string) bool {
+func (p *ȧutoGeneratedPlayCardToTrickReader) PropMutable(name string) bool {
 	switch name {
+	case "ComponentIndex":
+		return true
 	case "TargetPlayerIndex":
 		return true
 	}
@@ -13951,8 +26847,8 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) PropMutable(name string) bool
 	return false
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetProp(name string, value interface{}) error {
-	props := i.Props()
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -13965,25 +26861,25 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return i.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return i.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return i.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -13991,25 +26887,25 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) SetProp(name string, value int
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return i.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return i.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return i.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -14022,8 +26918,8 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) SetProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureProp(name string, value interface{}) error {
-	props := i.Props()
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14036,156 +26932,169 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureProp(name string, val
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return i.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return i.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return i.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if i.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return i.ConfigureEnumProp(name, val)
+			return p.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return i.ConfigureImmutableEnumProp(name, val)
+		return p.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return i.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return i.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return i.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return i.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if i.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return i.ConfigureStackProp(name, val)
+			return p.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return i.ConfigureImmutableStackProp(name, val)
+		return p.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if i.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return i.ConfigureBoardProp(name, val)
+			return p.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return i.ConfigureImmutableBoardProp(name, val)
+		return p.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if i.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return i.ConfigureTimerProp(name, val)
+			return p.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return i.ConfigureImmutableTimerProp(name, val)
+		return p.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) IntProp(name string) (int, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "ComponentIndex":
+		return p.data.ComponentIndex, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetIntProp(name string, value int) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "ComponentIndex":
+		p.data.ComponentIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) BoolProp(name string) (bool, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetBoolProp(name string, value bool) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) StringProp(name string) (string, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetStringProp(name string, value string) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
 	case "TargetPlayerIndex":
-		return i.data.TargetPlayerIndex, nil
+		return p.data.TargetPlayerIndex, nil
 
 	}
 
@@ -14193,11 +27102,11 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) PlayerIndexProp(name string) (
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	switch name {
 	case "TargetPlayerIndex":
-		i.data.TargetPlayerIndex = value
+		p.data.TargetPlayerIndex = value
 		return nil
 
 	}
@@ -14206,179 +27115,179 @@ func (i *ȧutoGeneratedInactivateEmptySeatReader) SetPlayerIndexProp(name string
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) EnumProp(name string) (enum.Val, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) IntSliceProp(name string) ([]int, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetIntSliceProp(name string, value []int) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) BoolSliceProp(name string) ([]bool, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetBoolSliceProp(name string, value []bool) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) StringSliceProp(name string) ([]string, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetStringSliceProp(name string, value []string) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) StackProp(name string) (boardgame.Stack, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) BoardProp(name string) (boardgame.Board, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (p *ȧutoGeneratedPlayCardToTrickReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (i *ȧutoGeneratedInactivateEmptySeatReader) TimerProp(name string) (boardgame.Timer, error) {
+func (p *ȧutoGeneratedPlayCardToTrickReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for InactivateEmptySeat
-func (i *InactivateEmptySeat) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedInactivateEmptySeatReader{i}
+//Reader returns an autp-generated boardgame.PropertyReader for PlayCardToTrick
+func (p *PlayCardToTrick) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPlayCardToTrickReader{p}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for InactivateEmptySeat
-func (i *InactivateEmptySeat) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedInactivateEmptySeatReader{i}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PlayCardToTrick
+func (p *PlayCardToTrick) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPlayCardToTrickReader{p}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for InactivateEmptySeat
-func (i *InactivateEmptySeat) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedInactivateEmptySeatReader{i}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PlayCardToTrick
+func (p *PlayCardToTrick) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPlayCardToTrickReader{p}
 }
 
-// Implementation for WaitForEnoughPlayers
+// Implementation for ResolveTrick
 
-var ȧutoGeneratedWaitForEnoughPlayersReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedResolveTrickReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedWaitForEnoughPlayersReader struct {
-	data *WaitForEnoughPlayers
+type ȧutoGeneratedResolveTrickReader struct {
+	data *ResolveTrick
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedWaitForEnoughPlayersReaderProps
+func (r *ȧutoGeneratedResolveTrickReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedResolveTrickReaderProps
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) Prop(name string) (interface{}, error) {
-	props := w.Props()
+func (r *ȧutoGeneratedResolveTrickReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14387,44 +27296,44 @@ func (w *ȧutoGeneratedWaitForEnoughPlayersReader) Prop(name string) (interface{
 
 	switch propType {
 	case boardgame.TypeInt:
-		return w.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return w.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return w.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return w.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return w.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return w.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return w.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return w.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return w.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return w.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return w.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return w.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedResolveTrickReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetProp(name string, value interface{}) error {
-	props := w.Props()
+func (r *ȧutoGeneratedResolveTrickReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14437,25 +27346,25 @@ func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetProp(name string, value in
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return w.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return w.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return w.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return w.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -14463,25 +27372,25 @@ func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetProp(name string, value in
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return w.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return w.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return w.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return w.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -14494,8 +27403,8 @@ func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetProp(name string, value in
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureProp(name string, value interface{}) error {
-	props := w.Props()
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14508,336 +27417,339 @@ func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureProp(name string, va
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return w.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return w.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return w.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return w.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if w.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return w.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return w.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return w.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return w.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return w.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return w.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if w.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return w.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return w.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if w.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return w.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return w.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if w.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return w.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return w.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedResolveTrickReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedResolveTrickReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedResolveTrickReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedResolveTrickReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedResolveTrickReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedResolveTrickReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedResolveTrickReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedResolveTrickReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedResolveTrickReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedResolveTrickReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedResolveTrickReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedResolveTrickReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedResolveTrickReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedResolveTrickReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedResolveTrickReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedResolveTrickReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedResolveTrickReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (w *ȧutoGeneratedWaitForEnoughPlayersReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedResolveTrickReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for WaitForEnoughPlayers
-func (w *WaitForEnoughPlayers) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
+//Reader returns an autp-generated boardgame.PropertyReader for ResolveTrick
+func (r *ResolveTrick) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedResolveTrickReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for WaitForEnoughPlayers
-func (w *WaitForEnoughPlayers) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ResolveTrick
+func (r *ResolveTrick) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedResolveTrickReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for WaitForEnoughPlayers
-func (w *WaitForEnoughPlayers) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedWaitForEnoughPlayersReader{w}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ResolveTrick
+func (r *ResolveTrick) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedResolveTrickReader{r}
 }
 
-// Implementation for ShuffleStack
+// Implementation for PlaceWorker
 
-var ȧutoGeneratedShuffleStackReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedPlaceWorkerReaderProps = map[string]boardgame.PropertyType{
+	"Space":             boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedShuffleStackReader struct {
-	data *ShuffleStack
+type ȧutoGeneratedPlaceWorkerReader struct {
+	data *PlaceWorker
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedShuffleStackReaderProps
+func (p *ȧutoGeneratedPlaceWorkerReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPlaceWorkerReaderProps
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) Prop(name string) (interface{}, error) {
-	props := s.Props()
+func (p *ȧutoGeneratedPlaceWorkerReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14846,44 +27758,48 @@ func (s *ȧutoGeneratedShuffleStackReader) Prop(name string) (interface{}, error
 
 	switch propType {
 	case boardgame.TypeInt:
-		return s.IntProp(name)
+		return p.IntProp(name)
 	case boardgame.TypeBool:
-		return s.BoolProp(name)
+		return p.BoolProp(name)
 	case boardgame.TypeString:
-		return s.StringProp(name)
+		return p.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return s.PlayerIndexProp(name)
+		return p.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return s.ImmutableEnumProp(name)
+		return p.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return s.IntSliceProp(name)
+		return p.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return s.BoolSliceProp(name)
+		return p.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return s.StringSliceProp(name)
+		return p.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return s.PlayerIndexSliceProp(name)
+		return p.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return s.ImmutableStackProp(name)
+		return p.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return s.ImmutableBoardProp(name)
+		return p.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return s.ImmutableTimerProp(name)
+		return p.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) PropMutable(name string) bool {
+func (p *ȧutoGeneratedPlaceWorkerReader) PropMutable(name string) bool {
 	switch name {
+	case "Space":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetProp(name string, value interface{}) error {
-	props := s.Props()
+func (p *ȧutoGeneratedPlaceWorkerReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14896,25 +27812,25 @@ func (s *ȧutoGeneratedShuffleStackReader) SetProp(name string, value interface{
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -14922,25 +27838,25 @@ func (s *ȧutoGeneratedShuffleStackReader) SetProp(name string, value interface{
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -14953,8 +27869,8 @@ func (s *ȧutoGeneratedShuffleStackReader) SetProp(name string, value interface{
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureProp(name string, value interface{}) error {
-	props := s.Props()
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -14967,336 +27883,362 @@ func (s *ȧutoGeneratedShuffleStackReader) ConfigureProp(name string, value inte
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return p.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return p.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return p.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return p.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if s.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return s.ConfigureEnumProp(name, val)
+			return p.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return s.ConfigureImmutableEnumProp(name, val)
+		return p.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return p.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return p.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return p.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return p.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if s.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return s.ConfigureStackProp(name, val)
+			return p.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return s.ConfigureImmutableStackProp(name, val)
+		return p.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if s.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return s.ConfigureBoardProp(name, val)
+			return p.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return s.ConfigureImmutableBoardProp(name, val)
+		return p.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if s.PropMutable(name) {
+		if p.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return s.ConfigureTimerProp(name, val)
+			return p.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return s.ConfigureImmutableTimerProp(name, val)
+		return p.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) IntProp(name string) (int, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Space":
+		return p.data.Space, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetIntProp(name string, value int) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Space":
+		p.data.Space = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) BoolProp(name string) (bool, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetBoolProp(name string, value bool) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) StringProp(name string) (string, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetStringProp(name string, value string) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return p.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		p.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) EnumProp(name string) (enum.Val, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) IntSliceProp(name string) ([]int, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetIntSliceProp(name string, value []int) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) BoolSliceProp(name string) ([]bool, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetBoolSliceProp(name string, value []bool) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) StringSliceProp(name string) ([]string, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetStringSliceProp(name string, value []string) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) StackProp(name string) (boardgame.Stack, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) BoardProp(name string) (boardgame.Board, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (p *ȧutoGeneratedPlaceWorkerReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedShuffleStackReader) TimerProp(name string) (boardgame.Timer, error) {
+func (p *ȧutoGeneratedPlaceWorkerReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for ShuffleStack
-func (s *ShuffleStack) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedShuffleStackReader{s}
+//Reader returns an autp-generated boardgame.PropertyReader for PlaceWorker
+func (p *PlaceWorker) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPlaceWorkerReader{p}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ShuffleStack
-func (s *ShuffleStack) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedShuffleStackReader{s}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for PlaceWorker
+func (p *PlaceWorker) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPlaceWorkerReader{p}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ShuffleStack
-func (s *ShuffleStack) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedShuffleStackReader{s}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for PlaceWorker
+func (p *PlaceWorker) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPlaceWorkerReader{p}
 }
 
-// Implementation for StartPhase
+// Implementation for ReturnWorkers
 
-var ȧutoGeneratedStartPhaseReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedReturnWorkersReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedStartPhaseReader struct {
-	data *StartPhase
+type ȧutoGeneratedReturnWorkersReader struct {
+	data *ReturnWorkers
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedStartPhaseReaderProps
+func (r *ȧutoGeneratedReturnWorkersReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedReturnWorkersReaderProps
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) Prop(name string) (interface{}, error) {
-	props := s.Props()
+func (r *ȧutoGeneratedReturnWorkersReader) Prop(name string) (interface{}, error) {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -15305,44 +28247,44 @@ func (s *ȧutoGeneratedStartPhaseReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return s.IntProp(name)
+		return r.IntProp(name)
 	case boardgame.TypeBool:
-		return s.BoolProp(name)
+		return r.BoolProp(name)
 	case boardgame.TypeString:
-		return s.StringProp(name)
+		return r.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return s.PlayerIndexProp(name)
+		return r.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return s.ImmutableEnumProp(name)
+		return r.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return s.IntSliceProp(name)
+		return r.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return s.BoolSliceProp(name)
+		return r.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return s.StringSliceProp(name)
+		return r.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return s.PlayerIndexSliceProp(name)
+		return r.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return s.ImmutableStackProp(name)
+		return r.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return s.ImmutableBoardProp(name)
+		return r.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return s.ImmutableTimerProp(name)
+		return r.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) PropMutable(name string) bool {
+func (r *ȧutoGeneratedReturnWorkersReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetProp(name string, value interface{}) error {
-	props := s.Props()
+func (r *ȧutoGeneratedReturnWorkersReader) SetProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -15355,25 +28297,25 @@ func (s *ȧutoGeneratedStartPhaseReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -15381,25 +28323,25 @@ func (s *ȧutoGeneratedStartPhaseReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -15412,8 +28354,8 @@ func (s *ȧutoGeneratedStartPhaseReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureProp(name string, value interface{}) error {
-	props := s.Props()
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureProp(name string, value interface{}) error {
+	props := r.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -15426,318 +28368,318 @@ func (s *ȧutoGeneratedStartPhaseReader) ConfigureProp(name string, value interf
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return s.SetIntProp(name, val)
+		return r.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return s.SetBoolProp(name, val)
+		return r.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return s.SetStringProp(name, val)
+		return r.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexProp(name, val)
+		return r.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if s.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return s.ConfigureEnumProp(name, val)
+			return r.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return s.ConfigureImmutableEnumProp(name, val)
+		return r.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return s.SetIntSliceProp(name, val)
+		return r.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return s.SetBoolSliceProp(name, val)
+		return r.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return s.SetStringSliceProp(name, val)
+		return r.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return s.SetPlayerIndexSliceProp(name, val)
+		return r.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if s.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return s.ConfigureStackProp(name, val)
+			return r.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return s.ConfigureImmutableStackProp(name, val)
+		return r.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if s.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return s.ConfigureBoardProp(name, val)
+			return r.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return s.ConfigureImmutableBoardProp(name, val)
+		return r.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if s.PropMutable(name) {
+		if r.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return s.ConfigureTimerProp(name, val)
+			return r.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return s.ConfigureImmutableTimerProp(name, val)
+		return r.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) IntProp(name string) (int, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetIntProp(name string, value int) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) BoolProp(name string) (bool, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetBoolProp(name string, value bool) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) StringProp(name string) (string, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetStringProp(name string, value string) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) EnumProp(name string) (enum.Val, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) IntSliceProp(name string) ([]int, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetIntSliceProp(name string, value []int) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) BoolSliceProp(name string) ([]bool, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetBoolSliceProp(name string, value []bool) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) StringSliceProp(name string) ([]string, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetStringSliceProp(name string, value []string) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (r *ȧutoGeneratedReturnWorkersReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) StackProp(name string) (boardgame.Stack, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) BoardProp(name string) (boardgame.Board, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (r *ȧutoGeneratedReturnWorkersReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (s *ȧutoGeneratedStartPhaseReader) TimerProp(name string) (boardgame.Timer, error) {
+func (r *ȧutoGeneratedReturnWorkersReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for StartPhase
-func (s *StartPhase) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedStartPhaseReader{s}
+//Reader returns an autp-generated boardgame.PropertyReader for ReturnWorkers
+func (r *ReturnWorkers) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedReturnWorkersReader{r}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for StartPhase
-func (s *StartPhase) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedStartPhaseReader{s}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for ReturnWorkers
+func (r *ReturnWorkers) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedReturnWorkersReader{r}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for StartPhase
-func (s *StartPhase) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedStartPhaseReader{s}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for ReturnWorkers
+func (r *ReturnWorkers) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedReturnWorkersReader{r}
 }
@@ -17,19 +17,19 @@ import (
 	"github.com/jkomoros/boardgame/enum"
 )
 
-// Implementation for moveShuffleStack
+// Implementation for moveResolveAuctionAddToCounter
 
-var ȧutoGeneratedMoveShuffleStackReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveResolveAuctionAddToCounterReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveShuffleStackReader struct {
-	data *moveShuffleStack
+type ȧutoGeneratedMoveResolveAuctionAddToCounterReader struct {
+	data *moveResolveAuctionAddToCounter
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveShuffleStackReaderProps
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveResolveAuctionAddToCounterReaderProps
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -68,14 +68,14 @@ func (m *ȧutoGeneratedMoveShuffleStackReader) Prop(name string) (interface{}, e
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -146,7 +146,7 @@ func (m *ȧutoGeneratedMoveShuffleStackReader) SetProp(name string, value interf
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -269,226 +269,226 @@ func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureProp(name string, value
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveShuffleStackReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveResolveAuctionAddToCounterReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveShuffleStack
-func (m *moveShuffleStack) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveShuffleStackReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveResolveAuctionAddToCounter
+func (m *moveResolveAuctionAddToCounter) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveResolveAuctionAddToCounterReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveShuffleStack
-func (m *moveShuffleStack) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveShuffleStackReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveResolveAuctionAddToCounter
+func (m *moveResolveAuctionAddToCounter) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveResolveAuctionAddToCounterReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveShuffleStack
-func (m *moveShuffleStack) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveShuffleStackReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveResolveAuctionAddToCounter
+func (m *moveResolveAuctionAddToCounter) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveResolveAuctionAddToCounterReader{m}
 }
 
-// Implementation for moveNoOpFixUp
+// Implementation for moveShuffleStack
 
-var ȧutoGeneratedMoveNoOpFixUpReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveShuffleStackReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveNoOpFixUpReader struct {
-	data *moveNoOpFixUp
+type ȧutoGeneratedMoveShuffleStackReader struct {
+	data *moveShuffleStack
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveNoOpFixUpReaderProps
+func (m *ȧutoGeneratedMoveShuffleStackReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveShuffleStackReaderProps
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -527,14 +527,14 @@ func (m *ȧutoGeneratedMoveNoOpFixUpReader) Prop(name string) (interface{}, erro
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveShuffleStackReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -605,7 +605,7 @@ func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetProp(name string, value interface
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -728,226 +728,226 @@ func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveShuffleStackReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveShuffleStackReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveNoOpFixUp
-func (m *moveNoOpFixUp) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveShuffleStack
+func (m *moveShuffleStack) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveShuffleStackReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveNoOpFixUp
-func (m *moveNoOpFixUp) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveShuffleStack
+func (m *moveShuffleStack) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveShuffleStackReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveNoOpFixUp
-func (m *moveNoOpFixUp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveShuffleStack
+func (m *moveShuffleStack) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveShuffleStackReader{m}
 }
 
-// Implementation for moveNoOpFixUpMulti
+// Implementation for moveNoOpFixUp
 
-var ȧutoGeneratedMoveNoOpFixUpMultiReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveNoOpFixUpReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveNoOpFixUpMultiReader struct {
-	data *moveNoOpFixUpMulti
+type ȧutoGeneratedMoveNoOpFixUpReader struct {
+	data *moveNoOpFixUp
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveNoOpFixUpMultiReaderProps
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveNoOpFixUpReaderProps
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -986,14 +986,14 @@ func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) Prop(name string) (interface{},
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -1064,7 +1064,7 @@ func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetProp(name string, value inte
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -1187,237 +1187,227 @@ func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureProp(name string, valu
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveNoOpFixUpMulti
-func (m *moveNoOpFixUpMulti) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveNoOpFixUp
+func (m *moveNoOpFixUp) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveNoOpFixUpMulti
-func (m *moveNoOpFixUpMulti) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveNoOpFixUp
+func (m *moveNoOpFixUp) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveNoOpFixUpMulti
-func (m *moveNoOpFixUpMulti) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveNoOpFixUp
+func (m *moveNoOpFixUp) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveNoOpFixUpReader{m}
 }
 
-// Implementation for gameState
+// Implementation for moveNoOpFixUpMulti
 
-var ȧutoGeneratedGameStateReaderProps = map[string]boardgame.PropertyType{
-	"Counter":         boardgame.TypeInt,
-	"CurrentPlayer":   boardgame.TypePlayerIndex,
-	"DiscardStack":    boardgame.TypeStack,
-	"DrawStack":       boardgame.TypeStack,
-	"Phase":           boardgame.TypeEnum,
-	"RRHasStarted":    boardgame.TypeBool,
-	"RRLastPlayer":    boardgame.TypePlayerIndex,
-	"RRRoundCount":    boardgame.TypeInt,
-	"RRStarterPlayer": boardgame.TypePlayerIndex,
-}
+var ȧutoGeneratedMoveNoOpFixUpMultiReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedGameStateReader struct {
-	data *gameState
+type ȧutoGeneratedMoveNoOpFixUpMultiReader struct {
+	data *moveNoOpFixUpMulti
 }
 
-func (g *ȧutoGeneratedGameStateReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedGameStateReaderProps
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveNoOpFixUpMultiReaderProps
 }
 
-func (g *ȧutoGeneratedGameStateReader) Prop(name string) (interface{}, error) {
-	props := g.Props()
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1426,62 +1416,44 @@ func (g *ȧutoGeneratedGameStateReader) Prop(name string) (interface{}, error) {
 
 	switch propType {
 	case boardgame.TypeInt:
-		return g.IntProp(name)
+		return m.IntProp(name)
 	case boardgame.TypeBool:
-		return g.BoolProp(name)
+		return m.BoolProp(name)
 	case boardgame.TypeString:
-		return g.StringProp(name)
+		return m.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return g.PlayerIndexProp(name)
+		return m.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return g.ImmutableEnumProp(name)
+		return m.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return g.IntSliceProp(name)
+		return m.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return g.BoolSliceProp(name)
+		return m.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return g.StringSliceProp(name)
+		return m.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return g.PlayerIndexSliceProp(name)
+		return m.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return g.ImmutableStackProp(name)
+		return m.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return g.ImmutableBoardProp(name)
+		return m.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return g.ImmutableTimerProp(name)
+		return m.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (g *ȧutoGeneratedGameStateReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PropMutable(name string) bool {
 	switch name {
-	case "Counter":
-		return true
-	case "CurrentPlayer":
-		return true
-	case "DiscardStack":
-		return true
-	case "DrawStack":
-		return true
-	case "Phase":
-		return true
-	case "RRHasStarted":
-		return true
-	case "RRLastPlayer":
-		return true
-	case "RRRoundCount":
-		return true
-	case "RRStarterPlayer":
-		return true
 	}
 
 	return false
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetProp(name string, value interface{}) error {
-	props := g.Props()
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1494,25 +1466,25 @@ func (g *ȧutoGeneratedGameStateReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return g.SetIntProp(name, val)
+		return m.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return g.SetBoolProp(name, val)
+		return m.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return g.SetStringProp(name, val)
+		return m.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return g.SetPlayerIndexProp(name, val)
+		return m.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -1520,25 +1492,25 @@ func (g *ȧutoGeneratedGameStateReader) SetProp(name string, value interface{})
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return g.SetIntSliceProp(name, val)
+		return m.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return g.SetBoolSliceProp(name, val)
+		return m.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return g.SetStringSliceProp(name, val)
+		return m.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return g.SetPlayerIndexSliceProp(name, val)
+		return m.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -1551,8 +1523,8 @@ func (g *ȧutoGeneratedGameStateReader) SetProp(name string, value interface{})
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureProp(name string, value interface{}) error {
-	props := g.Props()
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -1565,191 +1537,3573 @@ func (g *ȧutoGeneratedGameStateReader) ConfigureProp(name string, value interfa
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return g.SetIntProp(name, val)
+		return m.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return g.SetBoolProp(name, val)
+		return m.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return g.SetStringProp(name, val)
+		return m.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return g.SetPlayerIndexProp(name, val)
+		return m.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if g.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return g.ConfigureEnumProp(name, val)
+			return m.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return g.ConfigureImmutableEnumProp(name, val)
+		return m.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return g.SetIntSliceProp(name, val)
+		return m.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return g.SetBoolSliceProp(name, val)
+		return m.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return g.SetStringSliceProp(name, val)
+		return m.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return g.SetPlayerIndexSliceProp(name, val)
+		return m.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if g.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return g.ConfigureStackProp(name, val)
+			return m.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return g.ConfigureImmutableStackProp(name, val)
+		return m.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if g.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return g.ConfigureBoardProp(name, val)
+			return m.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return g.ConfigureImmutableBoardProp(name, val)
+		return m.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if g.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return g.ConfigureTimerProp(name, val)
+			return m.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return g.ConfigureImmutableTimerProp(name, val)
+		return m.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (g *ȧutoGeneratedGameStateReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) IntProp(name string) (int, error) {
 
-	switch name {
-	case "Counter":
-		return g.data.Counter, nil
-	case "RRRoundCount":
-		return g.data.RRRoundCount, nil
+	return 0, errors.New("No such Int prop: " + name)
 
-	}
+}
 
-	return 0, errors.New("No such Int prop: " + name)
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoolProp(name string) (bool, error) {
 
-	switch name {
-	case "Counter":
-		g.data.Counter = value
-		return nil
-	case "RRRoundCount":
-		g.data.RRRoundCount = value
-		return nil
+	return false, errors.New("No such Bool prop: " + name)
 
-	}
+}
 
-	return errors.New("No such Int prop: " + name)
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StringProp(name string) (string, error) {
 
-	switch name {
-	case "RRHasStarted":
-		return g.data.RRHasStarted, nil
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveNoOpFixUpMultiReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for moveNoOpFixUpMulti
+func (m *moveNoOpFixUpMulti) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveNoOpFixUpMulti
+func (m *moveNoOpFixUpMulti) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveNoOpFixUpMulti
+func (m *moveNoOpFixUpMulti) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveNoOpFixUpMultiReader{m}
+}
+
+// Implementation for moveEndDiceTurn
+
+var ȧutoGeneratedMoveEndDiceTurnReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedMoveEndDiceTurnReader struct {
+	data *moveEndDiceTurn
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveEndDiceTurnReaderProps
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return m.data.TargetPlayerIndex, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		m.data.TargetPlayerIndex = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveEndDiceTurnReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for moveEndDiceTurn
+func (m *moveEndDiceTurn) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveEndDiceTurnReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveEndDiceTurn
+func (m *moveEndDiceTurn) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveEndDiceTurnReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveEndDiceTurn
+func (m *moveEndDiceTurn) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveEndDiceTurnReader{m}
+}
+
+// Implementation for gameState
+
+var ȧutoGeneratedGameStateReaderProps = map[string]boardgame.PropertyType{
+	"ActionBoard":              boardgame.TypeBoard,
+	"ActionSpacePlacedPlayers": boardgame.TypePlayerIndexSlice,
+	"ActionSpacePlacedSpaces":  boardgame.TypeIntSlice,
+	"AuctionBid":               boardgame.TypeInt,
+	"AuctionBidder":            boardgame.TypePlayerIndex,
+	"AuctionInProgress":        boardgame.TypeBool,
+	"AuctionPassed":            boardgame.TypePlayerIndexSlice,
+	"AuctionSealed":            boardgame.TypeIntSlice,
+	"AuctionTurns":             boardgame.TypeInt,
+	"BankTaken":                boardgame.TypeIntSlice,
+	"Counter":                  boardgame.TypeInt,
+	"CurrentPlayer":            boardgame.TypePlayerIndex,
+	"Dice":                     boardgame.TypeStack,
+	"DicePoolLocked":           boardgame.TypeBoolSlice,
+	"DicePoolRerolls":          boardgame.TypeInt,
+	"DicePoolRolled":           boardgame.TypeBool,
+	"DiscardStack":             boardgame.TypeStack,
+	"DraftPicked":              boardgame.TypePlayerIndexSlice,
+	"DraftRoundCount":          boardgame.TypeInt,
+	"DraftTransit":             boardgame.TypeStack,
+	"DrawStack":                boardgame.TypeStack,
+	"Phase":                    boardgame.TypeEnum,
+	"RRHasStarted":             boardgame.TypeBool,
+	"RRLastPlayer":             boardgame.TypePlayerIndex,
+	"RRRoundCount":             boardgame.TypeInt,
+	"RRStarterPlayer":          boardgame.TypePlayerIndex,
+	"ReactionInProgress":       boardgame.TypeBool,
+	"ReactionLastToAct":        boardgame.TypePlayerIndex,
+	"ReactionNextResponder":    boardgame.TypePlayerIndex,
+	"ReactionPassed":           boardgame.TypeInt,
+	"ReactionStackedPlayers":   boardgame.TypePlayerIndexSlice,
+	"ReactionStackedValues":    boardgame.TypeIntSlice,
+	"TradeOfferedComponents":   boardgame.TypeIntSlice,
+	"TradeOfferedResources":    boardgame.TypeIntSlice,
+	"TradeOpen":                boardgame.TypeBool,
+	"TradeProposer":            boardgame.TypePlayerIndex,
+	"TradeRecipient":           boardgame.TypePlayerIndex,
+	"TradeRequestedComponents": boardgame.TypeIntSlice,
+	"TradeRequestedResources":  boardgame.TypeIntSlice,
+	"TrickLeader":              boardgame.TypePlayerIndex,
+	"TrickPlayedBy":            boardgame.TypePlayerIndexSlice,
+	"TrickStack":               boardgame.TypeStack,
+	"TrickSuit":                boardgame.TypeInt,
+}
+
+type ȧutoGeneratedGameStateReader struct {
+	data *gameState
+}
+
+func (g *ȧutoGeneratedGameStateReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedGameStateReaderProps
+}
+
+func (g *ȧutoGeneratedGameStateReader) Prop(name string) (interface{}, error) {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return g.IntProp(name)
+	case boardgame.TypeBool:
+		return g.BoolProp(name)
+	case boardgame.TypeString:
+		return g.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return g.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return g.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return g.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return g.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return g.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return g.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return g.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return g.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return g.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGameStateReader) PropMutable(name string) bool {
+	switch name {
+	case "ActionBoard":
+		return true
+	case "ActionSpacePlacedPlayers":
+		return true
+	case "ActionSpacePlacedSpaces":
+		return true
+	case "AuctionBid":
+		return true
+	case "AuctionBidder":
+		return true
+	case "AuctionInProgress":
+		return true
+	case "AuctionPassed":
+		return true
+	case "AuctionSealed":
+		return true
+	case "AuctionTurns":
+		return true
+	case "BankTaken":
+		return true
+	case "Counter":
+		return true
+	case "CurrentPlayer":
+		return true
+	case "Dice":
+		return true
+	case "DicePoolLocked":
+		return true
+	case "DicePoolRerolls":
+		return true
+	case "DicePoolRolled":
+		return true
+	case "DiscardStack":
+		return true
+	case "DraftPicked":
+		return true
+	case "DraftRoundCount":
+		return true
+	case "DraftTransit":
+		return true
+	case "DrawStack":
+		return true
+	case "Phase":
+		return true
+	case "RRHasStarted":
+		return true
+	case "RRLastPlayer":
+		return true
+	case "RRRoundCount":
+		return true
+	case "RRStarterPlayer":
+		return true
+	case "ReactionInProgress":
+		return true
+	case "ReactionLastToAct":
+		return true
+	case "ReactionNextResponder":
+		return true
+	case "ReactionPassed":
+		return true
+	case "ReactionStackedPlayers":
+		return true
+	case "ReactionStackedValues":
+		return true
+	case "TradeOfferedComponents":
+		return true
+	case "TradeOfferedResources":
+		return true
+	case "TradeOpen":
+		return true
+	case "TradeProposer":
+		return true
+	case "TradeRecipient":
+		return true
+	case "TradeRequestedComponents":
+		return true
+	case "TradeRequestedResources":
+		return true
+	case "TrickLeader":
+		return true
+	case "TrickPlayedBy":
+		return true
+	case "TrickStack":
+		return true
+	case "TrickSuit":
+		return true
+	}
+
+	return false
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetProp(name string, value interface{}) error {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return g.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return g.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return g.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return g.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return g.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return g.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureProp(name string, value interface{}) error {
+	props := g.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return g.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return g.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return g.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return g.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return g.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return g.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return g.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return g.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return g.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return g.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return g.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return g.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return g.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if g.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return g.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return g.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (g *ȧutoGeneratedGameStateReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "AuctionBid":
+		return g.data.AuctionBid, nil
+	case "AuctionTurns":
+		return g.data.AuctionTurns, nil
+	case "Counter":
+		return g.data.Counter, nil
+	case "DicePoolRerolls":
+		return g.data.DicePoolRerolls, nil
+	case "DraftRoundCount":
+		return g.data.DraftRoundCount, nil
+	case "RRRoundCount":
+		return g.data.RRRoundCount, nil
+	case "ReactionPassed":
+		return g.data.ReactionPassed, nil
+	case "TrickSuit":
+		return g.data.TrickSuit, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "AuctionBid":
+		g.data.AuctionBid = value
+		return nil
+	case "AuctionTurns":
+		g.data.AuctionTurns = value
+		return nil
+	case "Counter":
+		g.data.Counter = value
+		return nil
+	case "DicePoolRerolls":
+		g.data.DicePoolRerolls = value
+		return nil
+	case "DraftRoundCount":
+		g.data.DraftRoundCount = value
+		return nil
+	case "RRRoundCount":
+		g.data.RRRoundCount = value
+		return nil
+	case "ReactionPassed":
+		g.data.ReactionPassed = value
+		return nil
+	case "TrickSuit":
+		g.data.TrickSuit = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) BoolProp(name string) (bool, error) {
+
+	switch name {
+	case "AuctionInProgress":
+		return g.data.AuctionInProgress, nil
+	case "DicePoolRolled":
+		return g.data.DicePoolRolled, nil
+	case "RRHasStarted":
+		return g.data.RRHasStarted, nil
+	case "ReactionInProgress":
+		return g.data.ReactionInProgress, nil
+	case "TradeOpen":
+		return g.data.TradeOpen, nil
+
+	}
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetBoolProp(name string, value bool) error {
+
+	switch name {
+	case "AuctionInProgress":
+		g.data.AuctionInProgress = value
+		return nil
+	case "DicePoolRolled":
+		g.data.DicePoolRolled = value
+		return nil
+	case "RRHasStarted":
+		g.data.RRHasStarted = value
+		return nil
+	case "ReactionInProgress":
+		g.data.ReactionInProgress = value
+		return nil
+	case "TradeOpen":
+		g.data.TradeOpen = value
+		return nil
+
+	}
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "AuctionBidder":
+		return g.data.AuctionBidder, nil
+	case "CurrentPlayer":
+		return g.data.CurrentPlayer, nil
+	case "RRLastPlayer":
+		return g.data.RRLastPlayer, nil
+	case "RRStarterPlayer":
+		return g.data.RRStarterPlayer, nil
+	case "ReactionLastToAct":
+		return g.data.ReactionLastToAct, nil
+	case "ReactionNextResponder":
+		return g.data.ReactionNextResponder, nil
+	case "TradeProposer":
+		return g.data.TradeProposer, nil
+	case "TradeRecipient":
+		return g.data.TradeRecipient, nil
+	case "TrickLeader":
+		return g.data.TrickLeader, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "AuctionBidder":
+		g.data.AuctionBidder = value
+		return nil
+	case "CurrentPlayer":
+		g.data.CurrentPlayer = value
+		return nil
+	case "RRLastPlayer":
+		g.data.RRLastPlayer = value
+		return nil
+	case "RRStarterPlayer":
+		g.data.RRStarterPlayer = value
+		return nil
+	case "ReactionLastToAct":
+		g.data.ReactionLastToAct = value
+		return nil
+	case "ReactionNextResponder":
+		g.data.ReactionNextResponder = value
+		return nil
+	case "TradeProposer":
+		g.data.TradeProposer = value
+		return nil
+	case "TradeRecipient":
+		g.data.TradeRecipient = value
+		return nil
+	case "TrickLeader":
+		g.data.TrickLeader = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	switch name {
+	case "Phase":
+		return g.data.Phase, nil
+
+	}
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	switch name {
+	case "Phase":
+		g.data.Phase = value
+		return nil
+
+	}
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	switch name {
+	case "Phase":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) EnumProp(name string) (enum.Val, error) {
+
+	switch name {
+	case "Phase":
+		return g.data.Phase, nil
+
+	}
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) IntSliceProp(name string) ([]int, error) {
+
+	switch name {
+	case "ActionSpacePlacedSpaces":
+		return g.data.ActionSpacePlacedSpaces, nil
+	case "AuctionSealed":
+		return g.data.AuctionSealed, nil
+	case "BankTaken":
+		return g.data.BankTaken, nil
+	case "ReactionStackedValues":
+		return g.data.ReactionStackedValues, nil
+	case "TradeOfferedComponents":
+		return g.data.TradeOfferedComponents, nil
+	case "TradeOfferedResources":
+		return g.data.TradeOfferedResources, nil
+	case "TradeRequestedComponents":
+		return g.data.TradeRequestedComponents, nil
+	case "TradeRequestedResources":
+		return g.data.TradeRequestedResources, nil
+
+	}
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetIntSliceProp(name string, value []int) error {
+
+	switch name {
+	case "ActionSpacePlacedSpaces":
+		g.data.ActionSpacePlacedSpaces = value
+		return nil
+	case "AuctionSealed":
+		g.data.AuctionSealed = value
+		return nil
+	case "BankTaken":
+		g.data.BankTaken = value
+		return nil
+	case "ReactionStackedValues":
+		g.data.ReactionStackedValues = value
+		return nil
+	case "TradeOfferedComponents":
+		g.data.TradeOfferedComponents = value
+		return nil
+	case "TradeOfferedResources":
+		g.data.TradeOfferedResources = value
+		return nil
+	case "TradeRequestedComponents":
+		g.data.TradeRequestedComponents = value
+		return nil
+	case "TradeRequestedResources":
+		g.data.TradeRequestedResources = value
+		return nil
+
+	}
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) BoolSliceProp(name string) ([]bool, error) {
+
+	switch name {
+	case "DicePoolLocked":
+		return g.data.DicePoolLocked, nil
+
+	}
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetBoolSliceProp(name string, value []bool) error {
+
+	switch name {
+	case "DicePoolLocked":
+		g.data.DicePoolLocked = value
+		return nil
+
+	}
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "ActionSpacePlacedPlayers":
+		return g.data.ActionSpacePlacedPlayers, nil
+	case "AuctionPassed":
+		return g.data.AuctionPassed, nil
+	case "DraftPicked":
+		return g.data.DraftPicked, nil
+	case "ReactionStackedPlayers":
+		return g.data.ReactionStackedPlayers, nil
+	case "TrickPlayedBy":
+		return g.data.TrickPlayedBy, nil
+
+	}
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	switch name {
+	case "ActionSpacePlacedPlayers":
+		g.data.ActionSpacePlacedPlayers = value
+		return nil
+	case "AuctionPassed":
+		g.data.AuctionPassed = value
+		return nil
+	case "DraftPicked":
+		g.data.DraftPicked = value
+		return nil
+	case "ReactionStackedPlayers":
+		g.data.ReactionStackedPlayers = value
+		return nil
+	case "TrickPlayedBy":
+		g.data.TrickPlayedBy = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	switch name {
+	case "Dice":
+		return g.data.Dice, nil
+	case "DiscardStack":
+		return g.data.DiscardStack, nil
+	case "DraftTransit":
+		return g.data.DraftTransit, nil
+	case "DrawStack":
+		return g.data.DrawStack, nil
+	case "TrickStack":
+		return g.data.TrickStack, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	switch name {
+	case "Dice":
+		g.data.Dice = value
+		return nil
+	case "DiscardStack":
+		g.data.DiscardStack = value
+		return nil
+	case "DraftTransit":
+		g.data.DraftTransit = value
+		return nil
+	case "DrawStack":
+		g.data.DrawStack = value
+		return nil
+	case "TrickStack":
+		g.data.TrickStack = value
+		return nil
+
+	}
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	switch name {
+	case "Dice":
+		return boardgame.ErrPropertyImmutable
+	case "DiscardStack":
+		return boardgame.ErrPropertyImmutable
+	case "DraftTransit":
+		return boardgame.ErrPropertyImmutable
+	case "DrawStack":
+		return boardgame.ErrPropertyImmutable
+	case "TrickStack":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) StackProp(name string) (boardgame.Stack, error) {
+
+	switch name {
+	case "Dice":
+		return g.data.Dice, nil
+	case "DiscardStack":
+		return g.data.DiscardStack, nil
+	case "DraftTransit":
+		return g.data.DraftTransit, nil
+	case "DrawStack":
+		return g.data.DrawStack, nil
+	case "TrickStack":
+		return g.data.TrickStack, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	switch name {
+	case "ActionBoard":
+		return g.data.ActionBoard, nil
+
+	}
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	switch name {
+	case "ActionBoard":
+		g.data.ActionBoard = value
+		return nil
+
+	}
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	switch name {
+	case "ActionBoard":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) BoardProp(name string) (boardgame.Board, error) {
+
+	switch name {
+	case "ActionBoard":
+		return g.data.ActionBoard, nil
+
+	}
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (g *ȧutoGeneratedGameStateReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for gameState
+func (g *gameState) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedGameStateReader{g}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for gameState
+func (g *gameState) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedGameStateReader{g}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for gameState
+func (g *gameState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedGameStateReader{g}
+}
+
+// Implementation for playerState
+
+var ȧutoGeneratedPlayerStateReaderProps = map[string]boardgame.PropertyType{
+	"Brick":                 boardgame.TypeInt,
+	"Color":                 boardgame.TypeEnum,
+	"Counter":               boardgame.TypeInt,
+	"Hand":                  boardgame.TypeStack,
+	"OtherHand":             boardgame.TypeStack,
+	"Picked":                boardgame.TypeStack,
+	"ResourceCounts":        boardgame.TypeIntSlice,
+	"SecretChoiceCommitted": boardgame.TypeBool,
+	"SecretChoiceRevealed":  boardgame.TypeInt,
+	"SecretChoiceValue":     boardgame.TypeInt,
+	"WonCards":              boardgame.TypeStack,
+	"Wood":                  boardgame.TypeInt,
+}
+
+type ȧutoGeneratedPlayerStateReader struct {
+	data *playerState
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedPlayerStateReaderProps
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) Prop(name string) (interface{}, error) {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return p.IntProp(name)
+	case boardgame.TypeBool:
+		return p.BoolProp(name)
+	case boardgame.TypeString:
+		return p.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return p.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return p.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return p.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return p.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return p.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return p.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return p.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return p.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return p.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) PropMutable(name string) bool {
+	switch name {
+	case "Brick":
+		return true
+	case "Color":
+		return true
+	case "Counter":
+		return true
+	case "Hand":
+		return true
+	case "OtherHand":
+		return true
+	case "Picked":
+		return true
+	case "ResourceCounts":
+		return true
+	case "SecretChoiceCommitted":
+		return true
+	case "SecretChoiceRevealed":
+		return true
+	case "SecretChoiceValue":
+		return true
+	case "WonCards":
+		return true
+	case "Wood":
+		return true
+	}
+
+	return false
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureProp(name string, value interface{}) error {
+	props := p.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return p.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return p.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return p.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return p.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return p.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return p.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return p.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return p.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return p.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return p.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return p.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return p.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return p.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if p.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return p.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return p.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Brick":
+		return p.data.Brick, nil
+	case "Counter":
+		return p.data.Counter, nil
+	case "SecretChoiceRevealed":
+		return p.data.SecretChoiceRevealed, nil
+	case "SecretChoiceValue":
+		return p.data.SecretChoiceValue, nil
+	case "Wood":
+		return p.data.Wood, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Brick":
+		p.data.Brick = value
+		return nil
+	case "Counter":
+		p.data.Counter = value
+		return nil
+	case "SecretChoiceRevealed":
+		p.data.SecretChoiceRevealed = value
+		return nil
+	case "SecretChoiceValue":
+		p.data.SecretChoiceValue = value
+		return nil
+	case "Wood":
+		p.data.Wood = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) BoolProp(name string) (bool, error) {
+
+	switch name {
+	case "SecretChoiceCommitted":
+		return p.data.SecretChoiceCommitted, nil
+
+	}
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetBoolProp(name string, value bool) error {
+
+	switch name {
+	case "SecretChoiceCommitted":
+		p.data.SecretChoiceCommitted = value
+		return nil
+
+	}
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	switch name {
+	case "Color":
+		return p.data.Color, nil
+
+	}
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	switch name {
+	case "Color":
+		p.data.Color = value
+		return nil
+
+	}
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	switch name {
+	case "Color":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) EnumProp(name string) (enum.Val, error) {
+
+	switch name {
+	case "Color":
+		return p.data.Color, nil
+
+	}
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) IntSliceProp(name string) ([]int, error) {
+
+	switch name {
+	case "ResourceCounts":
+		return p.data.ResourceCounts, nil
+
+	}
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetIntSliceProp(name string, value []int) error {
+
+	switch name {
+	case "ResourceCounts":
+		p.data.ResourceCounts = value
+		return nil
+
+	}
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	switch name {
+	case "Hand":
+		return p.data.Hand, nil
+	case "OtherHand":
+		return p.data.OtherHand, nil
+	case "Picked":
+		return p.data.Picked, nil
+	case "WonCards":
+		return p.data.WonCards, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	switch name {
+	case "Hand":
+		p.data.Hand = value
+		return nil
+	case "OtherHand":
+		p.data.OtherHand = value
+		return nil
+	case "Picked":
+		p.data.Picked = value
+		return nil
+	case "WonCards":
+		p.data.WonCards = value
+		return nil
+
+	}
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	switch name {
+	case "Hand":
+		return boardgame.ErrPropertyImmutable
+	case "OtherHand":
+		return boardgame.ErrPropertyImmutable
+	case "Picked":
+		return boardgame.ErrPropertyImmutable
+	case "WonCards":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) StackProp(name string) (boardgame.Stack, error) {
+
+	switch name {
+	case "Hand":
+		return p.data.Hand, nil
+	case "OtherHand":
+		return p.data.OtherHand, nil
+	case "Picked":
+		return p.data.Picked, nil
+	case "WonCards":
+		return p.data.WonCards, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (p *ȧutoGeneratedPlayerStateReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for playerState
+func (p *playerState) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedPlayerStateReader{p}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for playerState
+func (p *playerState) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedPlayerStateReader{p}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for playerState
+func (p *playerState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedPlayerStateReader{p}
+}
+
+// Implementation for moveDealCards
+
+var ȧutoGeneratedMoveDealCardsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveDealCardsReader struct {
+	data *moveDealCards
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveDealCardsReaderProps
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealCardsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for moveDealCards
+func (m *moveDealCards) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveDealCardsReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveDealCards
+func (m *moveDealCards) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveDealCardsReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveDealCards
+func (m *moveDealCards) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveDealCardsReader{m}
+}
+
+// Implementation for moveDealOtherCards
+
+var ȧutoGeneratedMoveDealOtherCardsReaderProps = map[string]boardgame.PropertyType{}
+
+type ȧutoGeneratedMoveDealOtherCardsReader struct {
+	data *moveDealOtherCards
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveDealOtherCardsReaderProps
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) PropMutable(name string) bool {
+	switch name {
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetIntProp(name string, value int) error {
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (m *ȧutoGeneratedMoveDealOtherCardsReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for moveDealOtherCards
+func (m *moveDealOtherCards) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveDealOtherCards
+func (m *moveDealOtherCards) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveDealOtherCards
+func (m *moveDealOtherCards) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+}
+
+// Implementation for moveCurrentPlayerDraw
+
+var ȧutoGeneratedMoveCurrentPlayerDrawReaderProps = map[string]boardgame.PropertyType{
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
+
+type ȧutoGeneratedMoveCurrentPlayerDrawReader struct {
+	data *moveCurrentPlayerDraw
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveCurrentPlayerDrawReaderProps
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return m.IntProp(name)
+	case boardgame.TypeBool:
+		return m.BoolProp(name)
+	case boardgame.TypeString:
+		return m.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return m.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return m.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return m.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return m.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return m.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return m.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return m.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return m.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return m.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PropMutable(name string) bool {
+	switch name {
+	case "TargetPlayerIndex":
+		return true
+	}
+
+	return false
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return m.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return m.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return m.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return m.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return m.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return m.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return m.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return m.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return m.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return m.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return m.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return m.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return m.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if m.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return m.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return m.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) IntProp(name string) (int, error) {
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
 
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetIntProp(name string, value int) error {
 
-	return false, errors.New("No such Bool prop: " + name)
+	return errors.New("No such Int prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoolProp(name string) (bool, error) {
 
-	switch name {
-	case "RRHasStarted":
-		g.data.RRHasStarted = value
-		return nil
+	return false, errors.New("No such Bool prop: " + name)
 
-	}
+}
+
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
-	case "CurrentPlayer":
-		return g.data.CurrentPlayer, nil
-	case "RRLastPlayer":
-		return g.data.RRLastPlayer, nil
-	case "RRStarterPlayer":
-		return g.data.RRStarterPlayer, nil
+	case "TargetPlayerIndex":
+		return m.data.TargetPlayerIndex, nil
 
 	}
 
@@ -1757,17 +5111,11 @@ func (g *ȧutoGeneratedGameStateReader) PlayerIndexProp(name string) (boardgame.
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	switch name {
-	case "CurrentPlayer":
-		g.data.CurrentPlayer = value
-		return nil
-	case "RRLastPlayer":
-		g.data.RRLastPlayer = value
-		return nil
-	case "RRStarterPlayer":
-		g.data.RRStarterPlayer = value
+	case "TargetPlayerIndex":
+		m.data.TargetPlayerIndex = value
 		return nil
 
 	}
@@ -1776,243 +5124,179 @@ func (g *ȧutoGeneratedGameStateReader) SetPlayerIndexProp(name string, value bo
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
-
-	switch name {
-	case "Phase":
-		return g.data.Phase, nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureEnumProp(name string, value enum.Val) error {
-
-	switch name {
-	case "Phase":
-		g.data.Phase = value
-		return nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
-
-	switch name {
-	case "Phase":
-		return boardgame.ErrPropertyImmutable
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) EnumProp(name string) (enum.Val, error) {
-
-	switch name {
-	case "Phase":
-		return g.data.Phase, nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
-
-	switch name {
-	case "DiscardStack":
-		return g.data.DiscardStack, nil
-	case "DrawStack":
-		return g.data.DrawStack, nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
-
-	switch name {
-	case "DiscardStack":
-		g.data.DiscardStack = value
-		return nil
-	case "DrawStack":
-		g.data.DrawStack = value
-		return nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
-
-	switch name {
-	case "DiscardStack":
-		return boardgame.ErrPropertyImmutable
-	case "DrawStack":
-		return boardgame.ErrPropertyImmutable
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) StackProp(name string) (boardgame.Stack, error) {
-
-	switch name {
-	case "DiscardStack":
-		return g.data.DiscardStack, nil
-	case "DrawStack":
-		return g.data.DrawStack, nil
-
-	}
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (g *ȧutoGeneratedGameStateReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for gameState
-func (g *gameState) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedGameStateReader{g}
+//Reader returns an autp-generated boardgame.PropertyReader for moveCurrentPlayerDraw
+func (m *moveCurrentPlayerDraw) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for gameState
-func (g *gameState) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedGameStateReader{g}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveCurrentPlayerDraw
+func (m *moveCurrentPlayerDraw) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for gameState
-func (g *gameState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedGameStateReader{g}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveCurrentPlayerDraw
+func (m *moveCurrentPlayerDraw) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
 }
 
-// Implementation for playerState
+// Implementation for moveStartPhaseDrawAgain
 
-var ȧutoGeneratedPlayerStateReaderProps = map[string]boardgame.PropertyType{
-	"Color":     boardgame.TypeEnum,
-	"Counter":   boardgame.TypeInt,
-	"Hand":      boardgame.TypeStack,
-	"OtherHand": boardgame.TypeStack,
-}
+var ȧutoGeneratedMoveStartPhaseDrawAgainReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedPlayerStateReader struct {
-	data *playerState
+type ȧutoGeneratedMoveStartPhaseDrawAgainReader struct {
+	data *moveStartPhaseDrawAgain
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedPlayerStateReaderProps
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveStartPhaseDrawAgainReaderProps
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) Prop(name string) (interface{}, error) {
-	props := p.Props()
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) Prop(name string) (interface{}, error) {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2021,52 +5305,44 @@ func (p *ȧutoGeneratedPlayerStateReader) Prop(name string) (interface{}, error)
 
 	switch propType {
 	case boardgame.TypeInt:
-		return p.IntProp(name)
+		return m.IntProp(name)
 	case boardgame.TypeBool:
-		return p.BoolProp(name)
+		return m.BoolProp(name)
 	case boardgame.TypeString:
-		return p.StringProp(name)
+		return m.StringProp(name)
 	case boardgame.TypePlayerIndex:
-		return p.PlayerIndexProp(name)
+		return m.PlayerIndexProp(name)
 	case boardgame.TypeEnum:
-		return p.ImmutableEnumProp(name)
+		return m.ImmutableEnumProp(name)
 	case boardgame.TypeIntSlice:
-		return p.IntSliceProp(name)
+		return m.IntSliceProp(name)
 	case boardgame.TypeBoolSlice:
-		return p.BoolSliceProp(name)
+		return m.BoolSliceProp(name)
 	case boardgame.TypeStringSlice:
-		return p.StringSliceProp(name)
+		return m.StringSliceProp(name)
 	case boardgame.TypePlayerIndexSlice:
-		return p.PlayerIndexSliceProp(name)
+		return m.PlayerIndexSliceProp(name)
 	case boardgame.TypeStack:
-		return p.ImmutableStackProp(name)
+		return m.ImmutableStackProp(name)
 	case boardgame.TypeBoard:
-		return p.ImmutableBoardProp(name)
+		return m.ImmutableBoardProp(name)
 	case boardgame.TypeTimer:
-		return p.ImmutableTimerProp(name)
+		return m.ImmutableTimerProp(name)
 
 	}
 
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PropMutable(name string) bool {
 	switch name {
-	case "Color":
-		return true
-	case "Counter":
-		return true
-	case "Hand":
-		return true
-	case "OtherHand":
-		return true
 	}
 
 	return false
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetProp(name string, value interface{}) error {
-	props := p.Props()
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetProp(name string, value interface{}) error {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2079,25 +5355,25 @@ func (p *ȧutoGeneratedPlayerStateReader) SetProp(name string, value interface{}
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return p.SetIntProp(name, val)
+		return m.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return p.SetBoolProp(name, val)
+		return m.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return p.SetStringProp(name, val)
+		return m.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return p.SetPlayerIndexProp(name, val)
+		return m.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeIntSlice:
@@ -2105,25 +5381,25 @@ func (p *ȧutoGeneratedPlayerStateReader) SetProp(name string, value interface{}
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return p.SetIntSliceProp(name, val)
+		return m.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return p.SetBoolSliceProp(name, val)
+		return m.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return p.SetStringSliceProp(name, val)
+		return m.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return p.SetPlayerIndexSliceProp(name, val)
+		return m.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
 		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
 	case boardgame.TypeBoard:
@@ -2136,8 +5412,8 @@ func (p *ȧutoGeneratedPlayerStateReader) SetProp(name string, value interface{}
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureProp(name string, value interface{}) error {
-	props := p.Props()
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureProp(name string, value interface{}) error {
+	props := m.Props()
 	propType, ok := props[name]
 
 	if !ok {
@@ -2150,407 +5426,335 @@ func (p *ȧutoGeneratedPlayerStateReader) ConfigureProp(name string, value inter
 		if !ok {
 			return errors.New("Provided value was not of type int")
 		}
-		return p.SetIntProp(name, val)
+		return m.SetIntProp(name, val)
 	case boardgame.TypeBool:
 		val, ok := value.(bool)
 		if !ok {
 			return errors.New("Provided value was not of type bool")
 		}
-		return p.SetBoolProp(name, val)
+		return m.SetBoolProp(name, val)
 	case boardgame.TypeString:
 		val, ok := value.(string)
 		if !ok {
 			return errors.New("Provided value was not of type string")
 		}
-		return p.SetStringProp(name, val)
+		return m.SetStringProp(name, val)
 	case boardgame.TypePlayerIndex:
 		val, ok := value.(boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.PlayerIndex")
 		}
-		return p.SetPlayerIndexProp(name, val)
+		return m.SetPlayerIndexProp(name, val)
 	case boardgame.TypeEnum:
-		if p.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(enum.Val)
 			if !ok {
 				return errors.New("Provided value was not of type enum.Val")
 			}
-			return p.ConfigureEnumProp(name, val)
+			return m.ConfigureEnumProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(enum.ImmutableVal)
 		if !ok {
 			return errors.New("Provided value was not of type enum.ImmutableVal")
 		}
-		return p.ConfigureImmutableEnumProp(name, val)
+		return m.ConfigureImmutableEnumProp(name, val)
 	case boardgame.TypeIntSlice:
 		val, ok := value.([]int)
 		if !ok {
 			return errors.New("Provided value was not of type []int")
 		}
-		return p.SetIntSliceProp(name, val)
+		return m.SetIntSliceProp(name, val)
 	case boardgame.TypeBoolSlice:
 		val, ok := value.([]bool)
 		if !ok {
 			return errors.New("Provided value was not of type []bool")
 		}
-		return p.SetBoolSliceProp(name, val)
+		return m.SetBoolSliceProp(name, val)
 	case boardgame.TypeStringSlice:
 		val, ok := value.([]string)
 		if !ok {
 			return errors.New("Provided value was not of type []string")
 		}
-		return p.SetStringSliceProp(name, val)
+		return m.SetStringSliceProp(name, val)
 	case boardgame.TypePlayerIndexSlice:
 		val, ok := value.([]boardgame.PlayerIndex)
 		if !ok {
 			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
 		}
-		return p.SetPlayerIndexSliceProp(name, val)
+		return m.SetPlayerIndexSliceProp(name, val)
 	case boardgame.TypeStack:
-		if p.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Stack)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Stack")
 			}
-			return p.ConfigureStackProp(name, val)
+			return m.ConfigureStackProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableStack)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableStack")
 		}
-		return p.ConfigureImmutableStackProp(name, val)
+		return m.ConfigureImmutableStackProp(name, val)
 	case boardgame.TypeBoard:
-		if p.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Board)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Board")
 			}
-			return p.ConfigureBoardProp(name, val)
+			return m.ConfigureBoardProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableBoard)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
 		}
-		return p.ConfigureImmutableBoardProp(name, val)
+		return m.ConfigureImmutableBoardProp(name, val)
 	case boardgame.TypeTimer:
-		if p.PropMutable(name) {
+		if m.PropMutable(name) {
 			//Mutable variant
 			val, ok := value.(boardgame.Timer)
 			if !ok {
 				return errors.New("Provided value was not of type boardgame.Timer")
 			}
-			return p.ConfigureTimerProp(name, val)
+			return m.ConfigureTimerProp(name, val)
 		}
 		//Immutable variant
 		val, ok := value.(boardgame.ImmutableTimer)
 		if !ok {
 			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
 		}
-		return p.ConfigureImmutableTimerProp(name, val)
+		return m.ConfigureImmutableTimerProp(name, val)
 
 	}
 
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) IntProp(name string) (int, error) {
-
-	switch name {
-	case "Counter":
-		return p.data.Counter, nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetIntProp(name string, value int) error {
-
-	switch name {
-	case "Counter":
-		p.data.Counter = value
-		return nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
-
-	switch name {
-	case "Color":
-		return p.data.Color, nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureEnumProp(name string, value enum.Val) error {
-
-	switch name {
-	case "Color":
-		p.data.Color = value
-		return nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
-
-	switch name {
-	case "Color":
-		return boardgame.ErrPropertyImmutable
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) EnumProp(name string) (enum.Val, error) {
-
-	switch name {
-	case "Color":
-		return p.data.Color, nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
-
-	switch name {
-	case "Hand":
-		return p.data.Hand, nil
-	case "OtherHand":
-		return p.data.OtherHand, nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
-
-	switch name {
-	case "Hand":
-		p.data.Hand = value
-		return nil
-	case "OtherHand":
-		p.data.OtherHand = value
-		return nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
-
-	switch name {
-	case "Hand":
-		return boardgame.ErrPropertyImmutable
-	case "OtherHand":
-		return boardgame.ErrPropertyImmutable
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) StackProp(name string) (boardgame.Stack, error) {
-
-	switch name {
-	case "Hand":
-		return p.data.Hand, nil
-	case "OtherHand":
-		return p.data.OtherHand, nil
-
-	}
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (p *ȧutoGeneratedPlayerStateReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for playerState
-func (p *playerState) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedPlayerStateReader{p}
+//Reader returns an autp-generated boardgame.PropertyReader for moveStartPhaseDrawAgain
+func (m *moveStartPhaseDrawAgain) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for playerState
-func (p *playerState) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedPlayerStateReader{p}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveStartPhaseDrawAgain
+func (m *moveStartPhaseDrawAgain) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for playerState
-func (p *playerState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedPlayerStateReader{p}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveStartPhaseDrawAgain
+func (m *moveStartPhaseDrawAgain) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
 }
 
-// Implementation for moveDealCards
+// Implementation for moveStartPhaseIllegal
 
-var ȧutoGeneratedMoveDealCardsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveStartPhaseIllegalReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveDealCardsReader struct {
-	data *moveDealCards
+type ȧutoGeneratedMoveStartPhaseIllegalReader struct {
+	data *moveStartPhaseIllegal
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveDealCardsReaderProps
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveStartPhaseIllegalReaderProps
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -2589,14 +5793,14 @@ func (m *ȧutoGeneratedMoveDealCardsReader) Prop(name string) (interface{}, erro
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -2667,7 +5871,7 @@ func (m *ȧutoGeneratedMoveDealCardsReader) SetProp(name string, value interface
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -2790,226 +5994,226 @@ func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureProp(name string, value int
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealCardsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveDealCards
-func (m *moveDealCards) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveDealCardsReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveStartPhaseIllegal
+func (m *moveStartPhaseIllegal) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveDealCards
-func (m *moveDealCards) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveDealCardsReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveStartPhaseIllegal
+func (m *moveStartPhaseIllegal) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveDealCards
-func (m *moveDealCards) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveDealCardsReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveStartPhaseIllegal
+func (m *moveStartPhaseIllegal) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
 }
 
-// Implementation for moveDealOtherCards
+// Implementation for moveResolveReactionsRecord
 
-var ȧutoGeneratedMoveDealOtherCardsReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveResolveReactionsRecordReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveDealOtherCardsReader struct {
-	data *moveDealOtherCards
+type ȧutoGeneratedMoveResolveReactionsRecordReader struct {
+	data *moveResolveReactionsRecord
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveDealOtherCardsReaderProps
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveResolveReactionsRecordReaderProps
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3048,14 +6252,14 @@ func (m *ȧutoGeneratedMoveDealOtherCardsReader) Prop(name string) (interface{},
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3126,7 +6330,7 @@ func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetProp(name string, value inte
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3249,228 +6453,228 @@ func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureProp(name string, valu
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveDealOtherCardsReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveResolveReactionsRecordReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveDealOtherCards
-func (m *moveDealOtherCards) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveResolveReactionsRecord
+func (m *moveResolveReactionsRecord) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveResolveReactionsRecordReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveDealOtherCards
-func (m *moveDealOtherCards) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveResolveReactionsRecord
+func (m *moveResolveReactionsRecord) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveResolveReactionsRecordReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveDealOtherCards
-func (m *moveDealOtherCards) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveDealOtherCardsReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveResolveReactionsRecord
+func (m *moveResolveReactionsRecord) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveResolveReactionsRecordReader{m}
 }
 
-// Implementation for moveCurrentPlayerDraw
+// Implementation for moveBuildRoad
 
-var ȧutoGeneratedMoveCurrentPlayerDrawReaderProps = map[string]boardgame.PropertyType{
+var ȧutoGeneratedMoveBuildRoadReaderProps = map[string]boardgame.PropertyType{
 	"TargetPlayerIndex": boardgame.TypePlayerIndex,
 }
 
-type ȧutoGeneratedMoveCurrentPlayerDrawReader struct {
-	data *moveCurrentPlayerDraw
+type ȧutoGeneratedMoveBuildRoadReader struct {
+	data *moveBuildRoad
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveCurrentPlayerDrawReaderProps
+func (m *ȧutoGeneratedMoveBuildRoadReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveBuildRoadReaderProps
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3509,7 +6713,7 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) Prop(name string) (interface
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveBuildRoadReader) PropMutable(name string) bool {
 	switch name {
 	case "TargetPlayerIndex":
 		return true
@@ -3518,7 +6722,7 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PropMutable(name string) boo
 	return false
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3589,7 +6793,7 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetProp(name string, value i
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3712,43 +6916,43 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureProp(name string, v
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	switch name {
 	case "TargetPlayerIndex":
@@ -3760,7 +6964,7 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PlayerIndexProp(name string)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	switch name {
 	case "TargetPlayerIndex":
@@ -3773,178 +6977,178 @@ func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetPlayerIndexProp(name stri
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveBuildRoadReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveCurrentPlayerDrawReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveBuildRoadReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveCurrentPlayerDraw
-func (m *moveCurrentPlayerDraw) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveBuildRoad
+func (m *moveBuildRoad) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveBuildRoadReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveCurrentPlayerDraw
-func (m *moveCurrentPlayerDraw) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveBuildRoad
+func (m *moveBuildRoad) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveBuildRoadReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveCurrentPlayerDraw
-func (m *moveCurrentPlayerDraw) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveCurrentPlayerDrawReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveBuildRoad
+func (m *moveBuildRoad) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveBuildRoadReader{m}
 }
 
-// Implementation for moveStartPhaseDrawAgain
+// Implementation for moveResolveTrickHeartsTrump
 
-var ȧutoGeneratedMoveStartPhaseDrawAgainReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMoveResolveTrickHeartsTrumpReaderProps = map[string]boardgame.PropertyType{}
 
-type ȧutoGeneratedMoveStartPhaseDrawAgainReader struct {
-	data *moveStartPhaseDrawAgain
+type ȧutoGeneratedMoveResolveTrickHeartsTrumpReader struct {
+	data *moveResolveTrickHeartsTrump
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveStartPhaseDrawAgainReaderProps
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMoveResolveTrickHeartsTrumpReaderProps
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -3983,14 +7187,14 @@ func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) Prop(name string) (interfa
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) PropMutable(name string) bool {
 	switch name {
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -4061,7 +7265,7 @@ func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetProp(name string, value
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -4184,226 +7388,229 @@ func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureProp(name string,
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) IntProp(name string) (int, error) {
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetIntProp(name string, value int) error {
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseDrawAgainReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMoveResolveTrickHeartsTrumpReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveStartPhaseDrawAgain
-func (m *moveStartPhaseDrawAgain) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for moveResolveTrickHeartsTrump
+func (m *moveResolveTrickHeartsTrump) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMoveResolveTrickHeartsTrumpReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveStartPhaseDrawAgain
-func (m *moveStartPhaseDrawAgain) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveResolveTrickHeartsTrump
+func (m *moveResolveTrickHeartsTrump) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMoveResolveTrickHeartsTrumpReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveStartPhaseDrawAgain
-func (m *moveStartPhaseDrawAgain) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveStartPhaseDrawAgainReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveResolveTrickHeartsTrump
+func (m *moveResolveTrickHeartsTrump) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMoveResolveTrickHeartsTrumpReader{m}
 }
 
-// Implementation for moveStartPhaseIllegal
+// Implementation for movePlaceWorkerAndPass
 
-var ȧutoGeneratedMoveStartPhaseIllegalReaderProps = map[string]boardgame.PropertyType{}
+var ȧutoGeneratedMovePlaceWorkerAndPassReaderProps = map[string]boardgame.PropertyType{
+	"Space":             boardgame.TypeInt,
+	"TargetPlayerIndex": boardgame.TypePlayerIndex,
+}
 
-type ȧutoGeneratedMoveStartPhaseIllegalReader struct {
-	data *moveStartPhaseIllegal
+type ȧutoGeneratedMovePlaceWorkerAndPassReader struct {
+	data *movePlaceWorkerAndPass
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) Props() map[string]boardgame.PropertyType {
-	return ȧutoGeneratedMoveStartPhaseIllegalReaderProps
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedMovePlaceWorkerAndPassReaderProps
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) Prop(name string) (interface{}, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) Prop(name string) (interface{}, error) {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -4442,14 +7649,18 @@ func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) Prop(name string) (interface
 	return nil, errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PropMutable(name string) bool {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) PropMutable(name string) bool {
 	switch name {
+	case "Space":
+		return true
+	case "TargetPlayerIndex":
+		return true
 	}
 
 	return false
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -4520,7 +7731,7 @@ func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetProp(name string, value i
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureProp(name string, value interface{}) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureProp(name string, value interface{}) error {
 	props := m.Props()
 	propType, ok := props[name]
 
@@ -4643,209 +7854,235 @@ func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureProp(name string, v
 	return errors.New("Unexpected property type: " + propType.String())
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) IntProp(name string) (int, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Space":
+		return m.data.Space, nil
+
+	}
 
 	return 0, errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetIntProp(name string, value int) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Space":
+		m.data.Space = value
+		return nil
+
+	}
 
 	return errors.New("No such Int prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoolProp(name string) (bool, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) BoolProp(name string) (bool, error) {
 
 	return false, errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetBoolProp(name string, value bool) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetBoolProp(name string, value bool) error {
 
 	return errors.New("No such Bool prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StringProp(name string) (string, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) StringProp(name string) (string, error) {
 
 	return "", errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetStringProp(name string, value string) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetStringProp(name string, value string) error {
 
 	return errors.New("No such String prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "TargetPlayerIndex":
+		return m.data.TargetPlayerIndex, nil
+
+	}
 
 	return 0, errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "TargetPlayerIndex":
+		m.data.TargetPlayerIndex = value
+		return nil
+
+	}
 
 	return errors.New("No such PlayerIndex prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureEnumProp(name string, value enum.Val) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureEnumProp(name string, value enum.Val) error {
 
 	return errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
 
 	return errors.New("No such ImmutableEnum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) EnumProp(name string) (enum.Val, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) EnumProp(name string) (enum.Val, error) {
 
 	return nil, errors.New("No such Enum prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) IntSliceProp(name string) ([]int, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) IntSliceProp(name string) ([]int, error) {
 
 	return []int{}, errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetIntSliceProp(name string, value []int) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetIntSliceProp(name string, value []int) error {
 
 	return errors.New("No such IntSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoolSliceProp(name string) ([]bool, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) BoolSliceProp(name string) ([]bool, error) {
 
 	return []bool{}, errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetBoolSliceProp(name string, value []bool) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetBoolSliceProp(name string, value []bool) error {
 
 	return errors.New("No such BoolSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StringSliceProp(name string) ([]string, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) StringSliceProp(name string) ([]string, error) {
 
 	return []string{}, errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetStringSliceProp(name string, value []string) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetStringSliceProp(name string, value []string) error {
 
 	return errors.New("No such StringSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
 
 	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
 
 	return errors.New("No such PlayerIndexSlice prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureStackProp(name string, value boardgame.Stack) error {
 
 	return errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
 
 	return errors.New("No such ImmutableStack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) StackProp(name string) (boardgame.Stack, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) StackProp(name string) (boardgame.Stack, error) {
 
 	return nil, errors.New("No such Stack prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureBoardProp(name string, value boardgame.Board) error {
 
 	return errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
 
 	return errors.New("No such ImmutableBoard prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) BoardProp(name string) (boardgame.Board, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) BoardProp(name string) (boardgame.Board, error) {
 
 	return nil, errors.New("No such Board prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
 
 	return errors.New("No such Timer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
 
 	return errors.New("No such ImmutableTimer prop: " + name)
 
 }
 
-func (m *ȧutoGeneratedMoveStartPhaseIllegalReader) TimerProp(name string) (boardgame.Timer, error) {
+func (m *ȧutoGeneratedMovePlaceWorkerAndPassReader) TimerProp(name string) (boardgame.Timer, error) {
 
 	return nil, errors.New("No such Timer prop: " + name)
 
 }
 
-//Reader returns an autp-generated boardgame.PropertyReader for moveStartPhaseIllegal
-func (m *moveStartPhaseIllegal) Reader() boardgame.PropertyReader {
-	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
+//Reader returns an autp-generated boardgame.PropertyReader for movePlaceWorkerAndPass
+func (m *movePlaceWorkerAndPass) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedMovePlaceWorkerAndPassReader{m}
 }
 
-//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for moveStartPhaseIllegal
-func (m *moveStartPhaseIllegal) ReadSetter() boardgame.PropertyReadSetter {
-	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for movePlaceWorkerAndPass
+func (m *movePlaceWorkerAndPass) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedMovePlaceWorkerAndPassReader{m}
 }
 
-//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for moveStartPhaseIllegal
-func (m *moveStartPhaseIllegal) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
-	return &ȧutoGeneratedMoveStartPhaseIllegalReader{m}
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for movePlaceWorkerAndPass
+func (m *movePlaceWorkerAndPass) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedMovePlaceWorkerAndPassReader{m}
 }
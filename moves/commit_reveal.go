@@ -0,0 +1,208 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//choiceValidator is implemented by CommitAction.
+type choiceValidator interface {
+	ValidChoice(state boardgame.ImmutableState, player boardgame.PlayerIndex, choice int) error
+}
+
+/*
+
+CommitAction is a move for games where every player secretly chooses at the
+same time, and then all of the choices are revealed at once, like
+rock-paper-scissors. TargetPlayerIndex commits to Choice. It doesn't depend on
+the current player, so any player may commit, in any order, as long as they
+haven't already committed this round. Your PlayerState must implement
+interfaces.SecretChooser, typically by embedding behaviors.SecretChoice, which
+hides the committed choice from other players.
+
+Once every player who may be active has committed, RevealActions reveals all
+of the choices at once. Choices are ints; idiomatically they're values from an
+enum. Override ValidChoice to restrict which choices are legal.
+
+boardgame:codegen
+*/
+type CommitAction struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+	Choice            int
+}
+
+//ValidChoice returns nil. Override it in your embedding move to return an
+//error for choices that player may not make.
+func (c *CommitAction) ValidChoice(state boardgame.ImmutableState, player boardgame.PlayerIndex, choice int) error {
+	return nil
+}
+
+//Legal checks that TargetPlayerIndex is the proposer, that they may be
+//active, that they haven't already committed this round, and that ValidChoice
+//returns nil.
+func (c *CommitAction) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := c.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	target := c.TargetPlayerIndex
+
+	if target < 0 || !target.Valid(state) {
+		return errors.New("The specified target player is not valid")
+	}
+
+	if !target.Equivalent(proposer) {
+		return errors.New("You may only commit your own choice")
+	}
+
+	chooser, ok := state.ImmutablePlayerStates()[target].(interfaces.SecretChooser)
+
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.SecretChooser")
+	}
+
+	if chooser.HasCommittedChoice() {
+		return errors.New("You've already made your choice")
+	}
+
+	validator, ok := c.TopLevelStruct().(choiceValidator)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ValidChoice")
+	}
+
+	return validator.ValidChoice(state, target, c.Choice)
+}
+
+//Apply commits Choice for TargetPlayerIndex.
+func (c *CommitAction) Apply(state boardgame.State) error {
+	chooser, ok := state.PlayerStates()[c.TargetPlayerIndex].(interfaces.SecretChooser)
+
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.SecretChooser")
+	}
+
+	chooser.CommitChoice(c.Choice)
+
+	return nil
+}
+
+//HideSecrets zeroes Choice for everyone but TargetPlayerIndex. Other players
+//learn the choice from the player state's RevealedChoice once RevealActions
+//applies.
+func (c *CommitAction) HideSecrets(player boardgame.PlayerIndex) {
+	if c.TargetPlayerIndex.Equivalent(player) {
+		return
+	}
+	c.Choice = 0
+}
+
+//ValidConfiguration checks that player states implement
+//interfaces.SecretChooser.
+func (c *CommitAction) ValidConfiguration(exampleState boardgame.State) error {
+	if err := c.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	if _, ok := exampleState.ImmutablePlayerStates()[0].(interfaces.SecretChooser); !ok {
+		return errors.New("Player state didn't implement interfaces.SecretChooser. behaviors.SecretChoice implements it for free")
+	}
+	return nil
+}
+
+//FallbackName returns "Commit Action"
+func (c *CommitAction) FallbackName(m *boardgame.GameManager) string {
+	return "Commit Action"
+}
+
+//FallbackHelpText returns "Secretly commits to a choice, to be revealed once
+//every player has chosen."
+func (c *CommitAction) FallbackHelpText() string {
+	return "Secretly commits to a choice, to be revealed once every player has chosen."
+}
+
+/*
+
+RevealActions is a fix up move that applies once every player who may be active
+(see GameDelegate.PlayerMayBeActive) has committed a choice with CommitAction.
+It reveals every player's choice at once by calling RevealChoice on each
+player who committed, which also readies them to commit again.
+
+Your game logic can then act on each player's RevealedChoice, for example by
+embedding this move and doing so in Apply after calling RevealActions.Apply.
+
+boardgame:codegen
+*/
+type RevealActions struct {
+	FixUp
+}
+
+//Legal returns nil once every player who may be active has committed a
+//choice.
+func (r *RevealActions) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	numCommitted := 0
+
+	for i, player := range state.ImmutablePlayerStates() {
+		if !boardgame.PlayerIndex(i).Valid(state) {
+			continue
+		}
+		chooser, ok := player.(interfaces.SecretChooser)
+		if !ok {
+			return errors.New("Player state didn't implement interfaces.SecretChooser")
+		}
+		if !chooser.HasCommittedChoice() {
+			return errors.New("Not every player has made their choice")
+		}
+		numCommitted++
+	}
+
+	if numCommitted == 0 {
+		return errors.New("No players have made a choice")
+	}
+
+	return nil
+}
+
+//Apply calls RevealChoice on every player who has committed a choice.
+func (r *RevealActions) Apply(state boardgame.State) error {
+	for _, player := range state.PlayerStates() {
+		chooser, ok := player.(interfaces.SecretChooser)
+		if !ok {
+			return errors.New("Player state didn't implement interfaces.SecretChooser")
+		}
+		if !chooser.HasCommittedChoice() {
+			continue
+		}
+		chooser.RevealChoice()
+	}
+	return nil
+}
+
+//ValidConfiguration checks that player states implement
+//interfaces.SecretChooser.
+func (r *RevealActions) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	if _, ok := exampleState.ImmutablePlayerStates()[0].(interfaces.SecretChooser); !ok {
+		return errors.New("Player state didn't implement interfaces.SecretChooser. behaviors.SecretChoice implements it for free")
+	}
+	return nil
+}
+
+//FallbackName returns "Reveal Actions"
+func (r *RevealActions) FallbackName(m *boardgame.GameManager) string {
+	return "Reveal Actions"
+}
+
+//FallbackHelpText returns "Reveals every player's secret choice at once, after
+//they've all chosen."
+func (r *RevealActions) FallbackHelpText() string {
+	return "Reveals every player's secret choice at once, after they've all chosen."
+}
@@ -0,0 +1,85 @@
+package moves
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/workfit/tester/assert"
+)
+
+func commitRevealMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return []boardgame.MoveConfig{
+		auto.MustConfig(new(CommitAction)),
+		auto.MustConfig(new(RevealActions)),
+	}
+}
+
+func TestCommitReveal(t *testing.T) {
+	manager, err := newGameManager(commitRevealMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	commit := func(target, proposer boardgame.PlayerIndex, choice int) error {
+		move := game.MoveByName("Commit Action").(*CommitAction)
+		move.TargetPlayerIndex = target
+		move.Choice = choice
+		return <-game.ProposeMove(move, proposer)
+	}
+
+	//Players commit in any order, not just the current player.
+	assert.For(t).ThatActual(commit(2, 2, 7)).IsNil()
+	assert.For(t).ThatActual(commit(2, 2, 8)).IsNotNil()
+	assert.For(t).ThatActual(commit(1, 3, 4)).IsNotNil()
+
+	sanitized, err := game.CurrentState().SanitizedForPlayer(0)
+	assert.For(t).ThatActual(err).IsNil()
+	_, sanitizedPlayers := concreteStates(sanitized)
+	assert.For(t).ThatActual(sanitizedPlayers[2].HasCommittedChoice()).IsTrue()
+	assert.For(t).ThatActual(sanitizedPlayers[2].CommittedChoice()).Equals(0)
+
+	sanitized, err = game.CurrentState().SanitizedForPlayer(2)
+	assert.For(t).ThatActual(err).IsNil()
+	_, sanitizedPlayers = concreteStates(sanitized)
+	assert.For(t).ThatActual(sanitizedPlayers[2].CommittedChoice()).Equals(7)
+
+	//The choice is hidden in the move record, too.
+	record, err := game.Manager().Storage().Move(game.ID(), game.Version())
+	assert.For(t).ThatActual(err).IsNil()
+
+	for player, expected := range map[boardgame.PlayerIndex]int{0: 0, 2: 7, boardgame.ObserverPlayerIndex: 0, boardgame.AdminPlayerIndex: 7} {
+		sanitizedRecord, err := game.MoveRecordForPlayer(player, record)
+		assert.For(t, player).ThatActual(err).IsNil()
+		var blob struct {
+			Choice int
+		}
+		assert.For(t, player).ThatActual(json.Unmarshal(sanitizedRecord.Blob, &blob)).IsNil()
+		assert.For(t, player).ThatActual(blob.Choice).Equals(expected)
+	}
+
+	assert.For(t).ThatActual(commit(0, 0, 5)).IsNil()
+	assert.For(t).ThatActual(commit(3, 3, 6)).IsNil()
+
+	_, players := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(players[0].HasCommittedChoice()).IsTrue()
+
+	assert.For(t).ThatActual(commit(1, 1, 4)).IsNil()
+
+	//RevealActions should have applied, revealing everything at once.
+	_, players = concreteStates(game.CurrentState())
+
+	for i, choice := range []int{5, 4, 7, 6} {
+		assert.For(t, i).ThatActual(players[i].HasCommittedChoice()).IsFalse()
+		assert.For(t, i).ThatActual(players[i].RevealedChoice()).Equals(choice)
+	}
+
+	//The next round can begin.
+	assert.For(t).ThatActual(commit(2, 2, 1)).IsNil()
+}
@@ -68,6 +68,31 @@ func ensureAllMovesSatisfyFallBack() {
 	m = new(CloseEmptySeat)
 	m = new(InactivateEmptySeat)
 	m = new(WaitForEnoughPlayers)
+	m = new(PlayCardToTrick)
+	m = new(ResolveTrick)
+	m = new(PlaceBid)
+	m = new(PassBid)
+	m = new(ResolveAuction)
+	m = new(CommitAction)
+	m = new(RevealActions)
+	m = new(DraftPick)
+	m = new(RotateHands)
+	m = new(OfferTrade)
+	m = new(AcceptTrade)
+	m = new(DeclineTrade)
+	m = new(CancelTrade)
+	m = new(OpenReactionWindow)
+	m = new(React)
+	m = new(PassReaction)
+	m = new(ResolveReactions)
+	m = new(RollDice)
+	m = new(RerollSelected)
+	m = new(LockDie)
+	m = new(PlaceWorker)
+	m = new(ReturnWorkers)
+	m = new(GainResources)
+	m = new(PayCost)
+	m = new(ExchangeResources)
 	if m != nil {
 		return
 	}
@@ -515,11 +540,13 @@ func (d *Default) legalMoveInProgression(state boardgame.ImmutableState, propose
 		Name: d.Name(),
 	})
 
-	return matchTape(group, movesToNames(historicalMoves))
+	return matchTape(group, movesToNames(historicalMoves), state)
 
 }
 
-func makeTape(moveNames []string) *MoveGroupHistoryItem {
+//makeTape returns a tape of moveNames. If state is non-nil, the last item is
+//marked as the move being proposed in that state.
+func makeTape(moveNames []string, state boardgame.ImmutableState) *MoveGroupHistoryItem {
 	var tapeStart *MoveGroupHistoryItem
 	var tapeEnd *MoveGroupHistoryItem
 
@@ -538,6 +565,11 @@ func makeTape(moveNames []string) *MoveGroupHistoryItem {
 		tapeEnd = newItem
 	}
 
+	if tapeEnd != nil && state != nil {
+		tapeEnd.proposed = true
+		tapeEnd.state = state
+	}
+
 	return tapeStart
 }
 
@@ -551,10 +583,19 @@ func movesToNames(moves []*boardgame.MoveStorageRecord) []string {
 	return result
 }
 
-func matchTape(group MoveProgressionGroup, historicalMoves []string) error {
+//matchTape returns nil if there's any way for group to consume all of
+//historicalMoves, the last of which is being proposed in state. It considers
+//every way each group could consume the tape, so an earlier group consuming
+//more than it should (for example an Optional) doesn't prevent a match.
+func matchTape(group MoveProgressionGroup, historicalMoves []string, state boardgame.ImmutableState) error {
+
+	tapeStart := makeTape(historicalMoves, state)
 
-	tapeStart := makeTape(historicalMoves)
+	if hasRest(groupRests(group, tapeStart), nil) {
+		return nil
+	}
 
+	//Use Satisfied to find a descriptive error.
 	rest, err := group.Satisfied(tapeStart)
 
 	defaultErr := errors.NewFriendly("The move was not legal at this phase in the progression")
@@ -567,7 +608,7 @@ func matchTape(group MoveProgressionGroup, historicalMoves []string) error {
 		return defaultErr.WithError("The progression only matched some of the proposed move history")
 	}
 
-	return nil
+	return defaultErr
 }
 
 //stackName returns the name of the stack for helpTExt, name, etc based on the
@@ -626,3 +667,18 @@ func findStackNameInReader(reader boardgame.PropertyReader, exampleStack boardga
 	}
 	return ""
 }
+
+//stackerState returns state as a boardgame.State, for Legal and
+//DefaultsForState methods that need to call the interfaces package's
+//stack getters (SourceStacker, PlayerStacker, GameStacker and friends). Those
+//take a State because Apply uses them to move components, but Legal and
+//DefaultsForState are only handed an ImmutableState. The engine always
+//passes them its own state object, which is also a State, so the cast
+//succeeds in practice; callers must only read from the result.
+func stackerState(state boardgame.ImmutableState) (boardgame.State, error) {
+	mState, ok := state.(boardgame.State)
+	if !ok {
+		return nil, errors.New("State wasn't convertible to MutableState")
+	}
+	return mState, nil
+}
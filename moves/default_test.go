@@ -809,7 +809,7 @@ func TestMoveProgression(t *testing.T) {
 			true,
 		},
 		{
-			"Two serial groups in a row with two AllowMulti abutting. Doesn't match because the first group consumes both 1's, leaving none for the next to consume.",
+			"Two serial groups in a row with two AllowMulti abutting. Matches even though the first group's Satisfied greedily consumes both 1's, because matchTape considers leaving one for the next group.",
 			[]string{
 				multiMoveNames[0],
 				multiMoveNames[1],
@@ -826,7 +826,7 @@ func TestMoveProgression(t *testing.T) {
 					multiMoveConfigs[0],
 				),
 			},
-			false,
+			true,
 		},
 		{
 			"Two serial groups in a row with two AllowMulti abutting, but a NoOp as a guard against the first group matching too greedily.",
@@ -921,6 +921,225 @@ func TestMoveProgression(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Optional whose Satisfied greedily matches a move the next group needs",
+			[]string{
+				singleMoveNames[0],
+				singleMoveNames[1],
+			},
+			[]MoveProgressionGroup{
+				Optional(
+					singleMoveConfigs[0],
+				),
+				singleMoveConfigs[0],
+				singleMoveConfigs[1],
+			},
+			true,
+		},
+		{
+			"Choice first branch",
+			[]string{
+				singleMoveNames[0],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					singleMoveConfigs[0],
+					singleMoveConfigs[1],
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Choice second branch",
+			[]string{
+				singleMoveNames[1],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					singleMoveConfigs[0],
+					singleMoveConfigs[1],
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Choice both branches",
+			[]string{
+				singleMoveNames[0],
+				singleMoveNames[1],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					singleMoveConfigs[0],
+					singleMoveConfigs[1],
+				),
+				singleMoveConfigs[2],
+			},
+			false,
+		},
+		{
+			"Choice no branches",
+			[]string{
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					singleMoveConfigs[0],
+					singleMoveConfigs[1],
+				),
+				singleMoveConfigs[2],
+			},
+			false,
+		},
+		{
+			"Choice with branches sharing a prefix, partial",
+			[]string{
+				singleMoveNames[0],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[2],
+					),
+				),
+			},
+			true,
+		},
+		{
+			"Choice with branches sharing a prefix, shorter branch",
+			[]string{
+				singleMoveNames[0],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					singleMoveConfigs[0],
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Choice with branches sharing a prefix, longer branch",
+			[]string{
+				singleMoveNames[0],
+				singleMoveNames[1],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Choice(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					singleMoveConfigs[0],
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Interleave mixed",
+			[]string{
+				singleMoveNames[0],
+				multiMoveNames[0],
+				singleMoveNames[1],
+				multiMoveNames[1],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Interleave(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					Serial(
+						multiMoveConfigs[0],
+						multiMoveConfigs[1],
+					),
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Interleave partial",
+			[]string{
+				multiMoveNames[0],
+				singleMoveNames[0],
+			},
+			[]MoveProgressionGroup{
+				Interleave(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					Serial(
+						multiMoveConfigs[0],
+						multiMoveConfigs[1],
+					),
+				),
+				singleMoveConfigs[2],
+			},
+			true,
+		},
+		{
+			"Interleave out of a child's order",
+			[]string{
+				singleMoveNames[1],
+				singleMoveNames[0],
+			},
+			[]MoveProgressionGroup{
+				Interleave(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					Serial(
+						multiMoveConfigs[0],
+						multiMoveConfigs[1],
+					),
+				),
+			},
+			false,
+		},
+		{
+			"Interleave ended before a child is complete",
+			[]string{
+				singleMoveNames[0],
+				multiMoveNames[0],
+				multiMoveNames[1],
+				singleMoveNames[2],
+			},
+			[]MoveProgressionGroup{
+				Interleave(
+					Serial(
+						singleMoveConfigs[0],
+						singleMoveConfigs[1],
+					),
+					Serial(
+						multiMoveConfigs[0],
+						multiMoveConfigs[1],
+					),
+				),
+				singleMoveConfigs[2],
+			},
+			false,
+		},
 	}
 
 	//Note that the old test, progressionMatches() didn't check which types
@@ -935,7 +1154,7 @@ func TestMoveProgression(t *testing.T) {
 
 		group := Serial(test.pattern...)
 
-		err := matchTape(group, test.tape)
+		err := matchTape(group, test.tape, nil)
 
 		if !assert.For(t, i, test.description).ThatActual(err == nil).Equals(test.expectedResult).Passed() {
 			if err != nil {
@@ -946,3 +1165,101 @@ func TestMoveProgression(t *testing.T) {
 	}
 
 }
+
+func TestRepeatUntil(t *testing.T) {
+
+	manager, err := newGameManager(defaultMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	state := game.CurrentState()
+
+	conditionMet := false
+
+	configs := []GroupableMoveConfig{
+		newMoveConfig("Draw", new(moveNoOpFixUp), nil),
+		newMoveConfig("Play", new(moveNoOpFixUp), nil),
+		newMoveConfig("Done", new(moveNoOpFixUp), nil),
+	}
+
+	group := Serial(
+		RepeatUntil(
+			func(state boardgame.ImmutableState) bool {
+				return conditionMet
+			},
+			Serial(configs[0], configs[1]),
+		),
+		configs[2],
+	)
+
+	tests := []struct {
+		description    string
+		conditionMet   bool
+		tape           []string
+		expectedResult bool
+	}{
+		{
+			"Start while condition not met",
+			false,
+			[]string{"Draw"},
+			true,
+		},
+		{
+			"Skip while condition not met",
+			false,
+			[]string{"Done"},
+			false,
+		},
+		{
+			"Skip when condition met from the start",
+			true,
+			[]string{"Done"},
+			true,
+		},
+		{
+			"Start again while condition not met",
+			false,
+			[]string{"Draw", "Play", "Draw"},
+			true,
+		},
+		{
+			"Start again once condition met",
+			true,
+			[]string{"Draw", "Play", "Draw"},
+			false,
+		},
+		{
+			"Finish a time around once condition met",
+			true,
+			[]string{"Draw", "Play", "Draw", "Play"},
+			true,
+		},
+		{
+			"Move on once condition met",
+			true,
+			[]string{"Draw", "Play", "Done"},
+			true,
+		},
+		{
+			"Move on while condition not met",
+			false,
+			[]string{"Draw", "Play", "Done"},
+			false,
+		},
+	}
+
+	for i, test := range tests {
+		conditionMet = test.conditionMet
+		err := matchTape(group, test.tape, state)
+		if !assert.For(t, i, test.description).ThatActual(err == nil).Equals(test.expectedResult).Passed() {
+			if err != nil {
+				t.Log(err.Error())
+			}
+		}
+	}
+
+}
@@ -0,0 +1,479 @@
+package moves
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//rerollLimiter is implemented by RerollSelected.
+type rerollLimiter interface {
+	RerollLimit(state boardgame.ImmutableState) int
+}
+
+//diceMover is implemented by the dice moves.
+type diceMover interface {
+	moveInfoer
+	TopLevelStruct() boardgame.Move
+}
+
+//dicePool returns the dice in m's GameStack, indexed by slot. Empty slots are
+//nil.
+func dicePool(m diceMover, state boardgame.State) ([]interfaces.Die, error) {
+	gameStacker, ok := m.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return nil, errors.New("The top level struct unexpectedly didn't implement GameStacker")
+	}
+
+	stack := gameStacker.GameStack(state.GameState())
+
+	if stack == nil {
+		return nil, errors.New("GameStack returned a nil stack")
+	}
+
+	result := make([]interfaces.Die, stack.Len())
+
+	for i := range result {
+		c := stack.ComponentAt(i)
+		if c == nil {
+			continue
+		}
+		die, ok := c.DynamicValues().(interfaces.Die)
+		if !ok {
+			return nil, errors.New("The component at index " + strconv.Itoa(i) + " in GameStack isn't a die")
+		}
+		result[i] = die
+	}
+
+	return result, nil
+}
+
+//dieAt returns the die at slot index in dice, as returned by dicePool, or an
+//error if index is out of range or the slot is empty.
+func dieAt(dice []interfaces.Die, index int) (interfaces.Die, error) {
+	if index < 0 || index >= len(dice) {
+		return nil, errors.New("Die index " + strconv.Itoa(index) + " didn't specify a die")
+	}
+	if dice[index] == nil {
+		return nil, errors.New("Die index " + strconv.Itoa(index) + " is an empty slot")
+	}
+	return dice[index], nil
+}
+
+//dieLocked returns whether the die at slot index is locked in pool.
+func dieLocked(pool interfaces.DicePoolProperties, index int) bool {
+	locked := pool.DiceLocked()
+	return index < len(locked) && locked[index]
+}
+
+//diceValidConfiguration checks that GameState implements
+//interfaces.DicePoolProperties and that GameStack returns a non-nil stack.
+func diceValidConfiguration(m diceMover, exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.DicePoolProperties); !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	gameStacker, ok := m.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("Embedding move doesn't implement GameStacker")
+	}
+
+	if gameStacker.GameStack(exampleState.GameState()) == nil {
+		return errors.New("GameStack returned a nil stack")
+	}
+
+	return nil
+}
+
+//diceRolledLegal returns an error if the dice pool hasn't been rolled.
+func diceRolledLegal(state boardgame.ImmutableState) error {
+	pool, ok := state.ImmutableGameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	if !pool.DiceRolled() {
+		return errors.New("The dice haven't been rolled yet")
+	}
+
+	return nil
+}
+
+/*
+
+RollDice rolls every die in a pool of dice (GameStack, configured with
+WithGameProperty), like the first roll of a turn in Yahtzee or King of Tokyo.
+Each die's dynamic values must implement interfaces.Die, which
+components/dice's DynamicValue does. It unlocks every die and starts the
+count of rerolls for RerollSelected over.
+
+Your GameState must implement interfaces.DicePoolProperties, typically by
+embedding behaviors.DicePool. RollDice is illegal while DiceRolled is true, so
+the pool may only be rolled again once your game calls SetDiceRolled(false),
+typically at the end of the turn. Use a move progression to say when in the
+turn it happens.
+
+boardgame:codegen
+*/
+type RollDice struct {
+	CurrentPlayer
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the stack of dice that is rolled.
+//If that is not sufficient, override this in your embedding struct.
+func (r *RollDice) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(r, gameState)
+}
+
+//Legal checks that the dice haven't already been rolled.
+func (r *RollDice) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	pool, ok := state.ImmutableGameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	if pool.DiceRolled() {
+		return errors.New("The dice have already been rolled")
+	}
+
+	return nil
+}
+
+//Apply unlocks and rolls every die in the pool, and records that it was
+//rolled.
+func (r *RollDice) Apply(state boardgame.State) error {
+	dice, err := dicePool(r, state)
+
+	if err != nil {
+		return err
+	}
+
+	for _, die := range dice {
+		if die == nil {
+			continue
+		}
+		die.Roll(state.Rand())
+	}
+
+	pool, ok := state.GameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	pool.SetDiceRolled(true)
+	pool.SetDiceRerolls(0)
+	pool.SetDiceLocked(nil)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.DicePoolProperties and that GameStack returns a non-nil stack.
+func (r *RollDice) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return diceValidConfiguration(r, exampleState)
+}
+
+//FallbackName returns "Roll Dice"
+func (r *RollDice) FallbackName(m *boardgame.GameManager) string {
+	return "Roll Dice"
+}
+
+//FallbackHelpText returns "Rolls all of the dice."
+func (r *RollDice) FallbackHelpText() string {
+	return "Rolls all of the dice."
+}
+
+/*
+
+RerollSelected rerolls the dice at ComponentIndexes in the pool (GameStack,
+configured with WithGameProperty), after RollDice. ComponentIndexes are slots
+in the stack. Locked dice and empty slots may not be rerolled. It may be made
+at most RerollLimit times after each RollDice; DefaultsForState selects every
+die that isn't locked.
+
+Your GameState must implement interfaces.DicePoolProperties, typically by
+embedding behaviors.DicePool.
+
+boardgame:codegen
+*/
+type RerollSelected struct {
+	CurrentPlayer
+	ComponentIndexes []int
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the stack of dice that is rerolled.
+//If that is not sufficient, override this in your embedding struct.
+func (r *RerollSelected) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(r, gameState)
+}
+
+//RerollLimit returns the value passed to auto.Config with WithRerollLimit,
+//or 2 (as in Yahtzee) if none was passed. Override it if the limit depends
+//on the state.
+func (r *RerollSelected) RerollLimit(state boardgame.ImmutableState) int {
+	return intFromConfig(r, configPropRerollLimit, 2)
+}
+
+//DefaultsForState sets ComponentIndexes to every die that isn't locked.
+func (r *RerollSelected) DefaultsForState(state boardgame.ImmutableState) {
+	r.CurrentPlayer.DefaultsForState(state)
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return
+	}
+
+	dice, err := dicePool(r, mState)
+
+	if err != nil {
+		return
+	}
+
+	pool, ok := state.ImmutableGameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return
+	}
+
+	r.ComponentIndexes = nil
+
+	for i, die := range dice {
+		if die != nil && !dieLocked(pool, i) {
+			r.ComponentIndexes = append(r.ComponentIndexes, i)
+		}
+	}
+}
+
+//selected returns the dice at ComponentIndexes, or an error if any index is
+//repeated, doesn't specify a die, or specifies a locked one.
+func (r *RerollSelected) selected(state boardgame.State) ([]interfaces.Die, error) {
+	if len(r.ComponentIndexes) == 0 {
+		return nil, errors.New("No dice were selected to reroll")
+	}
+
+	dice, err := dicePool(r, state)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pool, ok := state.GameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return nil, errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	seen := make(map[int]bool, len(r.ComponentIndexes))
+	result := make([]interfaces.Die, len(r.ComponentIndexes))
+
+	for i, index := range r.ComponentIndexes {
+		if seen[index] {
+			return nil, errors.New("Die index " + strconv.Itoa(index) + " was selected more than once")
+		}
+		seen[index] = true
+		die, err := dieAt(dice, index)
+		if err != nil {
+			return nil, err
+		}
+		if dieLocked(pool, index) {
+			return nil, errors.New("Die index " + strconv.Itoa(index) + " is locked")
+		}
+		result[i] = die
+	}
+
+	return result, nil
+}
+
+//Legal checks that the dice have been rolled, that there are rerolls left,
+//and that ComponentIndexes are dice that aren't locked.
+func (r *RerollSelected) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	if err := diceRolledLegal(state); err != nil {
+		return err
+	}
+
+	limiter, ok := r.TopLevelStruct().(rerollLimiter)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have RerollLimit")
+	}
+
+	pool := state.ImmutableGameState().(interfaces.DicePoolProperties)
+
+	if pool.DiceRerolls() >= limiter.RerollLimit(state) {
+		return errors.New("There are no rerolls left")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.selected(mState)
+
+	return err
+}
+
+//Apply rolls the selected dice and counts the reroll.
+func (r *RerollSelected) Apply(state boardgame.State) error {
+	dice, err := r.selected(state)
+
+	if err != nil {
+		return err
+	}
+
+	for _, die := range dice {
+		die.Roll(state.Rand())
+	}
+
+	pool, ok := state.GameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	pool.SetDiceRerolls(pool.DiceRerolls() + 1)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.DicePoolProperties and that GameStack returns a non-nil stack.
+func (r *RerollSelected) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return diceValidConfiguration(r, exampleState)
+}
+
+//FallbackName returns "Reroll Selected"
+func (r *RerollSelected) FallbackName(m *boardgame.GameManager) string {
+	return "Reroll Selected"
+}
+
+//FallbackHelpText returns "Rerolls the selected dice."
+func (r *RerollSelected) FallbackHelpText() string {
+	return "Rerolls the selected dice."
+}
+
+/*
+
+LockDie locks the die at ComponentIndex in the pool (GameStack, configured
+with WithGameProperty) so RerollSelected won't reroll it, or unlocks it if
+it's already locked. ComponentIndex is a slot in the stack, and may not be an
+empty one. It's legal once the dice have been rolled. RollDice unlocks every
+die. Which dice are locked is stored in DicePoolProperties, not on the dice.
+
+Your GameState must implement interfaces.DicePoolProperties, typically by
+embedding behaviors.DicePool.
+
+boardgame:codegen
+*/
+type LockDie struct {
+	CurrentPlayer
+	ComponentIndex int
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the stack of dice the die is in. If
+//that is not sufficient, override this in your embedding struct.
+func (l *LockDie) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(l, gameState)
+}
+
+//die returns the die at ComponentIndex.
+func (l *LockDie) die(state boardgame.State) (interfaces.Die, error) {
+	dice, err := dicePool(l, state)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dieAt(dice, l.ComponentIndex)
+}
+
+//Legal checks that the dice have been rolled and that ComponentIndex is a
+//slot with a die in it.
+func (l *LockDie) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := l.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	if err := diceRolledLegal(state); err != nil {
+		return err
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.die(mState)
+
+	return err
+}
+
+//Apply flips whether the die is locked.
+func (l *LockDie) Apply(state boardgame.State) error {
+	dice, err := dicePool(l, state)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := dieAt(dice, l.ComponentIndex); err != nil {
+		return err
+	}
+
+	pool, ok := state.GameState().(interfaces.DicePoolProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DicePoolProperties")
+	}
+
+	locked := make([]bool, len(dice))
+	copy(locked, pool.DiceLocked())
+	locked[l.ComponentIndex] = !locked[l.ComponentIndex]
+
+	pool.SetDiceLocked(locked)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.DicePoolProperties and that GameStack returns a non-nil stack.
+func (l *LockDie) ValidConfiguration(exampleState boardgame.State) error {
+	if err := l.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return diceValidConfiguration(l, exampleState)
+}
+
+//FallbackName returns "Lock Die"
+func (l *LockDie) FallbackName(m *boardgame.GameManager) string {
+	return "Lock Die"
+}
+
+//FallbackHelpText returns "Locks a die so it isn't rerolled, or unlocks it."
+func (l *LockDie) FallbackHelpText() string {
+	return "Locks a die so it isn't rerolled, or unlocks it."
+}
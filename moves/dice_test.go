@@ -0,0 +1,164 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/components/dice"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+	"github.com/workfit/tester/assert"
+)
+
+//boardgame:codegen
+type moveEndDiceTurn struct {
+	CurrentPlayer
+}
+
+//Apply clears DiceRolled, as a game would at the end of the turn.
+func (m *moveEndDiceTurn) Apply(state boardgame.State) error {
+	state.GameState().(*gameState).SetDiceRolled(false)
+	return nil
+}
+
+func diceMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(RollDice),
+				WithGameProperty("Dice"),
+			),
+			auto.MustConfig(
+				new(RerollSelected),
+				WithGameProperty("Dice"),
+				WithRerollLimit(1),
+			),
+			auto.MustConfig(
+				new(LockDie),
+				WithGameProperty("Dice"),
+			),
+			auto.MustConfig(
+				new(moveEndDiceTurn),
+				WithMoveName("End Dice Turn"),
+			),
+		),
+	)
+}
+
+func TestDicePool(t *testing.T) {
+	manager, err := newGameManager(diceMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	gameState, _ := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.Dice.NumComponents()).Equals(5)
+
+	die := func(index int) *dice.DynamicValue {
+		gameState, _ := concreteStates(game.CurrentState())
+		return gameState.Dice.ImmutableComponentAt(index).ImmutableDynamicValues().(*dice.DynamicValue)
+	}
+
+	lock := func(index int) error {
+		move := game.MoveByName("Lock Die").(*LockDie)
+		move.ComponentIndex = index
+		return <-game.ProposeMove(move, 0)
+	}
+
+	reroll := func(indexes []int) error {
+		move := game.MoveByName("Reroll Selected").(*RerollSelected)
+		move.DefaultsForState(game.CurrentState())
+		if indexes != nil {
+			move.ComponentIndexes = indexes
+		}
+		return <-game.ProposeMove(move, 0)
+	}
+
+	//Nothing may be locked or rerolled before the first roll.
+	assert.For(t).ThatActual(lock(0)).IsNotNil()
+	assert.For(t).ThatActual(reroll(nil)).IsNotNil()
+
+	assert.For(t).ThatActual(<-game.ProposeMove(game.MoveByName("Roll Dice"), 1)).IsNotNil()
+	assert.For(t).ThatActual(<-game.ProposeMove(game.MoveByName("Roll Dice"), 0)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.DiceRolled()).IsTrue()
+	assert.For(t).ThatActual(len(dice.Values(gameState.Dice))).Equals(5)
+
+	for i := 0; i < 5; i++ {
+		value := die(i).Value
+		assert.For(t, i).ThatActual(value >= 1 && value <= 6).IsTrue()
+	}
+
+	assert.For(t).ThatActual(lock(5)).IsNotNil()
+	assert.For(t).ThatActual(lock(1)).IsNil()
+	assert.For(t).ThatActual(lock(3)).IsNil()
+	assert.For(t).ThatActual(lock(3)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.DiceLocked()).Equals([]bool{false, true, false, false, false})
+
+	move := game.MoveByName("Reroll Selected").(*RerollSelected)
+	move.DefaultsForState(game.CurrentState())
+	assert.For(t).ThatActual(move.ComponentIndexes).Equals([]int{0, 2, 3, 4})
+
+	//Locked dice, repeated dice and no dice at all can't be rerolled.
+	assert.For(t).ThatActual(reroll([]int{1})).IsNotNil()
+	assert.For(t).ThatActual(reroll([]int{0, 0})).IsNotNil()
+	assert.For(t).ThatActual(reroll([]int{})).IsNotNil()
+
+	locked := die(1).Value
+
+	assert.For(t).ThatActual(reroll(nil)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.DiceRerolls()).Equals(1)
+	assert.For(t).ThatActual(die(1).Value).Equals(locked)
+
+	//The reroll limit is 1.
+	assert.For(t).ThatActual(reroll([]int{0})).IsNotNil()
+
+	//The dice may not be rolled again in the same turn, which would start
+	//the rerolls over.
+	assert.For(t).ThatActual(<-game.ProposeMove(game.MoveByName("Roll Dice"), 0)).IsNotNil()
+
+	//Once the turn ends, rolling again starts over.
+	assert.For(t).ThatActual(<-game.ProposeMove(game.MoveByName("End Dice Turn"), 0)).IsNil()
+	assert.For(t).ThatActual(reroll([]int{0})).IsNotNil()
+	assert.For(t).ThatActual(<-game.ProposeMove(game.MoveByName("Roll Dice"), 0)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.DiceRerolls()).Equals(0)
+	assert.For(t).ThatActual(len(gameState.DiceLocked())).Equals(0)
+	assert.For(t).ThatActual(reroll([]int{0})).IsNil()
+}
+
+func TestDieAt(t *testing.T) {
+	die := &dice.DynamicValue{}
+
+	pool := []interfaces.Die{die, nil}
+
+	result, err := dieAt(pool, 0)
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(result).Equals(die)
+
+	_, err = dieAt(pool, 1)
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	_, err = dieAt(pool, 2)
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	_, err = dieAt(pool, -1)
+	assert.For(t).ThatActual(err).IsNotNil()
+}
@@ -198,13 +198,40 @@ each has on the one above it. See the documentation for each struct for more.
         * Default - Substantial base logic, including base property overriding for with and especially in Legal() around move progressions and phases.
             * Done - A simple move that does nothing in its Apply and has no extra Legal() logic, meaning it's primarily a non-fix-up move applied by a player to move out of a move progression.
             * CurrentPlayer - Defaults to the GameDelegate.CurrentPlayerIndex, and only lets the move be made if it's on behalf of that player.
+                * PlaceBid - Bids Bid in the current auction, starting one if none is in progress. Supports ascending, once-around and sealed-bid auctions on a GameState that embeds behaviors.Auction.
+                * PassBid - Passes in the current auction, so the player may not bid again in it.
+                * OpenReactionWindow - Puts Action on the bottom of the reaction stack and lets every other player React or PassReaction, in turn order, before it resolves. Requires a GameState that embeds behaviors.ReactionWindow.
+                * OfferTrade - Offers Recipient the components at OfferedComponents and OfferedResources in return for RequestedComponents and RequestedResources, on a GameState that embeds behaviors.PendingTrade.
+                * RollDice - Unlocks and rolls every die in the pool in GameStack, on a GameState that embeds behaviors.DicePool.
+                * RerollSelected - Rerolls the unlocked dice at ComponentIndexes, at most RerollLimit times after each RollDice.
+                * LockDie - Locks or unlocks the die at ComponentIndex, so RerollSelected keeps it as is.
+                * PlaceWorker - Moves a worker from the current player's PlayerStack to the action space at Space on GameBoard, if it has room, and calls the GameDelegate's ActionSpaceEffect. Requires a GameState that embeds behaviors.ActionSpaces and a GameDelegate that implements interfaces.ActionSpaceDefiner.
+                * GainResources - Gives the current player ResourceGain, taking it from the limited resources of the bank, on a PlayerState that embeds behaviors.Resources.
+                * PayCost - Has the current player pay ResourceCost back to the bank. Embed it in moves that cost resources to make.
+                * ExchangeResources - Has the current player pay ResourceCost to the bank in return for ResourceGain.
+                * PlayCardToTrick - Plays the card at ComponentIndex from the current player's PlayerStack to the trick in GameStack, enforcing FollowSuitLegal and recording the led suit on a GameState that embeds behaviors.Trick.
             * SeatPlayer - A special move that the server package uses to tell the game logic that a new player has been added to the game.
+            * UnseatPlayer - The inverse of SeatPlayer; the server package uses it to tell the game logic that a seated player has left the game.
+            * InactivatePlayer - Marks TargetPlayerIndex as inactive. Only admins may propose it; the server package uses it for players who have taken too long to move.
+            * CommitAction - Secretly records Choice for TargetPlayerIndex on a PlayerState that embeds behaviors.SecretChoice. Any player may commit, in any order, once per round.
+            * DraftPick - Moves the card at ComponentIndex from TargetPlayerIndex's PlayerStack to their PickedStack. Every player picks at the same time, once each before RotateHands.
+            * AcceptTrade - Lets the recipient of a pending trade accept it out of turn, exchanging everything at once.
+            * DeclineTrade - Lets the recipient of a pending trade turn it down out of turn.
+            * CancelTrade - Lets the player who offered a pending trade withdraw it.
+            * React - Lets the player whose turn it is to respond in an open reaction window put Response on top of the reaction stack, out of turn.
+            * PassReaction - Lets the player whose turn it is to respond in an open reaction window decline to.
             * FixUp - Overrides IsFixUp() to always return true, making the move eligible for base.GameDelegate.ProposeFixUpMove.
                 * NoOp - A move that does nothing. Useful for specific edge cases of MoveProessionMatching, and also to signal to AddOrderedForPhase that the lack of a StartPhase move was intentional.
                 * Increment - Increments the provided SourceProperty by Amount. Useful to run automatically at a given spot in a move progression.
                 * ShuffleStack - Shuffles the stack at SourceProperty. Useful to run automatically at a certain time in a MoveProgression.
                 * StartPhase - Calls BeforeLeavePhase, then BeforeEnterPhase, then SetCurrentPhase. Generally you have one of these at the end of an AddOrderedForPhase.
                 * FinishTurn - Checks if State.CurrentPlayer().TurnDone() is true, and if so increments CurrentPlayerIndex to the next player, calling playerState.ResetForTurnEnd() and then ResetForTurnStart.
+                * ResolveAuction - Once the current auction is over, records the winner, calls AuctionResolved so your game can act on it, and makes AuctionNextPlayer the current player.
+                * ResolveReactions - Once every player but the last to act has passed in a reaction window, resolves the stack most recent first via ResolveReaction.
+                * RevealActions - Once every active player has committed with CommitAction, reveals all of their choices at once.
+                * RotateHands - Once every active player has picked with DraftPick, passes every hand to the next player in PassDirection, through a hidden scratch stack so cards can't be followed.
+                * ReturnWorkers - Once no active player has workers left to place, returns every worker on GameBoard to the PlayerStack of the player who placed it.
+                * ResolveTrick - Once the trick in GameStack is full, moves it to the PlayerStack of whoever played the highest trump or highest card of the led suit, and makes them the current player so they lead next.
                 * WaitForEnoughPlayers - Is illegal until enough players are seated; used to hold up a phase progression to wait for enough players to join
                 * FixUpMulti - Overrides AllowMultipleInProgression() to true, meaning multiple of the same move are legal to apply in a row according to Deafult.Legal()
                     * DefaultComponent - Looks at each component in SourceStack() and sees which one's method of Legal() returns nil, selecting that component for you to operate on in your own Apply.
@@ -280,9 +307,16 @@ Groups
 
 Groups allow you to specify a specific set of moves that must occur in a given
 order. You pass them to AddOrderedForPhase. All of the groups are of type
-MoveProgressionGroup, and this package defines 5: Serial, Parallel,
-ParallelCount, Repeat, and Optional. They can be nested as often as you'd like
-to express the semantics of your move progression.
+MoveProgressionGroup, and this package defines 8: Serial, Parallel,
+ParallelCount, Repeat, Optional, Choice, RepeatUntil, and Interleave. They can
+be nested as often as you'd like to express the semantics of your move
+progression. Choice matches exactly one of its children; RepeatUntil repeats
+its child until a condition on the state is met; Interleave matches all of its
+children with their moves mixed together.
+
+When deciding if a move is legal, moves.Default considers every way the
+groups could match the moves so far, so it doesn't matter if an earlier group
+could also have matched a move that a later group needs.
 
 They are defined as functions that return anonymous underlying structs so that
 when used in configuration you can avoid needing to wrap your children list with
@@ -318,6 +352,10 @@ when used in configuration you can avoid needing to wrap your children list with
         ),
     )
 
+ReactionWindow is a convenience that combines the basic groups to express a
+window where other players may react to an action before it resolves; see
+OpenReactionWindow.
+
 Move names must be unique, but sometimes you want to use the same underlying
 move at multiple points in a progression. WithMoveNameSuffix is useful for that
 case.
@@ -0,0 +1,472 @@
+package moves
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//PassDirection is which way RotateHands passes hands. Configure it with
+//WithPassDirection.
+type PassDirection int
+
+const (
+	//PassLeft passes each hand to the next player (the one with the next
+	//higher PlayerIndex, wrapping around).
+	PassLeft PassDirection = iota
+	//PassRight passes each hand to the previous player.
+	PassRight
+	//PassAlternate passes left in even DraftRounds (starting with the first)
+	//and right in odd ones.
+	PassAlternate
+)
+
+//String returns the name of the PassDirection.
+func (p PassDirection) String() string {
+	switch p {
+	case PassLeft:
+		return "Left"
+	case PassRight:
+		return "Right"
+	case PassAlternate:
+		return "Alternate"
+	}
+	return "Unknown Pass Direction " + strconv.Itoa(int(p))
+}
+
+//pickedStacker is implemented by DraftPick.
+type pickedStacker interface {
+	PickedStack(playerState boardgame.SubState) boardgame.Stack
+}
+
+//passDirectioner is implemented by RotateHands.
+type passDirectioner interface {
+	PassDirection(state boardgame.ImmutableState) PassDirection
+}
+
+//draftHasPicked returns whether player has picked since hands were last
+//passed.
+func draftHasPicked(draft interfaces.DraftProperties, player boardgame.PlayerIndex) bool {
+	for _, picked := range draft.DraftPickedPlayers() {
+		if picked == player {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+
+DraftPick is a move for drafting games, where each player picks a card from
+their hand and then passes the rest on. TargetPlayerIndex moves the card at
+ComponentIndex from their hand (PlayerStack, configured with
+WithPlayerProperty) to their picked cards (PickedStack, configured with
+WithPickedProperty). It doesn't depend on the current player: every player
+picks at the same time, once each before RotateHands passes the hands on.
+
+Your GameState must implement interfaces.DraftProperties, typically by
+embedding behaviors.Draft.
+
+boardgame:codegen
+*/
+type DraftPick struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+	ComponentIndex    int
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the hand cards are picked
+//from. If that is not sufficient, override this in your embedding struct.
+func (d *DraftPick) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(d, playerState)
+}
+
+//PickedStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPickedProperty. If that is not sufficient,
+//override this in your embedding struct.
+func (d *DraftPick) PickedStack(playerState boardgame.SubState) boardgame.Stack {
+	config := d.CustomConfiguration()
+
+	stackName, ok := config[configPropPickedProperty]
+
+	if !ok {
+		return nil
+	}
+
+	strStackName, ok := stackName.(string)
+
+	if !ok {
+		return nil
+	}
+
+	stack, err := playerState.ReadSetter().StackProp(strStackName)
+
+	if err != nil {
+		return nil
+	}
+
+	return stack
+}
+
+//stacks returns player's hand and picked stacks.
+func (d *DraftPick) stacks(state boardgame.State, player boardgame.PlayerIndex) (hand, picked boardgame.Stack, err error) {
+	if player < 0 || int(player) >= len(state.PlayerStates()) {
+		return nil, nil, errors.New("The specified target player is not valid")
+	}
+
+	playerStacker, ok := d.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't implement PlayerStacker")
+	}
+
+	picker, ok := d.TopLevelStruct().(pickedStacker)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't have PickedStack")
+	}
+
+	playerState := state.PlayerStates()[player]
+
+	hand = playerStacker.PlayerStack(playerState)
+	picked = picker.PickedStack(playerState)
+
+	if hand == nil || picked == nil {
+		return nil, nil, errors.New("PlayerStack or PickedStack returned a nil stack")
+	}
+
+	return hand, picked, nil
+}
+
+//Legal checks that TargetPlayerIndex is the proposer, that they haven't
+//already picked since hands were last passed, and that ComponentIndex is a
+//card in their hand.
+func (d *DraftPick) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := d.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	target := d.TargetPlayerIndex
+
+	if target < 0 || !target.Valid(state) {
+		return errors.New("The specified target player is not valid")
+	}
+
+	if !target.Equivalent(proposer) {
+		return errors.New("You may only pick for yourself")
+	}
+
+	draft, ok := state.ImmutableGameState().(interfaces.DraftProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	if draftHasPicked(draft, target) {
+		return errors.New("You've already picked; wait for the hands to be passed")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	hand, _, err := d.stacks(mState, target)
+
+	if err != nil {
+		return err
+	}
+
+	if hand.ComponentAt(d.ComponentIndex) == nil {
+		return errors.New("ComponentIndex didn't specify a card in hand")
+	}
+
+	return nil
+}
+
+//Apply moves the card to the player's picked stack and records that they
+//picked.
+func (d *DraftPick) Apply(state boardgame.State) error {
+	hand, picked, err := d.stacks(state, d.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	c := hand.ComponentAt(d.ComponentIndex)
+
+	if c == nil {
+		return errors.New("ComponentIndex didn't specify a card in hand")
+	}
+
+	if err := c.MoveToNextSlot(picked); err != nil {
+		return errors.New("Couldn't pick card: " + err.Error())
+	}
+
+	draft, ok := state.GameState().(interfaces.DraftProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	players := append([]boardgame.PlayerIndex{}, draft.DraftPickedPlayers()...)
+	draft.SetDraftPickedPlayers(append(players, d.TargetPlayerIndex))
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.DraftProperties and that PlayerStack and PickedStack return
+//non-nil stacks.
+func (d *DraftPick) ValidConfiguration(exampleState boardgame.State) error {
+	if err := d.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	if _, ok := exampleState.GameState().(interfaces.DraftProperties); !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	if _, _, err := d.stacks(exampleState, 0); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//FallbackName returns "Draft Pick"
+func (d *DraftPick) FallbackName(m *boardgame.GameManager) string {
+	return "Draft Pick"
+}
+
+//FallbackHelpText returns "Picks a card from your hand to keep before the rest
+//are passed on."
+func (d *DraftPick) FallbackHelpText() string {
+	return "Picks a card from your hand to keep before the rest are passed on."
+}
+
+/*
+
+RotateHands is a fix up move that applies once every player who may be active
+has picked with DraftPick. It passes every active player's hand (PlayerStack,
+configured with WithPlayerProperty) to the next active player in
+PassDirection, all at once.
+
+To do that it first moves every hand into a scratch stack on the GameState
+(GameStack, configured with WithGameProperty), which you should sanitize with
+`sanitize:"hidden"`, and then deals each hand out to its new owner with
+SecretMoveTo. That scrambles the Ids of the cards in each hand, so other
+players can't follow individual cards as they're passed.
+
+If every hand is empty once everyone has picked, there's nothing to pass: the
+round is over, so RotateHands increments DraftRound instead. Deal new hands
+for the next round however your game does.
+
+boardgame:codegen
+*/
+type RotateHands struct {
+	FixUp
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the hand that is passed.
+//If that is not sufficient, override this in your embedding struct.
+func (r *RotateHands) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(r, playerState)
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the scratch stack hands are passed
+//through. If that is not sufficient, override this in your embedding struct.
+func (r *RotateHands) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(r, gameState)
+}
+
+//PassDirection returns PassLeft or PassRight based on the value passed to
+//auto.Config with WithPassDirection (PassLeft if none was passed). For
+//PassAlternate it's PassLeft in even DraftRounds, and PassRight in odd ones.
+func (r *RotateHands) PassDirection(state boardgame.ImmutableState) PassDirection {
+	config := r.CustomConfiguration()
+
+	direction, ok := config[configPropPassDirection].(PassDirection)
+
+	if !ok {
+		return PassLeft
+	}
+
+	if direction != PassAlternate {
+		return direction
+	}
+
+	draft, ok := state.ImmutableGameState().(interfaces.DraftProperties)
+
+	if !ok || draft.DraftRound()%2 == 0 {
+		return PassLeft
+	}
+
+	return PassRight
+}
+
+//Legal returns nil once every player who may be active has picked.
+func (r *RotateHands) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	draft, ok := state.ImmutableGameState().(interfaces.DraftProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	if len(draft.DraftPickedPlayers()) == 0 {
+		return errors.New("No one has picked yet")
+	}
+
+	for i := range state.ImmutablePlayerStates() {
+		player := boardgame.PlayerIndex(i)
+		if !player.Valid(state) {
+			continue
+		}
+		if !draftHasPicked(draft, player) {
+			return errors.New("Player " + player.String() + " hasn't picked yet")
+		}
+	}
+
+	return nil
+}
+
+//Apply passes every active player's hand on in PassDirection, or increments
+//DraftRound if every hand is empty.
+func (r *RotateHands) Apply(state boardgame.State) error {
+	draft, ok := state.GameState().(interfaces.DraftProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	playerStacker, ok := r.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement PlayerStacker")
+	}
+
+	gameStacker, ok := r.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement GameStacker")
+	}
+
+	directioner, ok := r.TopLevelStruct().(passDirectioner)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have PassDirection")
+	}
+
+	transit := gameStacker.GameStack(state.GameState())
+
+	if transit == nil {
+		return errors.New("GameStack returned a nil stack")
+	}
+
+	draft.SetDraftPickedPlayers(nil)
+
+	var players []boardgame.PlayerIndex
+	var counts []int
+	total := 0
+
+	for i, playerState := range state.PlayerStates() {
+		player := boardgame.PlayerIndex(i)
+		if !player.Valid(state) {
+			continue
+		}
+		hand := playerStacker.PlayerStack(playerState)
+		if hand == nil {
+			return errors.New("PlayerStack returned a nil stack")
+		}
+		players = append(players, player)
+		counts = append(counts, hand.NumComponents())
+		total += hand.NumComponents()
+	}
+
+	if total == 0 {
+		draft.SetDraftRound(draft.DraftRound() + 1)
+		return nil
+	}
+
+	for _, player := range players {
+		if err := playerStacker.PlayerStack(state.PlayerStates()[player]).MoveAllTo(transit); err != nil {
+			return errors.New("Couldn't collect hand: " + err.Error())
+		}
+	}
+
+	direction := directioner.PassDirection(state)
+
+	for i, player := range players {
+		recipient := player.Next(state)
+		if direction == PassRight {
+			recipient = player.Previous(state)
+		}
+		hand := playerStacker.PlayerStack(state.PlayerStates()[recipient])
+		for j := 0; j < counts[i]; j++ {
+			slot := hand.Len()
+			if sized := hand.SizedStack(); sized != nil {
+				slot = sized.NextSlot()
+			}
+			if err := transit.First().SecretMoveTo(hand, slot); err != nil {
+				return errors.New("Couldn't pass hand: " + err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.DraftProperties and that PlayerStack and GameStack return non-nil
+//stacks.
+func (r *RotateHands) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	if _, ok := exampleState.GameState().(interfaces.DraftProperties); !ok {
+		return errors.New("GameState does not implement DraftProperties")
+	}
+
+	playerStacker, ok := r.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return errors.New("Embedding move doesn't implement PlayerStacker")
+	}
+
+	if playerStacker.PlayerStack(exampleState.PlayerStates()[0]) == nil {
+		return errors.New("PlayerStack returned a nil stack")
+	}
+
+	gameStacker, ok := r.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("Embedding move doesn't implement GameStacker")
+	}
+
+	if gameStacker.GameStack(exampleState.GameState()) == nil {
+		return errors.New("GameStack returned a nil stack")
+	}
+
+	return nil
+}
+
+//FallbackName returns "Rotate Hands"
+func (r *RotateHands) FallbackName(m *boardgame.GameManager) string {
+	return "Rotate Hands"
+}
+
+//FallbackHelpText returns "Passes every player's hand on once everyone has
+//picked."
+func (r *RotateHands) FallbackHelpText() string {
+	return "Passes every player's hand on once everyone has picked."
+}
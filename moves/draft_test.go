@@ -0,0 +1,109 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/components/playingcards"
+	"github.com/workfit/tester/assert"
+)
+
+func draftMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(DealCountComponents),
+				WithGameProperty("DrawStack"),
+				WithPlayerProperty("Hand"),
+				WithTargetCount(2),
+			),
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(DraftPick),
+				WithPlayerProperty("Hand"),
+				WithPickedProperty("Picked"),
+			),
+			auto.MustConfig(
+				new(RotateHands),
+				WithPlayerProperty("Hand"),
+				WithGameProperty("DraftTransit"),
+				WithPassDirection(PassAlternate),
+			),
+		),
+	)
+}
+
+func TestDraft(t *testing.T) {
+	manager, err := newGameManager(draftMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	pick := func(target, proposer boardgame.PlayerIndex, index int) error {
+		move := game.MoveByName("Draft Pick").(*DraftPick)
+		move.TargetPlayerIndex = target
+		move.ComponentIndex = index
+		return <-game.ProposeMove(move, proposer)
+	}
+
+	//Cards are dealt in canonical order, so player 0 has the Ace and 5 of
+	//spades, and player 1 has the 2 and 6.
+	_, players := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(cardIndex(players[0].Hand, playingcards.SuitSpades, playingcards.Rank5)).Equals(1)
+
+	//Players pick in any order, but only for themselves and only once.
+	assert.For(t).ThatActual(pick(2, 2, 0)).IsNil()
+	assert.For(t).ThatActual(pick(2, 2, 0)).IsNotNil()
+	assert.For(t).ThatActual(pick(1, 3, 0)).IsNotNil()
+	assert.For(t).ThatActual(pick(0, 0, 5)).IsNotNil()
+	assert.For(t).ThatActual(pick(0, 0, 0)).IsNil()
+	assert.For(t).ThatActual(pick(3, 3, 0)).IsNil()
+
+	gameState, players := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(len(gameState.DraftPickedPlayers())).Equals(3)
+	assert.For(t).ThatActual(players[0].Picked.NumComponents()).Equals(1)
+	assert.For(t).ThatActual(players[0].Hand.NumComponents()).Equals(1)
+
+	assert.For(t).ThatActual(pick(1, 1, 0)).IsNil()
+
+	//RotateHands should have applied, passing every hand left.
+	gameState, players = concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(len(gameState.DraftPickedPlayers())).Equals(0)
+	assert.For(t).ThatActual(gameState.DraftTransit.NumComponents()).Equals(0)
+	assert.For(t).ThatActual(gameState.DraftRound()).Equals(0)
+	assert.For(t).ThatActual(cardIndex(players[1].Hand, playingcards.SuitSpades, playingcards.Rank5)).Equals(0)
+	assert.For(t).ThatActual(cardIndex(players[2].Hand, playingcards.SuitSpades, playingcards.Rank6)).Equals(0)
+	assert.For(t).ThatActual(cardIndex(players[0].Hand, playingcards.SuitSpades, playingcards.Rank8)).Equals(0)
+
+	//The cards in transit are hidden from everyone.
+	sanitized, err := game.CurrentState().SanitizedForPlayer(0)
+	assert.For(t).ThatActual(err).IsNil()
+	sanitizedGame, _ := concreteStates(sanitized)
+	assert.For(t).ThatActual(sanitizedGame.DraftTransit.NumComponents()).Equals(0)
+
+	for i := 0; i < 4; i++ {
+		assert.For(t, i).ThatActual(pick(boardgame.PlayerIndex(i), boardgame.PlayerIndex(i), 0)).IsNil()
+	}
+
+	//Every hand was picked empty, so the round is over and the next one
+	//passes right.
+	gameState, players = concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(gameState.DraftRound()).Equals(1)
+	assert.For(t).ThatActual(players[1].Picked.NumComponents()).Equals(2)
+
+	rotate := game.MoveByName("Rotate Hands").(*RotateHands)
+	assert.For(t).ThatActual(rotate.PassDirection(game.CurrentState())).Equals(PassRight)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jkomoros/boardgame"
 	"github.com/jkomoros/boardgame/base"
 	"github.com/jkomoros/boardgame/behaviors"
+	"github.com/jkomoros/boardgame/components/dice"
 	"github.com/jkomoros/boardgame/components/playingcards"
 	"github.com/jkomoros/boardgame/enum"
 	"github.com/jkomoros/boardgame/storage/memory"
@@ -27,6 +28,12 @@ const (
 	colorBlue
 )
 
+const (
+	resourceWood = iota
+	resourceBrick
+	resourceGold
+)
+
 var enums = enum.NewSet()
 
 var phaseEnum = enums.MustAddTree("phase", map[int]string{
@@ -51,14 +58,32 @@ var colorEnum = enums.MustAdd("color", map[int]string{
 	colorBlue:  "Blue",
 })
 
+var resourceEnum = enums.MustAdd("resource", map[int]string{
+	resourceWood:  "Wood",
+	resourceBrick: "Brick",
+	resourceGold:  "Gold",
+})
+
 //boardgame:codegen
 type gameState struct {
 	behaviors.RoundRobin
 	base.SubState
 	behaviors.CurrentPlayerBehavior
 	behaviors.PhaseBehavior
+	behaviors.Trick
+	behaviors.Auction
+	behaviors.Draft
+	behaviors.PendingTrade
+	behaviors.ReactionWindow
+	behaviors.DicePool
+	behaviors.ActionSpaces
+	behaviors.ResourceBank
 	DrawStack    boardgame.Stack `stack:"cards"`
 	DiscardStack boardgame.Stack `stack:"cards"`
+	TrickStack   boardgame.Stack `stack:"cards"`
+	DraftTransit boardgame.Stack `stack:"cards" sanitize:"hidden"`
+	Dice         boardgame.Stack `stack:"dice"`
+	ActionBoard  boardgame.Board `stack:"cards" board:"3"`
 	Counter      int
 }
 
@@ -66,9 +91,15 @@ type gameState struct {
 type playerState struct {
 	base.SubState
 	behaviors.PlayerColor
+	behaviors.SecretChoice
+	behaviors.Resources
 	Hand      boardgame.Stack `stack:"cards"`
 	OtherHand boardgame.Stack `stack:"cards"`
+	WonCards  boardgame.Stack `stack:"cards"`
+	Picked    boardgame.Stack `stack:"cards"`
 	Counter   int
+	Wood      int
+	Brick     int
 }
 
 func (p *playerState) FinishStateSetUp() {
@@ -103,9 +134,22 @@ func (g *gameDelegate) Name() string {
 func (g *gameDelegate) DistributeComponentToStarterStack(state boardgame.ImmutableState, c boardgame.Component) (boardgame.ImmutableStack, error) {
 	game, _ := concreteStates(state)
 
+	if c.Deck().Name() == "dice" {
+		return game.Dice, nil
+	}
+
 	return game.DrawStack, nil
 }
 
+func (g *gameDelegate) DynamicComponentValuesConstructor(deck *boardgame.Deck) boardgame.ConfigurableSubState {
+	if deck.Name() == "dice" {
+		return &dice.DynamicValue{
+			Value: 1,
+		}
+	}
+	return nil
+}
+
 func (g *gameDelegate) DefaultNumPlayers() int {
 	return 4
 }
@@ -118,6 +162,45 @@ func (g *gameDelegate) GameStateConstructor() boardgame.ConfigurableSubState {
 	return new(gameState)
 }
 
+//BankResourceLimit limits wood and brick to 4 each.
+func (g *gameDelegate) BankResourceLimit(resource int) int {
+	switch resource {
+	case resourceWood, resourceBrick:
+		return 4
+	}
+	return 0
+}
+
+//ActionSpaceNames returns the forest, the quarry and the market.
+func (g *gameDelegate) ActionSpaceNames() []string {
+	return []string{"Forest", "Quarry", "Market"}
+}
+
+//ActionSpaceCapacity returns 2 for the quarry and 0 for the others.
+func (g *gameDelegate) ActionSpaceCapacity(space int) int {
+	if space == 1 {
+		return 2
+	}
+	return 0
+}
+
+//ActionSpaceExclusive returns true for the market.
+func (g *gameDelegate) ActionSpaceExclusive(space int) bool {
+	return space == 2
+}
+
+//ActionSpaceEffect gives wood for the forest and brick for the quarry.
+func (g *gameDelegate) ActionSpaceEffect(state boardgame.State, player boardgame.PlayerIndex, space int) error {
+	players := state.PlayerStates()
+	switch space {
+	case 0:
+		players[player].(*playerState).Wood++
+	case 1:
+		players[player].(*playerState).Brick++
+	}
+	return nil
+}
+
 func (g *gameDelegate) PlayerStateConstructor(index boardgame.PlayerIndex) boardgame.ConfigurableSubState {
 	return new(playerState)
 }
@@ -131,8 +214,13 @@ func (g *gameDelegate) ConfigureEnums() *enum.Set {
 }
 
 func (g *gameDelegate) ConfigureDecks() map[string]*boardgame.Deck {
+	diceDeck := boardgame.NewDeck()
+	for i := 0; i < 5; i++ {
+		diceDeck.AddComponent(dice.DefaultDie())
+	}
 	return map[string]*boardgame.Deck{
 		"cards": playingcards.NewDeck(false),
+		"dice":  diceDeck,
 	}
 }
 
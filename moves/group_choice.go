@@ -0,0 +1,67 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+Choice returns a MoveProgressionGroup that matches exactly one of the provided
+groups: either path A or path B, but not both, and not neither. It's similar
+to ParallelCount with CountAny(), except that it considers every child that
+could match, not just the one that consumes the most tape, so branches that
+share a prefix are fine; the branch isn't decided until the tape diverges.
+
+    //Example
+    moves.Choice(
+        moves.Serial(
+            auto.MustConfig(new(MoveDrawCard)),
+            auto.MustConfig(new(MovePlayCard)),
+        ),
+        moves.Serial(
+            auto.MustConfig(new(MoveDrawCard)),
+            auto.MustConfig(new(MoveDiscardCard)),
+        ),
+    )
+
+Its Satisfied returns the match that consumes the most tape, erroring if no
+child matches.
+*/
+func Choice(children ...MoveProgressionGroup) MoveProgressionGroup {
+	return choice(children)
+}
+
+type choice []MoveProgressionGroup
+
+func (c choice) MoveConfigs() []boardgame.MoveConfig {
+	var result []boardgame.MoveConfig
+	for _, group := range c {
+		result = append(result, group.MoveConfigs()...)
+	}
+	return result
+}
+
+func (c choice) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryItem, error) {
+	if tape == nil {
+		return nil, nil
+	}
+	rests := c.satisfiedAll(tape)
+	if len(rests) == 0 {
+		return tape, errors.New("none of the choices matched")
+	}
+	return longestRest(tape, rests)
+}
+
+func (c choice) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+	if tape == nil {
+		return []*MoveGroupHistoryItem{nil}
+	}
+	var result []*MoveGroupHistoryItem
+	for _, group := range c {
+		for _, rest := range groupRests(group, tape) {
+			result = addRest(result, rest)
+		}
+	}
+	return result
+}
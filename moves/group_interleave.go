@@ -0,0 +1,141 @@
+package moves
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+Interleave returns a MoveProgressionGroup that requires every one of the
+provided groups to be matched, with their moves mixed together in any order.
+Parallel requires each child to be matched all in one go, one after another;
+Interleave lets a move from one child come between the moves of another. For
+example, Interleave(Serial(A, B), Serial(C, D)) matches A C B D and C A D B,
+but not B A C D, since each child's own order is kept.
+
+    //Example
+    moves.Interleave(
+        moves.Serial(
+            auto.MustConfig(new(MoveBuildRoad)),
+            auto.MustConfig(new(MoveBuildSettlement)),
+        ),
+        moves.Repeat(
+            moves.CountAtMost(2),
+            auto.MustConfig(new(MoveTrade)),
+        ),
+    )
+
+Each move on the tape is given to whichever children it could continue, and
+every possibility is followed, so it's only suitable for the relatively short
+move progressions within a phase. Its Satisfied returns the match that
+consumes the most tape.
+*/
+func Interleave(children ...MoveProgressionGroup) MoveProgressionGroup {
+	return interleave(children)
+}
+
+type interleave []MoveProgressionGroup
+
+func (in interleave) MoveConfigs() []boardgame.MoveConfig {
+	var result []boardgame.MoveConfig
+	for _, group := range in {
+		result = append(result, group.MoveConfigs()...)
+	}
+	return result
+}
+
+func (in interleave) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryItem, error) {
+	if tape == nil {
+		return nil, nil
+	}
+	rests := in.satisfiedAll(tape)
+	if len(rests) == 0 {
+		return tape, errors.New("the tape couldn't be split up between the interleaved groups")
+	}
+	return longestRest(tape, rests)
+}
+
+func (in interleave) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+	var result []*MoveGroupHistoryItem
+	in.visit(tape, make([][]*MoveGroupHistoryItem, len(in)), make(map[string]bool), &result)
+	return result
+}
+
+//subTape returns a new tape of copies of items, so each child can be matched
+//against just the moves given to it. If next is non-nil, a copy of it is
+//added at the end as a stand-in for whatever follows.
+func subTape(items []*MoveGroupHistoryItem, next *MoveGroupHistoryItem) (start, end *MoveGroupHistoryItem) {
+	if next != nil {
+		items = append(append([]*MoveGroupHistoryItem{}, items...), next)
+	}
+	for i := len(items) - 1; i >= 0; i-- {
+		start = &MoveGroupHistoryItem{
+			MoveName: items[i].MoveName,
+			Rest:     start,
+			proposed: items[i].proposed,
+			state:    items[i].state,
+		}
+		if end == nil {
+			end = start
+		}
+	}
+	return start, end
+}
+
+//visit adds to result every place the tape could end up from tapeHead, given
+//the moves already given to each child in assigned.
+func (in interleave) visit(tapeHead *MoveGroupHistoryItem, assigned [][]*MoveGroupHistoryItem, seen map[string]bool, result *[]*MoveGroupHistoryItem) {
+
+	//Different ways of giving out the moves that leave every child with the
+	//same names lead to the same place.
+	key := fmt.Sprintf("%p", tapeHead)
+	for _, items := range assigned {
+		key += "|"
+		for _, item := range items {
+			key += item.MoveName + "\x00"
+		}
+	}
+
+	if seen[key] {
+		return
+	}
+	seen[key] = true
+
+	if tapeHead == nil {
+		*result = addRest(*result, nil)
+		return
+	}
+
+	//The interleave may end here if every child is complete, which is when
+	//it could be followed by something that isn't part of it. A stand-in
+	//for that something is matched with no name, which no move has.
+	complete := true
+	for i, group := range in {
+		start, stand := subTape(assigned[i], &MoveGroupHistoryItem{
+			proposed: tapeHead.proposed,
+			state:    tapeHead.state,
+		})
+		if !hasRest(groupRests(group, start), stand) {
+			complete = false
+			break
+		}
+	}
+
+	if complete {
+		*result = addRest(*result, tapeHead)
+	}
+
+	for i, group := range in {
+		items := append(append([]*MoveGroupHistoryItem{}, assigned[i]...), tapeHead)
+		start, _ := subTape(items, nil)
+		//Only give the move to a child that could still be matched with it.
+		if !hasRest(groupRests(group, start), nil) {
+			continue
+		}
+		nextAssigned := append([][]*MoveGroupHistoryItem{}, assigned...)
+		nextAssigned[i] = items
+		in.visit(tapeHead.Rest, nextAssigned, seen, result)
+	}
+}
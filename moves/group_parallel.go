@@ -2,6 +2,7 @@ package moves
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 
@@ -149,3 +150,43 @@ func (p parallelCount) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryI
 
 	return tapeHead, nil
 }
+
+func (p parallelCount) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+	var result []*MoveGroupHistoryItem
+	p.visit(tape, make([]bool, len(p.Children)), 0, make(map[string]bool), &result)
+	return result
+}
+
+//visit adds to result every place the tape could end up from tapeHead, given
+//that the children in matched have already been matched.
+func (p parallelCount) visit(tapeHead *MoveGroupHistoryItem, matched []bool, numMatched int, seen map[string]bool, result *[]*MoveGroupHistoryItem) {
+
+	key := fmt.Sprintf("%p %v", tapeHead, matched)
+
+	if seen[key] {
+		return
+	}
+	seen[key] = true
+
+	//Like Satisfied, stop as soon as the count is met.
+	if err := p.Count(numMatched, len(p.Children)); err == nil {
+		*result = addRest(*result, tapeHead)
+		return
+	}
+
+	if tapeHead == nil {
+		*result = addRest(*result, nil)
+		return
+	}
+
+	for i, group := range p.Children {
+		if matched[i] {
+			continue
+		}
+		for _, rest := range groupRests(group, tapeHead) {
+			nextMatched := append([]bool{}, matched...)
+			nextMatched[i] = true
+			p.visit(rest, nextMatched, numMatched+1, seen, result)
+		}
+	}
+}
@@ -101,3 +101,47 @@ func (r repeat) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryItem, er
 	return tapeHead, nil
 
 }
+
+func (r repeat) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+
+	var result []*MoveGroupHistoryItem
+
+	//Follows the same rules as Satisfied, but following every way the child
+	//could consume the tape each time around.
+	heads := []*MoveGroupHistoryItem{tape}
+
+	for count := 0; len(heads) > 0; count++ {
+
+		withinBounds := r.Count(count, 1) == nil
+
+		var next []*MoveGroupHistoryItem
+
+		for _, head := range heads {
+			if head == nil {
+				result = addRest(result, nil)
+				continue
+			}
+			if withinBounds {
+				result = addRest(result, head)
+				//If one more would be past the upper bound, stop here.
+				if r.Count(count+1, 1) != nil {
+					continue
+				}
+			}
+			for _, rest := range groupRests(r.Child, head) {
+				//Each time around must consume some tape, or we'd never
+				//finish.
+				if rest == head {
+					continue
+				}
+				next = addRest(next, rest)
+			}
+		}
+
+		heads = next
+
+	}
+
+	return result
+
+}
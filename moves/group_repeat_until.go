@@ -0,0 +1,102 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+)
+
+/*
+RepeatUntil returns a MoveProgressionGroup that repeats the provided group in
+serial until condition returns true, consulting the state rather than just
+counting how many times the group has matched like Repeat does. It's useful
+for things like "keep drawing until your hand is full", where the number of
+times isn't known ahead of time.
+
+condition is called with the state that a move is being proposed in. While it
+returns false, the next move must continue the group (starting another time
+around if the last one finished); once it returns true, the group may not
+start again, and the moves after it in the progression become legal. A time
+around that's already underway may always be finished. If condition is true
+from the start, the group is skipped entirely.
+
+When the tape isn't being checked on behalf of a proposed move (for example
+when calling Satisfied directly), condition isn't consulted, and RepeatUntil
+behaves like Repeat with CountAtLeast(0).
+*/
+func RepeatUntil(condition func(state boardgame.ImmutableState) bool, group MoveProgressionGroup) MoveProgressionGroup {
+	return repeatUntil{
+		condition,
+		group,
+	}
+}
+
+type repeatUntil struct {
+	Condition func(state boardgame.ImmutableState) bool
+	Child     MoveProgressionGroup
+}
+
+func (r repeatUntil) MoveConfigs() []boardgame.MoveConfig {
+	return r.Child.MoveConfigs()
+}
+
+func (r repeatUntil) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryItem, error) {
+	if tape == nil {
+		return nil, nil
+	}
+	rests := r.satisfiedAll(tape)
+	if len(rests) == 0 {
+		return tape, errors.New("the repeated group didn't match, and its condition wasn't met")
+	}
+	return longestRest(tape, rests)
+}
+
+func (r repeatUntil) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+
+	var result []*MoveGroupHistoryItem
+
+	heads := []*MoveGroupHistoryItem{tape}
+	seen := make(map[*MoveGroupHistoryItem]bool)
+
+	for len(heads) > 0 {
+
+		head := heads[0]
+		heads = heads[1:]
+
+		if head == nil {
+			result = addRest(result, nil)
+			continue
+		}
+
+		if seen[head] {
+			continue
+		}
+		seen[head] = true
+
+		//Only the move being proposed is judged by the condition; earlier
+		//moves were legal when they were made.
+		conditionMet := true
+		if head.proposed {
+			conditionMet = r.Condition(head.state)
+		}
+
+		if conditionMet {
+			//The proposed move may come after this group.
+			result = addRest(result, head)
+		}
+
+		if head.proposed && conditionMet {
+			//The group may not start again.
+			continue
+		}
+
+		for _, rest := range groupRests(r.Child, head) {
+			if rest == head {
+				continue
+			}
+			heads = append(heads, rest)
+		}
+	}
+
+	return result
+}
@@ -47,3 +47,29 @@ func (s serial) Satisfied(tape *MoveGroupHistoryItem) (*MoveGroupHistoryItem, er
 	return tapeHead, nil
 
 }
+
+func (s serial) satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+
+	heads := []*MoveGroupHistoryItem{tape}
+
+	for _, group := range s {
+
+		var next []*MoveGroupHistoryItem
+
+		for _, head := range heads {
+			if head == nil {
+				next = addRest(next, nil)
+				continue
+			}
+			for _, rest := range groupRests(group, head) {
+				next = addRest(next, rest)
+			}
+		}
+
+		heads = next
+
+	}
+
+	return heads
+
+}
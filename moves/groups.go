@@ -1,6 +1,8 @@
 package moves
 
 import (
+	"errors"
+
 	"github.com/jkomoros/boardgame"
 )
 
@@ -11,6 +13,11 @@ import (
 type MoveGroupHistoryItem struct {
 	MoveName string
 	Rest     *MoveGroupHistoryItem
+	//proposed is true for the last item when the tape is being checked to
+	//see if a move is legal; it's the move being proposed. state is the
+	//state it's proposed in. Groups like RepeatUntil consult them.
+	proposed bool
+	state    boardgame.ImmutableState
 }
 
 //MoveProgressionGroup is an object that can be used to define a valid move
@@ -32,3 +39,64 @@ type MoveProgressionGroup interface {
 	//return an error then the progression is considered valid.
 	Satisfied(tape *MoveGroupHistoryItem) (rest *MoveGroupHistoryItem, err error)
 }
+
+//multiSatisfier is implemented by the groups in this package. Unlike
+//Satisfied, which greedily commits to one way of consuming the tape,
+//satisfiedAll returns every position the group could leave the tape at, with
+//nil meaning the whole tape was consumed. If the group can't match at all it
+//returns an empty slice. This is how matchTape handles ambiguous prefixes,
+//where an earlier group could consume more or less of the tape.
+type multiSatisfier interface {
+	satisfiedAll(tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem
+}
+
+//groupRests returns every position group could leave tape at. For groups
+//that don't implement multiSatisfier it's just the result of Satisfied.
+func groupRests(group MoveProgressionGroup, tape *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+	if multi, ok := group.(multiSatisfier); ok {
+		return multi.satisfiedAll(tape)
+	}
+	rest, err := group.Satisfied(tape)
+	if err != nil {
+		return nil
+	}
+	return []*MoveGroupHistoryItem{rest}
+}
+
+//addRest returns rests with rest added, if it wasn't already in it.
+func addRest(rests []*MoveGroupHistoryItem, rest *MoveGroupHistoryItem) []*MoveGroupHistoryItem {
+	for _, existing := range rests {
+		if existing == rest {
+			return rests
+		}
+	}
+	return append(rests, rest)
+}
+
+//hasRest returns whether rest is in rests.
+func hasRest(rests []*MoveGroupHistoryItem, rest *MoveGroupHistoryItem) bool {
+	for _, existing := range rests {
+		if existing == rest {
+			return true
+		}
+	}
+	return false
+}
+
+//longestRest picks the rest from rests that consumes the most of tape, for
+//groups whose Satisfied is implemented with satisfiedAll.
+func longestRest(tape *MoveGroupHistoryItem, rests []*MoveGroupHistoryItem) (*MoveGroupHistoryItem, error) {
+	if len(rests) == 0 {
+		return tape, errors.New("the group didn't match the tape")
+	}
+	if hasRest(rests, nil) {
+		return nil, nil
+	}
+	result := rests[0]
+	for _, rest := range rests[1:] {
+		if tapeLength(tape, rest) > tapeLength(tape, result) {
+			result = rest
+		}
+	}
+	return result, nil
+}
@@ -89,3 +89,79 @@ func (a *ActivateInactivePlayer) FallbackHelpText() string {
 func (a *ActivateInactivePlayer) FallbackName(m *boardgame.GameManager) string {
 	return "Activate Inactive Players"
 }
+
+//InactivatePlayer is a move that marks TargetPlayerIndex as inactive. It may
+//only be proposed by an admin, and is not a FixUp move, so the engine will
+//never apply it on its own. It is a special interface point for the server
+//library: when a game type's inactivity policy is configured to inactivate
+//players who have taken too long to move, the server proposes this move (or a
+//move that embeds it) for the current player. Designed to be used with
+//behaviors.PlayerInactive; note that a player inactivated this way will be
+//reactivated by ActivateInactivePlayer the next time that move is legal. For
+//more on inactive players, see the package doc of boardgame/behaviors.
+//
+//boardgame:codegen
+type InactivatePlayer struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//IsInactivatePlayerMove returns true. This is a way for moves to signal to
+//other libraries that it's an InactivatePlayer move, even if it isn't
+//literally this move struct but a subclass of it. Implements
+//interfaces.InactivatePlayerMover.
+func (i *InactivatePlayer) IsInactivatePlayerMove() bool {
+	return true
+}
+
+//Legal verifies that the proposer is an admin, and that TargetPlayerIndex is
+//set to a player who is not already inactive.
+func (i *InactivatePlayer) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := i.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+	if proposer != boardgame.AdminPlayerIndex {
+		return errors.New("This move may only be proposed by an admin")
+	}
+	targetPlayerIndex := i.TargetPlayerIndex
+	if targetPlayerIndex < 0 || int(targetPlayerIndex) >= len(state.ImmutablePlayerStates()) {
+		return errors.New("Invalid TargetPlayerIndex")
+	}
+	player := state.ImmutablePlayerStates()[targetPlayerIndex]
+	if behaviors.PlayerIsInactive(player) {
+		return errors.New("The selected player is already inactive")
+	}
+	return nil
+}
+
+//Apply sets the TargetPlayerIndex to be inactive via SetPlayerInactive.
+func (i *InactivatePlayer) Apply(state boardgame.State) error {
+	player := state.ImmutablePlayerStates()[i.TargetPlayerIndex]
+	inactiver, ok := player.(interfaces.PlayerInactiver)
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.PlayerInactiver")
+	}
+	inactiver.SetPlayerInactive()
+	return nil
+}
+
+//ValidConfiguration checks that player states implement interfaces.PlayerInactiver
+func (i *InactivatePlayer) ValidConfiguration(exampleState boardgame.State) error {
+	player := exampleState.ImmutablePlayerStates()[0]
+	_, ok := player.(interfaces.PlayerInactiver)
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.PlayerInactiver. behaviors.PlayerInactive implements it for free")
+	}
+	return nil
+}
+
+//FallbackHelpText returns "Marks a player who has taken too long to move as
+//inactive."
+func (i *InactivatePlayer) FallbackHelpText() string {
+	return "Marks a player who has taken too long to move as inactive."
+}
+
+//FallbackName returns "Inactivate Player"
+func (i *InactivatePlayer) FallbackName(m *boardgame.GameManager) string {
+	return "Inactivate Player"
+}
@@ -10,6 +10,8 @@ clear about which structs are the main embeddable moves.
 package interfaces
 
 import (
+	"math/rand"
+
 	"github.com/jkomoros/boardgame"
 )
 
@@ -163,6 +165,14 @@ type Seater interface {
 	SetSeatClosed()
 }
 
+//Unseater is for PlayerStates that interact with moves like UnseatPlayer,
+//which need to be able to mark a filled seat as empty again. See the package
+//doc of boardgame/behaviors for more on the notion of seats.
+type Unseater interface {
+	Seater
+	SetSeatEmpty()
+}
+
 //SeatPlayerMover should be implemented for moves that are SeatPlayer moves,
 //returing true from IsSeatPlayerMove(). Typically you use moves.SeatPlayer
 //directly, which implements this interface, but you might also want to embed
@@ -173,6 +183,21 @@ type SeatPlayerMover interface {
 	IsSeatPlayerMove() bool
 }
 
+//InactivatePlayerMover should be implemented for moves that are
+//InactivatePlayer moves, returning true from IsInactivatePlayerMove(). The
+//server uses it to find the move to propose for players who have been
+//inactive for too long.
+type InactivatePlayerMover interface {
+	IsInactivatePlayerMove() bool
+}
+
+//UnseatPlayerMover should be implemented for moves that are UnseatPlayer
+//moves, returning true from IsUnseatPlayerMove(). It's the UnseatPlayer
+//analogue of SeatPlayerMover.
+type UnseatPlayerMover interface {
+	IsUnseatPlayerMove() bool
+}
+
 //SeatPlayerSignaler is the way that moves.SeatPlayer and the server coordinate
 //about where to seat a player.
 type SeatPlayerSignaler interface {
@@ -182,3 +207,298 @@ type SeatPlayerSignaler interface {
 	//The callback that should be called when the move is committed
 	Committed()
 }
+
+//UnseatPlayerSignaler is the way that moves.UnseatPlayer and the server
+//coordinate about which player has left their seat.
+type UnseatPlayerSignaler interface {
+	//The index of the seat the player has left.
+	SeatIndex() boardgame.PlayerIndex
+	//The callback that should be called when the move is committed
+	Committed()
+}
+
+//TrickProperties should be implemented by your GameState if you use
+//PlayCardToTrick or ResolveTrick. Like RoundRobinProperties, you don't have to
+//do anything with these other than store them and return them via the getters.
+//Generally you simply embed behaviors.Trick to satisfy this interface for free.
+type TrickProperties interface {
+	//The player who led the current trick. Once a trick is resolved, the
+	//winner of it, who will lead the next one.
+	TrickLeadPlayer() boardgame.PlayerIndex
+	//The suit of the card that led the current trick.
+	TrickLedSuit() int
+	//The player who played each card in the current trick, in the order they
+	//were played. Empty if no card has been played to the trick yet.
+	TrickCardPlayers() []boardgame.PlayerIndex
+
+	SetTrickLeadPlayer(player boardgame.PlayerIndex)
+	SetTrickLedSuit(suit int)
+	SetTrickCardPlayers(players []boardgame.PlayerIndex)
+}
+
+//TrickCard should be implemented by the ComponentValues of cards used with
+//moves like PlayCardToTrick and ResolveTrick. playingcards.Card implements
+//this.
+type TrickCard interface {
+	//TrickSuit is the suit the card belongs to for following suit and for
+	//trumps.
+	TrickSuit() int
+	//TrickRank orders the cards within a suit. When two cards of the same
+	//suit are compared in a trick, the higher TrickRank wins.
+	TrickRank() int
+}
+
+//TrickCardRanker is implemented by moves like PlayCardToTrick and
+//ResolveTrick. By default they defer to the TrickCard methods on the
+//component's values; override these on your embedding move if a card's suit
+//or rank depends on the state, for example a bower that changes suit when its
+//color is trump.
+type TrickCardRanker interface {
+	CardSuit(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int
+	CardRank(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int
+}
+
+//FollowSuitEnforcer is implemented by moves like PlayCardToTrick to decide
+//whether a card may be played to the current trick. Override it on your
+//embedding move if your game's follow suit rules differ from the default.
+type FollowSuitEnforcer interface {
+	//FollowSuitLegal should return nil if card may be played from hand to
+	//the current trick, or an error describing why it may not.
+	FollowSuitLegal(state boardgame.ImmutableState, hand boardgame.ImmutableStack, card boardgame.ImmutableComponentInstance) error
+}
+
+//TrumpSuiter is implemented by moves like ResolveTrick to say which suit, if
+//any, is trump.
+type TrumpSuiter interface {
+	//TrumpSuit returns the suit that beats every other suit in a trick, or a
+	//negative number if there is no trump.
+	TrumpSuit(state boardgame.ImmutableState) int
+}
+
+//AuctionProperties should be implemented by your GameState if you use any of
+//the auction moves: PlaceBid, PassBid and ResolveAuction. Like
+//RoundRobinProperties, you don't have to do anything with these other than
+//store them and return them via the getters. Generally you simply embed
+//behaviors.Auction to satisfy this interface for free.
+type AuctionProperties interface {
+	//The current high bid, or once the auction is resolved the winning bid.
+	AuctionHighBid() int
+	//The current high bidder, or once the auction is resolved the winner.
+	//ObserverPlayerIndex if no one has bid.
+	AuctionHighBidder() boardgame.PlayerIndex
+	//The players who have passed in the current auction.
+	AuctionPassedPlayers() []boardgame.PlayerIndex
+	//How many bids and passes have been made in the current auction.
+	AuctionTurnCount() int
+	//In a sealed-bid auction, each player's bid, indexed by player. -1 for
+	//players who haven't bid.
+	AuctionSealedBids() []int
+	//AuctionHasStarted is true from the first bid or pass until the auction
+	//is resolved.
+	AuctionHasStarted() bool
+
+	SetAuctionHighBid(bid int)
+	SetAuctionHighBidder(player boardgame.PlayerIndex)
+	SetAuctionPassedPlayers(players []boardgame.PlayerIndex)
+	SetAuctionTurnCount(count int)
+	SetAuctionSealedBids(bids []int)
+	SetAuctionHasStarted(hasStarted bool)
+}
+
+//AuctionResolver may be implemented by moves that embed ResolveAuction. It's
+//how your game acts on the result of an auction, for example by charging the
+//winner and giving them the lot.
+type AuctionResolver interface {
+	//AuctionResolved is called by ResolveAuction's Apply with the winner and
+	//their bid. If everyone passed, winner is ObserverPlayerIndex.
+	AuctionResolved(state boardgame.State, winner boardgame.PlayerIndex, bid int) error
+}
+
+//SecretChooser is for PlayerStates that use CommitAction and RevealActions,
+//where every player secretly chooses at the same time and then all the choices
+//are revealed at once. behaviors.SecretChoice implements it.
+type SecretChooser interface {
+	//HasCommittedChoice should return true between CommitChoice and
+	//RevealChoice.
+	HasCommittedChoice() bool
+	//CommittedChoice is the choice passed to CommitChoice. It should be
+	//hidden from other players.
+	CommittedChoice() int
+	//RevealedChoice is the choice that was committed as of the last
+	//RevealChoice. It should be visible to everyone.
+	RevealedChoice() int
+	CommitChoice(choice int)
+	RevealChoice()
+}
+
+//DraftProperties should be implemented by your GameState if you use DraftPick
+//and RotateHands. Like RoundRobinProperties, you don't have to do anything
+//with these other than store them and return them via the getters. Generally
+//you simply embed behaviors.Draft to satisfy this interface for free.
+type DraftProperties interface {
+	//The players who have picked since hands were last passed.
+	DraftPickedPlayers() []boardgame.PlayerIndex
+	//How many times every hand has been picked empty. Alternating pass
+	//directions switch each round.
+	DraftRound() int
+
+	SetDraftPickedPlayers(players []boardgame.PlayerIndex)
+	SetDraftRound(round int)
+}
+
+//TradeProperties should be implemented by your GameState if you use
+//OfferTrade, AcceptTrade, DeclineTrade and CancelTrade. Like
+//RoundRobinProperties, you don't have to do anything with these other than
+//store them and return them via the getters. Generally you simply embed
+//behaviors.PendingTrade to satisfy this interface for free.
+type TradeProperties interface {
+	//TradePending should return true between OpenTrade and CloseTrade.
+	TradePending() bool
+	TradeProposingPlayer() boardgame.PlayerIndex
+	TradeReceivingPlayer() boardgame.PlayerIndex
+	//Indexes into the proposer's stack of the components they offer.
+	TradeOfferedComponentIndexes() []int
+	//Indexes into the recipient's stack of the components asked for in
+	//return.
+	TradeRequestedComponentIndexes() []int
+	//Counts of each resource offered, in the order of WithTradeResources.
+	TradeOfferedResourceCounts() []int
+	//Counts of each resource asked for in return.
+	TradeRequestedResourceCounts() []int
+
+	OpenTrade(proposer, recipient boardgame.PlayerIndex, offeredComponents, requestedComponents, offeredResources, requestedResources []int)
+	CloseTrade()
+}
+
+//ReactionProperties should be implemented by your GameState if you use
+//OpenReactionWindow, React, PassReaction and ResolveReactions. Like
+//RoundRobinProperties, you don't have to do anything with these other than
+//store them and return them via the getters. Generally you simply embed
+//behaviors.ReactionWindow to satisfy this interface for free.
+type ReactionProperties interface {
+	//ReactionWindowOpen should return true while players may respond.
+	ReactionWindowOpen() bool
+	//The player who may respond or pass next.
+	ReactionResponder() boardgame.PlayerIndex
+	//The player who opened the window or most recently responded.
+	ReactionLastActor() boardgame.PlayerIndex
+	//How many players have passed since ReactionLastActor acted.
+	ReactionPassCount() int
+	//The players who put each item on the stack, with the opener first and
+	//the most recent response last.
+	ReactionStackPlayers() []boardgame.PlayerIndex
+	//The value of each item on the stack, in the same order.
+	ReactionStackValues() []int
+
+	SetReactionWindowOpen(open bool)
+	SetReactionResponder(player boardgame.PlayerIndex)
+	SetReactionLastActor(player boardgame.PlayerIndex)
+	SetReactionPassCount(count int)
+	SetReactionStack(players []boardgame.PlayerIndex, values []int)
+}
+
+//ReactionResolver may be implemented by moves that embed ResolveReactions.
+//It's how your game carries out the opening action and each response to it.
+type ReactionResolver interface {
+	//ResolveReaction is called by ResolveReactions' Apply once for each item
+	//on the stack, most recent first. The item has already been popped, so
+	//to cancel items below it (for example, to counter the action it
+	//responded to) remove them with SetReactionStack.
+	ResolveReaction(state boardgame.State, player boardgame.PlayerIndex, value int) error
+}
+
+//DicePoolProperties should be implemented by your GameState if you use
+//RollDice, RerollSelected and LockDie. Like RoundRobinProperties, you don't
+//have to do anything with these other than store them and return them via
+//the getters. Generally you simply embed behaviors.DicePool to satisfy this
+//interface for free.
+type DicePoolProperties interface {
+	//DiceRolled should return true once RollDice has rolled the pool, until
+	//your game clears it (typically at the end of the turn).
+	DiceRolled() bool
+	//How many times the pool has been rerolled since RollDice.
+	DiceRerolls() int
+	//Which dice are locked, indexed by slot in the dice stack. Dice past the
+	//end aren't locked.
+	DiceLocked() []bool
+
+	SetDiceRolled(rolled bool)
+	SetDiceRerolls(rerolls int)
+	SetDiceLocked(locked []bool)
+}
+
+//Die should be implemented by the dynamic values of the components in a dice
+//pool used with RollDice, RerollSelected and LockDie. components/dice's
+//DynamicValue implements it.
+type Die interface {
+	//Roll should set the die to a random face, using r as the source of
+	//randomness.
+	Roll(r *rand.Rand)
+}
+
+//ActionSpaceProperties should be implemented by your GameState if you use
+//PlaceWorker and ReturnWorkers. Generally you simply embed
+//behaviors.ActionSpaces to satisfy this interface for free.
+type ActionSpaceProperties interface {
+	//The space each worker was placed on since workers were last returned,
+	//in the order they were placed.
+	ActionSpacePlacementSpaces() []int
+	//The player who placed each worker, in the same order.
+	ActionSpacePlacementPlayers() []boardgame.PlayerIndex
+
+	SetActionSpacePlacements(spaces []int, players []boardgame.PlayerIndex)
+}
+
+//ActionSpaceDefiner should be implemented by your GameDelegate if you use
+//PlaceWorker and ReturnWorkers. It describes the action spaces, which are
+//the same for the whole game, so only where the workers are is stored in
+//state.
+type ActionSpaceDefiner interface {
+	//The names of the action spaces, in the same order as the spaces of the
+	//Board the workers are placed on, which should have at least this many
+	//spaces.
+	ActionSpaceNames() []string
+	//How many workers may be on the space at once. 0 means any number.
+	ActionSpaceCapacity(space int) int
+	//Whether only one player may have workers on the space at once.
+	ActionSpaceExclusive(space int) bool
+}
+
+//ActionSpaceEffecter may be implemented by your GameDelegate if you use
+//PlaceWorker. It's how your game carries out the action of each space.
+type ActionSpaceEffecter interface {
+	//ActionSpaceEffect is called by PlaceWorker's Apply after player's
+	//worker has been placed on space.
+	ActionSpaceEffect(state boardgame.State, player boardgame.PlayerIndex, space int) error
+}
+
+//ResourceProperties should be implemented by your PlayerState if you use
+//GainResources, PayCost and ExchangeResources. Generally you simply embed
+//behaviors.Resources to satisfy this interface for free.
+type ResourceProperties interface {
+	//How much of the resource, a value in the "resource" enum, the player
+	//has.
+	ResourceCount(resource int) int
+	SetResourceCount(resource int, count int)
+}
+
+//ResourceBankProperties may be implemented by your GameState if you use
+//GainResources, PayCost and ExchangeResources and the supply of some
+//resources is limited by your GameDelegate's ResourceBankLimiter. Generally
+//you simply embed behaviors.ResourceBank to satisfy this interface for free.
+type ResourceBankProperties interface {
+	//How much of the resource has been taken from the bank and not yet paid
+	//back. Only counted for resources the ResourceBankLimiter limits.
+	BankResourceTaken(resource int) int
+	SetBankResourceTaken(resource int, count int)
+}
+
+//ResourceBankLimiter may be implemented by your GameDelegate if you use
+//GainResources, PayCost and ExchangeResources and the supply of some
+//resources is limited. The limits are the same for the whole game, so only
+//how much has been taken is stored in state, via ResourceBankProperties.
+type ResourceBankLimiter interface {
+	//How much of the resource the bank starts the game with. 0 means the
+	//supply is unlimited.
+	BankResourceLimit(resource int) int
+}
@@ -46,6 +46,11 @@ only uses moves.Default.Legal() and is therefore always legal at its point in th
 phase. After NoOp applies, the next FixUpAllowsMultiple applies, guaranteeing
 it begins matching the second group.
 
+moves.Default.Legal() considers every way the groups in this package could
+consume the move history, so it doesn't need this barrier to match the second
+group. But Satisfied on each group is still greedy, so the barrier is
+still useful if your own MoveProgressionGroup relies on it.
+
 NoOp is also used by AddOrderedForPhase to signal that the lack of a
 StartPhase move was intentional.
 
@@ -0,0 +1,432 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+/*
+ReactionWindow returns a MoveProgressionGroup for a reaction window: open,
+followed by any number of react or pass in any order, followed by resolve.
+Typically open is an OpenReactionWindow, react a React, pass a PassReaction
+and resolve a ResolveReactions. Pass it to AddOrderedForPhase, wrapped in
+Repeat if windows may open more than once in the phase.
+
+    moves.AddOrderedForPhase(PhaseNormalPlay,
+        moves.Repeat(moves.CountAtLeast(1), moves.ReactionWindow(
+            auto.MustConfig(new(MovePlayAttack)),
+            auto.MustConfig(new(MovePlayDefense)),
+            auto.MustConfig(new(moves.PassReaction)),
+            auto.MustConfig(new(MoveResolveAttack)),
+        )),
+        auto.MustConfig(new(moves.StartPhase), moves.WithPhaseToStart(PhaseScoring, PhaseEnum)),
+    )
+
+*/
+func ReactionWindow(open, react, pass, resolve MoveProgressionGroup) MoveProgressionGroup {
+	return Serial(
+		open,
+		Repeat(CountAtLeast(0), ParallelCount(CountAny(), react, pass)),
+		resolve,
+	)
+}
+
+//reactionWindowDone returns whether every player who may be active other than
+//the last to act has passed since they acted.
+func reactionWindowDone(state boardgame.ImmutableState, reaction interfaces.ReactionProperties) bool {
+	return reaction.ReactionPassCount() >= activePlayerCount(state)-1
+}
+
+//reactionResponderLegal checks that a reaction window is open and still
+//waiting on target, and that proposer may act for target.
+func reactionResponderLegal(state boardgame.ImmutableState, target, proposer boardgame.PlayerIndex) error {
+	if target < 0 || !target.Valid(state) {
+		return errors.New("The specified target player is not valid")
+	}
+
+	if !target.Equivalent(proposer) {
+		return errors.New("You may only respond for yourself")
+	}
+
+	reaction, ok := state.ImmutableGameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	if !reaction.ReactionWindowOpen() {
+		return errors.New("There's no reaction window open")
+	}
+
+	if reactionWindowDone(state, reaction) {
+		return errors.New("Everyone has passed; the reactions are about to resolve")
+	}
+
+	if reaction.ReactionResponder() != target {
+		return errors.New("It's not your turn to respond")
+	}
+
+	return nil
+}
+
+//reactionPush puts value on top of the stack on behalf of player, and gives
+//everyone else a chance to respond to it.
+func reactionPush(state boardgame.State, reaction interfaces.ReactionProperties, player boardgame.PlayerIndex, value int) {
+	players := append([]boardgame.PlayerIndex{}, reaction.ReactionStackPlayers()...)
+	values := append([]int{}, reaction.ReactionStackValues()...)
+
+	reaction.SetReactionStack(append(players, player), append(values, value))
+	reaction.SetReactionLastActor(player)
+	reaction.SetReactionPassCount(0)
+	reaction.SetReactionResponder(player.Next(state))
+}
+
+//reactionValidConfiguration checks that the GameState implements
+//interfaces.ReactionProperties.
+func reactionValidConfiguration(exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.ReactionProperties); !ok {
+		return errors.New("GameState does not implement ReactionProperties. behaviors.ReactionWindow implements it for free")
+	}
+	return nil
+}
+
+//responseValidator is implemented by React.
+type responseValidator interface {
+	ValidResponse(state boardgame.ImmutableState, player boardgame.PlayerIndex, response int) error
+}
+
+/*
+
+OpenReactionWindow is a move that lets every other player react to an action
+before it takes effect, like casting a spell that opponents may counter.
+The current player (TargetPlayerIndex) puts Action on the bottom of the
+reaction stack. Then, starting with the next player and in turn order, each
+player may respond with React or pass with PassReaction, regardless of who the
+current player is. A response goes on top of the stack and gives everyone
+else a chance to respond to it. Once every other player has passed in a row,
+ResolveReactions resolves the stack, most recent first, ending with Action.
+
+Actions and responses are ints; idiomatically they're values from an enum, or
+indexes of the card played. Your GameState must implement
+interfaces.ReactionProperties, typically by embedding behaviors.ReactionWindow.
+Use ReactionWindow to build the MoveProgressionGroup for the whole exchange.
+
+boardgame:codegen
+*/
+type OpenReactionWindow struct {
+	CurrentPlayer
+	Action int
+}
+
+//Legal checks that no reaction window is already open.
+func (o *OpenReactionWindow) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := o.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	reaction, ok := state.ImmutableGameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	if reaction.ReactionWindowOpen() {
+		return errors.New("There's already a reaction window open")
+	}
+
+	return nil
+}
+
+//Apply opens the window with Action at the bottom of the stack.
+func (o *OpenReactionWindow) Apply(state boardgame.State) error {
+	reaction, ok := state.GameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	reaction.SetReactionWindowOpen(true)
+	reaction.SetReactionStack(nil, nil)
+	reactionPush(state, reaction, o.TargetPlayerIndex, o.Action)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ReactionProperties.
+func (o *OpenReactionWindow) ValidConfiguration(exampleState boardgame.State) error {
+	if err := o.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return reactionValidConfiguration(exampleState)
+}
+
+//FallbackName returns "Open Reaction Window"
+func (o *OpenReactionWindow) FallbackName(m *boardgame.GameManager) string {
+	return "Open Reaction Window"
+}
+
+//FallbackHelpText returns "Takes an action that other players may react to
+//before it resolves."
+func (o *OpenReactionWindow) FallbackHelpText() string {
+	return "Takes an action that other players may react to before it resolves."
+}
+
+/*
+
+React is a move for the player whose turn it is to respond in an open
+reaction window (TargetPlayerIndex) to put Response on top of the reaction
+stack. It doesn't depend on the current player. Override ValidResponse to
+restrict which responses are legal.
+
+boardgame:codegen
+*/
+type React struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+	Response          int
+}
+
+//ValidResponse returns nil. Override it in your embedding move to return an
+//error for responses that player may not make.
+func (r *React) ValidResponse(state boardgame.ImmutableState, player boardgame.PlayerIndex, response int) error {
+	return nil
+}
+
+//DefaultsForState sets TargetPlayerIndex to the player whose turn it is to
+//respond.
+func (r *React) DefaultsForState(state boardgame.ImmutableState) {
+	if reaction, ok := state.ImmutableGameState().(interfaces.ReactionProperties); ok {
+		r.TargetPlayerIndex = reaction.ReactionResponder()
+	}
+}
+
+//Legal checks that a reaction window is open, that it's TargetPlayerIndex's
+//turn to respond, that they are the proposer, and that ValidResponse returns
+//nil.
+func (r *React) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	if err := reactionResponderLegal(state, r.TargetPlayerIndex, proposer); err != nil {
+		return err
+	}
+
+	validator, ok := r.TopLevelStruct().(responseValidator)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ValidResponse")
+	}
+
+	return validator.ValidResponse(state, r.TargetPlayerIndex, r.Response)
+}
+
+//Apply puts Response on top of the stack, and gives every other player a
+//chance to respond to it.
+func (r *React) Apply(state boardgame.State) error {
+	reaction, ok := state.GameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	reactionPush(state, reaction, r.TargetPlayerIndex, r.Response)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ReactionProperties.
+func (r *React) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return reactionValidConfiguration(exampleState)
+}
+
+//FallbackName returns "React"
+func (r *React) FallbackName(m *boardgame.GameManager) string {
+	return "React"
+}
+
+//FallbackHelpText returns "Responds to the action being taken, before it
+//resolves."
+func (r *React) FallbackHelpText() string {
+	return "Responds to the action being taken, before it resolves."
+}
+
+/*
+
+PassReaction is a move for the player whose turn it is to respond in an open
+reaction window (TargetPlayerIndex) to decline to respond. It doesn't depend
+on the current player.
+
+boardgame:codegen
+*/
+type PassReaction struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//DefaultsForState sets TargetPlayerIndex to the player whose turn it is to
+//respond.
+func (p *PassReaction) DefaultsForState(state boardgame.ImmutableState) {
+	if reaction, ok := state.ImmutableGameState().(interfaces.ReactionProperties); ok {
+		p.TargetPlayerIndex = reaction.ReactionResponder()
+	}
+}
+
+//Legal checks that a reaction window is open, that it's TargetPlayerIndex's
+//turn to respond, and that they are the proposer.
+func (p *PassReaction) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := p.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+	return reactionResponderLegal(state, p.TargetPlayerIndex, proposer)
+}
+
+//Apply records the pass and moves on to the next player.
+func (p *PassReaction) Apply(state boardgame.State) error {
+	reaction, ok := state.GameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	reaction.SetReactionPassCount(reaction.ReactionPassCount() + 1)
+	reaction.SetReactionResponder(p.TargetPlayerIndex.Next(state))
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ReactionProperties.
+func (p *PassReaction) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return reactionValidConfiguration(exampleState)
+}
+
+//FallbackName returns "Pass Reaction"
+func (p *PassReaction) FallbackName(m *boardgame.GameManager) string {
+	return "Pass Reaction"
+}
+
+//FallbackHelpText returns "Declines to respond to the action being taken."
+func (p *PassReaction) FallbackHelpText() string {
+	return "Declines to respond to the action being taken."
+}
+
+/*
+
+ResolveReactions is a fix up move that applies once every player other than
+the last to act has passed in an open reaction window. It pops each item off
+the reaction stack, most recent first, and passes it to ResolveReaction, and
+then closes the window.
+
+Embed it in your own move and override ResolveReaction to carry out the
+opening action and each response. To cancel items lower in the stack, for
+example when a response counters the action it responded to, remove them
+with SetReactionStack from within ResolveReaction.
+
+boardgame:codegen
+*/
+type ResolveReactions struct {
+	FixUp
+}
+
+//ResolveReaction is called once for each item on the stack, most recent
+//first. By default it does nothing; override it to carry out each action.
+//Satisfies interfaces.ReactionResolver.
+func (r *ResolveReactions) ResolveReaction(state boardgame.State, player boardgame.PlayerIndex, value int) error {
+	return nil
+}
+
+//Legal returns nil once every player other than the last to act has passed.
+func (r *ResolveReactions) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	reaction, ok := state.ImmutableGameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	if !reaction.ReactionWindowOpen() {
+		return errors.New("There's no reaction window open")
+	}
+
+	if !reactionWindowDone(state, reaction) {
+		return errors.New("Not every player has had a chance to respond")
+	}
+
+	return nil
+}
+
+//Apply pops each item off the stack, most recent first, and calls
+//ResolveReaction with it, and then closes the window.
+func (r *ResolveReactions) Apply(state boardgame.State) error {
+	reaction, ok := state.GameState().(interfaces.ReactionProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ReactionProperties")
+	}
+
+	resolver, ok := r.TopLevelStruct().(interfaces.ReactionResolver)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement ReactionResolver")
+	}
+
+	for {
+		players := reaction.ReactionStackPlayers()
+		values := reaction.ReactionStackValues()
+
+		if len(players) == 0 || len(players) != len(values) {
+			break
+		}
+
+		last := len(players) - 1
+
+		reaction.SetReactionStack(
+			append([]boardgame.PlayerIndex{}, players[:last]...),
+			append([]int{}, values[:last]...),
+		)
+
+		if err := resolver.ResolveReaction(state, players[last], values[last]); err != nil {
+			return err
+		}
+	}
+
+	reaction.SetReactionStack(nil, nil)
+	reaction.SetReactionPassCount(0)
+	reaction.SetReactionWindowOpen(false)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ReactionProperties.
+func (r *ResolveReactions) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return reactionValidConfiguration(exampleState)
+}
+
+//FallbackName returns "Resolve Reactions"
+func (r *ResolveReactions) FallbackName(m *boardgame.GameManager) string {
+	return "Resolve Reactions"
+}
+
+//FallbackHelpText returns "Resolves the action and every response to it, most
+//recent first."
+func (r *ResolveReactions) FallbackHelpText() string {
+	return "Resolves the action and every response to it, most recent first."
+}
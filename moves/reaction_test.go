@@ -0,0 +1,137 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+	"github.com/workfit/tester/assert"
+)
+
+//reactionCounter is the response that cancels the item below it.
+const reactionCounter = 9
+
+//boardgame:codegen
+type moveResolveReactionsRecord struct {
+	ResolveReactions
+}
+
+//ResolveReaction appends value to gameState.Counter, so the test can see the
+//order items resolved in.
+func (m *moveResolveReactionsRecord) ResolveReaction(state boardgame.State, player boardgame.PlayerIndex, value int) error {
+	game := state.GameState().(*gameState)
+	game.Counter = game.Counter*10 + value
+
+	if value != reactionCounter {
+		return nil
+	}
+
+	reaction := state.GameState().(interfaces.ReactionProperties)
+	players := reaction.ReactionStackPlayers()
+	values := reaction.ReactionStackValues()
+	if len(players) > 0 {
+		reaction.SetReactionStack(players[:len(players)-1], values[:len(values)-1])
+	}
+	return nil
+}
+
+func reactionMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddOrderedForPhase(phaseNormalPlayDrawCard,
+			Repeat(CountExactly(2), ReactionWindow(
+				auto.MustConfig(new(OpenReactionWindow)),
+				auto.MustConfig(new(React)),
+				auto.MustConfig(new(PassReaction)),
+				auto.MustConfig(new(moveResolveReactionsRecord)),
+			)),
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseDrawAgain, phaseEnum),
+			),
+		),
+	)
+}
+
+func TestReactionWindow(t *testing.T) {
+	manager, err := newGameManager(reactionMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	open := func(player boardgame.PlayerIndex, action int) error {
+		move := game.MoveByName("Open Reaction Window").(*OpenReactionWindow)
+		move.TargetPlayerIndex = player
+		move.Action = action
+		return <-game.ProposeMove(move, player)
+	}
+
+	react := func(player boardgame.PlayerIndex, response int) error {
+		move := game.MoveByName("React").(*React)
+		move.TargetPlayerIndex = player
+		move.Response = response
+		return <-game.ProposeMove(move, player)
+	}
+
+	pass := func(player boardgame.PlayerIndex) error {
+		move := game.MoveByName("Pass Reaction").(*PassReaction)
+		move.TargetPlayerIndex = player
+		return <-game.ProposeMove(move, player)
+	}
+
+	//Nobody may respond before a window is open.
+	assert.For(t).ThatActual(pass(1)).IsNotNil()
+
+	assert.For(t).ThatActual(open(0, 1)).IsNil()
+	assert.For(t).ThatActual(open(0, 1)).IsNotNil()
+
+	gameState, _ := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.ReactionWindowOpen()).IsTrue()
+	assert.For(t).ThatActual(gameState.ReactionResponder()).Equals(boardgame.PlayerIndex(1))
+
+	//Players respond in turn order, even though player 0 is current.
+	assert.For(t).ThatActual(react(2, 2)).IsNotNil()
+	assert.For(t).ThatActual(pass(0)).IsNotNil()
+	assert.For(t).ThatActual(react(1, 2)).IsNil()
+	assert.For(t).ThatActual(pass(2)).IsNil()
+	assert.For(t).ThatActual(react(3, 3)).IsNil()
+
+	//A response gives everyone else, including the opener, another chance.
+	assert.For(t).ThatActual(pass(0)).IsNil()
+	assert.For(t).ThatActual(pass(1)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.ReactionWindowOpen()).IsTrue()
+	assert.For(t).ThatActual(len(gameState.ReactionStackValues())).Equals(3)
+
+	assert.For(t).ThatActual(pass(2)).IsNil()
+
+	//ResolveReactions should have applied, most recent first.
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.ReactionWindowOpen()).IsFalse()
+	assert.For(t).ThatActual(len(gameState.ReactionStackValues())).Equals(0)
+	assert.For(t).ThatActual(gameState.Counter).Equals(321)
+	assert.For(t).ThatActual(gameState.Phase.Value()).Equals(phaseNormalPlayDrawCard)
+
+	//A counter cancels the action it responded to.
+	assert.For(t).ThatActual(open(0, 1)).IsNil()
+	assert.For(t).ThatActual(react(1, reactionCounter)).IsNil()
+	assert.For(t).ThatActual(pass(2)).IsNil()
+	assert.For(t).ThatActual(pass(3)).IsNil()
+	assert.For(t).ThatActual(pass(0)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.Counter).Equals(321*10 + reactionCounter)
+	assert.For(t).ThatActual(gameState.Phase.Value()).Equals(phaseDrawAgain)
+}
@@ -0,0 +1,394 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/behaviors"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//resourceCoster is implemented by PayCost and ExchangeResources.
+type resourceCoster interface {
+	ResourceCost(state boardgame.ImmutableState) behaviors.Cost
+}
+
+//resourceGainer is implemented by GainResources and ExchangeResources.
+type resourceGainer interface {
+	ResourceGain(state boardgame.ImmutableState) behaviors.Cost
+}
+
+//costFromConfig returns the behaviors.Cost passed to auto.Config for
+//configPropName, or nil if none was.
+func costFromConfig(m moveInfoer, configPropName string) behaviors.Cost {
+	cost, _ := m.CustomConfiguration()[configPropName].(behaviors.Cost)
+	return cost
+}
+
+//resourceHolders returns player's ResourceProperties, the bank if GameState
+//has one, and the bank's limits if the GameDelegate has them.
+func resourceHolders(state boardgame.ImmutableState, player boardgame.PlayerIndex) (interfaces.ResourceProperties, interfaces.ResourceBankProperties, interfaces.ResourceBankLimiter, error) {
+	if player < 0 || int(player) >= len(state.ImmutablePlayerStates()) {
+		return nil, nil, nil, errors.New("The specified target player is not valid")
+	}
+
+	holder, ok := state.ImmutablePlayerStates()[player].(interfaces.ResourceProperties)
+
+	if !ok {
+		return nil, nil, nil, errors.New("PlayerState does not implement ResourceProperties")
+	}
+
+	bank, _ := state.ImmutableGameState().(interfaces.ResourceBankProperties)
+
+	limiter, _ := state.Manager().Delegate().(interfaces.ResourceBankLimiter)
+
+	return holder, bank, limiter, nil
+}
+
+//resourceValidConfiguration checks that PlayerState implements
+//interfaces.ResourceProperties, that there is a resource enum, and that each
+//of costs is valid for it.
+func resourceValidConfiguration(exampleState boardgame.State, costs ...behaviors.Cost) error {
+	if _, _, _, err := resourceHolders(exampleState, 0); err != nil {
+		return err
+	}
+
+	resources := exampleState.Manager().Chest().Enums().Enum(behaviors.ResourceEnumName)
+
+	if resources == nil {
+		return errors.New("There is no enum named " + behaviors.ResourceEnumName)
+	}
+
+	for _, cost := range costs {
+		if err := cost.Valid(resources); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+
+GainResources gives the current player ResourceGain, configured with
+WithGain, taking it from the bank if your GameState implements
+interfaces.ResourceBankProperties (typically by embedding
+behaviors.ResourceBank) and your GameDelegate's
+interfaces.ResourceBankLimiter limits any of the resources. It's illegal if
+the bank doesn't have enough.
+
+Your PlayerState must implement interfaces.ResourceProperties, typically by
+embedding behaviors.Resources.
+
+boardgame:codegen
+*/
+type GainResources struct {
+	CurrentPlayer
+}
+
+//ResourceGain returns the value passed to auto.Config with WithGain. Override
+//it if what's gained depends on the state.
+func (g *GainResources) ResourceGain(state boardgame.ImmutableState) behaviors.Cost {
+	return costFromConfig(g, configPropGain)
+}
+
+//Legal checks that the bank has enough of each resource.
+func (g *GainResources) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := g.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	gainer, ok := g.TopLevelStruct().(resourceGainer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ResourceGain")
+	}
+
+	_, bank, limiter, err := resourceHolders(state, g.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	if !gainer.ResourceGain(state).Available(bank, limiter) {
+		return errors.New("The bank doesn't have enough resources")
+	}
+
+	return nil
+}
+
+//Apply gives the current player ResourceGain.
+func (g *GainResources) Apply(state boardgame.State) error {
+	gainer, ok := g.TopLevelStruct().(resourceGainer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ResourceGain")
+	}
+
+	holder, bank, limiter, err := resourceHolders(state, g.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	return gainer.ResourceGain(state).Gain(holder, bank, limiter)
+}
+
+//ValidConfiguration checks that PlayerState implements
+//interfaces.ResourceProperties, and that ResourceGain is valid for the
+//resource enum.
+func (g *GainResources) ValidConfiguration(exampleState boardgame.State) error {
+	if err := g.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	gainer, ok := g.TopLevelStruct().(resourceGainer)
+
+	if !ok {
+		return errors.New("Embedding move doesn't have ResourceGain")
+	}
+
+	return resourceValidConfiguration(exampleState, gainer.ResourceGain(exampleState))
+}
+
+//FallbackName returns "Gain Resources"
+func (g *GainResources) FallbackName(m *boardgame.GameManager) string {
+	return "Gain Resources"
+}
+
+//FallbackHelpText returns "Gains resources from the bank."
+func (g *GainResources) FallbackHelpText() string {
+	return "Gains resources from the bank."
+}
+
+/*
+
+PayCost has the current player pay ResourceCost, configured with WithCost,
+returning it to the bank if your GameState implements
+interfaces.ResourceBankProperties and your GameDelegate's
+interfaces.ResourceBankLimiter limits any of the resources. It's illegal if
+the player can't afford it.
+
+It's designed to be embedded in your own moves for actions that cost
+something to take, like building or buying. Call PayCost.Apply from your
+move's Apply, and then do whatever the move does.
+
+    //Example
+    type moveBuildRoad struct {
+        moves.PayCost
+    }
+
+    auto.MustConfig(
+        new(moveBuildRoad),
+        moves.WithCost(behaviors.Cost{
+            resourceWood:  1,
+            resourceBrick: 1,
+        }),
+    )
+
+Your PlayerState must implement interfaces.ResourceProperties, typically by
+embedding behaviors.Resources.
+
+boardgame:codegen
+*/
+type PayCost struct {
+	CurrentPlayer
+}
+
+//ResourceCost returns the value passed to auto.Config with WithCost.
+//Override it if the cost depends on the state.
+func (p *PayCost) ResourceCost(state boardgame.ImmutableState) behaviors.Cost {
+	return costFromConfig(p, configPropCost)
+}
+
+//Legal checks that the current player can afford ResourceCost.
+func (p *PayCost) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := p.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	coster, ok := p.TopLevelStruct().(resourceCoster)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ResourceCost")
+	}
+
+	holder, _, _, err := resourceHolders(state, p.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	if !coster.ResourceCost(state).CanAfford(holder) {
+		return errors.New("You can't afford it")
+	}
+
+	return nil
+}
+
+//Apply has the current player pay ResourceCost.
+func (p *PayCost) Apply(state boardgame.State) error {
+	coster, ok := p.TopLevelStruct().(resourceCoster)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have ResourceCost")
+	}
+
+	holder, bank, limiter, err := resourceHolders(state, p.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	return coster.ResourceCost(state).Pay(holder, bank, limiter)
+}
+
+//ValidConfiguration checks that PlayerState implements
+//interfaces.ResourceProperties, and that ResourceCost is valid for the
+//resource enum.
+func (p *PayCost) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	coster, ok := p.TopLevelStruct().(resourceCoster)
+
+	if !ok {
+		return errors.New("Embedding move doesn't have ResourceCost")
+	}
+
+	return resourceValidConfiguration(exampleState, coster.ResourceCost(exampleState))
+}
+
+//FallbackName returns "Pay Cost"
+func (p *PayCost) FallbackName(m *boardgame.GameManager) string {
+	return "Pay Cost"
+}
+
+//FallbackHelpText returns "Pays resources to the bank."
+func (p *PayCost) FallbackHelpText() string {
+	return "Pays resources to the bank."
+}
+
+/*
+
+ExchangeResources has the current player pay ResourceCost (configured with
+WithCost) to the bank in return for ResourceGain (configured with WithGain),
+like trading four of a kind for any one resource in Catan. It's illegal if the
+player can't afford the cost or the bank doesn't have enough to give.
+
+Your PlayerState must implement interfaces.ResourceProperties, typically by
+embedding behaviors.Resources.
+
+boardgame:codegen
+*/
+type ExchangeResources struct {
+	CurrentPlayer
+}
+
+//ResourceCost returns the value passed to auto.Config with WithCost.
+//Override it if the cost depends on the state.
+func (e *ExchangeResources) ResourceCost(state boardgame.ImmutableState) behaviors.Cost {
+	return costFromConfig(e, configPropCost)
+}
+
+//ResourceGain returns the value passed to auto.Config with WithGain. Override
+//it if what's gained depends on the state.
+func (e *ExchangeResources) ResourceGain(state boardgame.ImmutableState) behaviors.Cost {
+	return costFromConfig(e, configPropGain)
+}
+
+//costs returns ResourceCost and ResourceGain from the top level struct.
+func (e *ExchangeResources) costs(state boardgame.ImmutableState) (cost, gain behaviors.Cost, err error) {
+	coster, ok := e.TopLevelStruct().(resourceCoster)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't have ResourceCost")
+	}
+
+	gainer, ok := e.TopLevelStruct().(resourceGainer)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't have ResourceGain")
+	}
+
+	return coster.ResourceCost(state), gainer.ResourceGain(state), nil
+}
+
+//Legal checks that the current player can afford ResourceCost and that the
+//bank has enough to give ResourceGain.
+func (e *ExchangeResources) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := e.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	cost, gain, err := e.costs(state)
+
+	if err != nil {
+		return err
+	}
+
+	holder, bank, limiter, err := resourceHolders(state, e.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	if !cost.CanAfford(holder) {
+		return errors.New("You can't afford it")
+	}
+
+	if !gain.Available(bank, limiter) {
+		return errors.New("The bank doesn't have enough resources")
+	}
+
+	return nil
+}
+
+//Apply pays ResourceCost and then gains ResourceGain.
+func (e *ExchangeResources) Apply(state boardgame.State) error {
+	cost, gain, err := e.costs(state)
+
+	if err != nil {
+		return err
+	}
+
+	holder, bank, limiter, err := resourceHolders(state, e.TargetPlayerIndex)
+
+	if err != nil {
+		return err
+	}
+
+	if err := cost.Pay(holder, bank, limiter); err != nil {
+		return err
+	}
+
+	return gain.Gain(holder, bank, limiter)
+}
+
+//ValidConfiguration checks that PlayerState implements
+//interfaces.ResourceProperties, and that ResourceCost and ResourceGain are
+//valid for the resource enum.
+func (e *ExchangeResources) ValidConfiguration(exampleState boardgame.State) error {
+	if err := e.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	cost, gain, err := e.costs(exampleState)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceValidConfiguration(exampleState, cost, gain)
+}
+
+//FallbackName returns "Exchange Resources"
+func (e *ExchangeResources) FallbackName(m *boardgame.GameManager) string {
+	return "Exchange Resources"
+}
+
+//FallbackHelpText returns "Exchanges resources with the bank."
+func (e *ExchangeResources) FallbackHelpText() string {
+	return "Exchanges resources with the bank."
+}
@@ -0,0 +1,122 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/behaviors"
+	"github.com/workfit/tester/assert"
+)
+
+//boardgame:codegen
+type moveBuildRoad struct {
+	PayCost
+}
+
+//Apply pays for the road and then counts it.
+func (m *moveBuildRoad) Apply(state boardgame.State) error {
+	if err := m.PayCost.Apply(state); err != nil {
+		return err
+	}
+	state.PlayerStates()[m.TargetPlayerIndex].(*playerState).Counter++
+	return nil
+}
+
+func resourcesMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(GainResources),
+				WithGain(behaviors.Cost{
+					resourceWood: 3,
+					resourceGold: 2,
+				}),
+			),
+			auto.MustConfig(
+				new(moveBuildRoad),
+				WithMoveName("Build Road"),
+				WithCost(behaviors.Cost{
+					resourceWood:  1,
+					resourceBrick: 1,
+				}),
+			),
+			auto.MustConfig(
+				new(ExchangeResources),
+				WithCost(behaviors.Cost{
+					resourceGold: 2,
+				}),
+				WithGain(behaviors.Cost{
+					resourceBrick: 1,
+				}),
+			),
+		),
+	)
+}
+
+func TestResources(t *testing.T) {
+	manager, err := newGameManager(resourcesMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	propose := func(name string) error {
+		move := game.MoveByName(name)
+		move.DefaultsForState(game.CurrentState())
+		return <-game.ProposeMove(move, 0)
+	}
+
+	assert.For(t).ThatActual(propose("Build Road")).IsNotNil()
+	assert.For(t).ThatActual(propose("Exchange Resources")).IsNotNil()
+
+	assert.For(t).ThatActual(propose("Gain Resources")).IsNil()
+
+	gameState, players := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(players[0].ResourceCount(resourceWood)).Equals(3)
+	assert.For(t).ThatActual(players[0].ResourceCount(resourceGold)).Equals(2)
+	assert.For(t).ThatActual(gameState.BankResourceCount(manager.Delegate().(*gameDelegate), resourceWood)).Equals(1)
+
+	//The bank only has one wood left.
+	assert.For(t).ThatActual(propose("Gain Resources")).IsNotNil()
+
+	//Still no brick.
+	assert.For(t).ThatActual(propose("Build Road")).IsNotNil()
+
+	assert.For(t).ThatActual(propose("Exchange Resources")).IsNil()
+	assert.For(t).ThatActual(propose("Build Road")).IsNil()
+
+	gameState, players = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(players[0].Counter).Equals(1)
+	assert.For(t).ThatActual(players[0].ResourceCount(resourceWood)).Equals(2)
+	assert.For(t).ThatActual(players[0].ResourceCount(resourceBrick)).Equals(0)
+	assert.For(t).ThatActual(players[0].ResourceCount(resourceGold)).Equals(0)
+	assert.For(t).ThatActual(gameState.BankResourceCount(manager.Delegate().(*gameDelegate), resourceWood)).Equals(2)
+	assert.For(t).ThatActual(gameState.BankResourceCount(manager.Delegate().(*gameDelegate), resourceBrick)).Equals(4)
+}
+
+func TestResourcesInvalidCost(t *testing.T) {
+	_, err := newGameManager(func(manager *boardgame.GameManager) []boardgame.MoveConfig {
+		auto := NewAutoConfigurer(manager.Delegate())
+		return Add(
+			auto.MustConfig(
+				new(PayCost),
+				WithCost(behaviors.Cost{
+					resourceWood: -1,
+				}),
+			),
+		)
+	}, false)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+}
@@ -19,6 +19,40 @@ type playerConditionMet interface {
 	PlayerConditionMet(playerState boardgame.ImmutableSubState) bool
 }
 
+//goesNext makes player the current player, for moves like ResolveTrick and
+//ResolveAuction whose outcome decides who goes next. If the GameState also
+//implements interfaces.RoundRobinProperties and no round robin is in progress,
+//player is set as the RoundRobinStarterPlayer, too.
+func goesNext(state boardgame.State, player boardgame.PlayerIndex) error {
+	setter, ok := state.GameState().(interfaces.CurrentPlayerSetter)
+
+	if !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	setter.SetCurrentPlayer(player)
+
+	if roundRobiner, ok := state.GameState().(interfaces.RoundRobinProperties); ok && !roundRobiner.RoundRobinHasStarted() {
+		roundRobiner.SetRoundRobinStarterPlayer(player)
+		roundRobiner.SetRoundRobinLastPlayer(player.Previous(state))
+	}
+
+	return nil
+}
+
+//activePlayerCount returns the number of players who may be active. It's how
+//many cards make a full trick by default, how many players take part in an
+//auction, and how many may react to a move.
+func activePlayerCount(state boardgame.ImmutableState) int {
+	result := 0
+	for i := range state.ImmutablePlayerStates() {
+		if boardgame.PlayerIndex(i).Valid(state) {
+			result++
+		}
+	}
+	return result
+}
+
 /*
 
 RoundRobin is a complicated type of move because a lot of complicated logic
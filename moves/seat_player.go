@@ -12,6 +12,7 @@ import (
 //Note: these are also duplicated in server/api/storage.go
 const playerToSeatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToSeat"
 const willSeatPlayerRendevousDataType = "github.com/jkomoros/boardgame/server/api.WillSeatPlayer"
+const playerToUnseatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToUnseat"
 
 //gameWillSeatPlayer returns true if the game will ever potentially call
 //moves.SeatPlayer or not.
@@ -224,6 +225,136 @@ func (s *SeatPlayer) FallbackName(m *boardgame.GameManager) string {
 	return "Seat Player"
 }
 
+//UnseatPlayer is the inverse of SeatPlayer: a move that marks a filled seat as
+//empty again, because the user sitting in it left the game (or was removed by
+//the game's owner). Like SeatPlayer, it is a special interface point for the
+//server library: the server will propose it when it has a player who has
+//left, as long as it is legal in your game logic at that point. If you do not
+//configure UnseatPlayer (or a move that derives from it) in your game, the
+//server will still remove the user from the seat, but your game logic will
+//not be told.
+//
+//If the player state also implements interfaces.PlayerInactiver (for example
+//because it embeds behaviors.PlayerInactive), the player will also be marked
+//inactive, so your game logic will skip them until someone new is seated and
+//activated.
+//
+//For more on the concept of seats, see the package doc of boardgame/behaviors
+//package.
+//
+//boardgame:codegen
+type UnseatPlayer struct {
+	FixUp
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//IsUnseatPlayerMove returns true. This is a way for moves to signal to other
+//libraries that it's an UnseatPlayer move, even if it isn't literally this
+//move struct but a subclass of it. Implements interfaces.UnseatPlayerMover.
+func (u *UnseatPlayer) IsUnseatPlayerMove() bool {
+	return true
+}
+
+//signaler returns the UnseatPlayerSignaler for the game, if one exists.
+func (u *UnseatPlayer) signaler(state boardgame.ImmutableState) (interfaces.UnseatPlayerSignaler, error) {
+	playerToUnseatGeneric := state.Manager().Storage().FetchInjectedDataForGame(state.Game().ID(), playerToUnseatRendevousDataType)
+	if playerToUnseatGeneric == nil {
+		return nil, errors.New("No player to unseat")
+	}
+	signaler, ok := playerToUnseatGeneric.(interfaces.UnseatPlayerSignaler)
+	if !ok {
+		return nil, errors.New("PlayerToUnseat was not an UnseatPlayerSignaler as expected")
+	}
+	return signaler, nil
+}
+
+//DefaultsForState sets TargetPlayerIndex to the PlayerIndex returned by
+//UnseatPlayerSignaler.
+func (u *UnseatPlayer) DefaultsForState(state boardgame.ImmutableState) {
+	signaler, err := u.signaler(state)
+	if err != nil {
+		return
+	}
+	u.TargetPlayerIndex = signaler.SeatIndex()
+}
+
+//Legal verifies that there is a player to unseat, that TargetPlayerIndex is
+//their seat and is currently filled, and that the proposer is the admin,
+//since only server should propose this move.
+func (u *UnseatPlayer) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := u.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+	signaler, err := u.signaler(state)
+	if err != nil {
+		return err
+	}
+	if proposer != boardgame.AdminPlayerIndex {
+		return errors.New("This move may only be proposed by an admin")
+	}
+	//We explicitly do not do EnsureValid, because the player might be
+	//inactive.
+	targetPlayerIndex := u.TargetPlayerIndex
+	if targetPlayerIndex < 0 || int(targetPlayerIndex) >= len(state.ImmutablePlayerStates()) {
+		return errors.New("TargetPlayerIndex is invalid")
+	}
+	if targetPlayerIndex != signaler.SeatIndex() {
+		return errors.New("TargetPlayerIndex is not the seat of the player to unseat")
+	}
+	seat, ok := state.ImmutablePlayerStates()[targetPlayerIndex].(interfaces.Seater)
+	if !ok {
+		return errors.New("The selected player did not implement interfaces.Seater")
+	}
+	if !seat.SeatIsFilled() {
+		return errors.New("The selected seat is not filled")
+	}
+	return nil
+}
+
+//Apply sets the targeted player's seat to be empty, and if the player state
+//implements interfaces.PlayerInactiver, sets them to be inactive.
+func (u *UnseatPlayer) Apply(state boardgame.State) error {
+
+	//Make sure server will get a signal when the player is unseated.
+	signaler, err := u.signaler(state)
+	if err != nil {
+		return err
+	}
+	state.Manager().Internals().AddCommittedCallback(state, signaler.Committed)
+
+	player := state.ImmutablePlayerStates()[u.TargetPlayerIndex]
+	seat, ok := player.(interfaces.Unseater)
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.Unseater")
+	}
+	seat.SetSeatEmpty()
+	if inactiver, ok := player.(interfaces.PlayerInactiver); ok {
+		inactiver.SetPlayerInactive()
+	}
+	return nil
+}
+
+//ValidConfiguration checks that player states implement interfaces.Unseater
+func (u *UnseatPlayer) ValidConfiguration(exampleState boardgame.State) error {
+	player := exampleState.ImmutablePlayerStates()[0]
+	_, ok := player.(interfaces.Unseater)
+	if !ok {
+		return errors.New("Player state didn't implement interfaces.Unseater. behaviors.Seat implements it for free")
+	}
+	return nil
+}
+
+//FallbackHelpText returns "Marks the seat of a player who left as empty, so
+//they are no longer part of the game"
+func (u *UnseatPlayer) FallbackHelpText() string {
+	return "Marks the seat of a player who left as empty, so they are no longer part of the game"
+}
+
+//FallbackName returns "Unseat Player"
+func (u *UnseatPlayer) FallbackName(m *boardgame.GameManager) string {
+	return "Unseat Player"
+}
+
 //CloseEmptySeat is a move that will go through and repeatedly apply itself to
 //close any seat that is not filled. Typically you put this at the end of a
 //SetUp phase, once all of the players are there who you care to wait for, and
@@ -0,0 +1,607 @@
+package moves
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//sumInts returns the sum of ints.
+func sumInts(ints []int) int {
+	result := 0
+	for _, i := range ints {
+		result += i
+	}
+	return result
+}
+
+//tradeMover is implemented by the trade moves.
+type tradeMover interface {
+	moveInfoer
+	TopLevelStruct() boardgame.Move
+}
+
+//tradeResourceNames returns the names passed to WithTradeResources.
+func tradeResourceNames(m moveInfoer) []string {
+	names, _ := m.CustomConfiguration()[configPropTradeResources].([]string)
+	return names
+}
+
+//tradeStack returns the PlayerStack of player according to m's top level
+//struct, or nil if it doesn't have one.
+func tradeStack(m tradeMover, state boardgame.State, player boardgame.PlayerIndex) boardgame.Stack {
+	playerStacker, ok := m.TopLevelStruct().(interfaces.PlayerStacker)
+	if !ok {
+		return nil
+	}
+	return playerStacker.PlayerStack(state.PlayerStates()[player])
+}
+
+//tradeComponents returns the components at indexes in player's stack, or an
+//error if any index is repeated or doesn't specify a component.
+func tradeComponents(m tradeMover, state boardgame.State, player boardgame.PlayerIndex, indexes []int) ([]boardgame.ComponentInstance, error) {
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+
+	stack := tradeStack(m, state, player)
+
+	if stack == nil {
+		return nil, errors.New("Components can't be traded without a PlayerStack")
+	}
+
+	seen := make(map[int]bool, len(indexes))
+	result := make([]boardgame.ComponentInstance, len(indexes))
+
+	for i, index := range indexes {
+		if seen[index] {
+			return nil, errors.New("Component index " + strconv.Itoa(index) + " was listed more than once")
+		}
+		seen[index] = true
+		c := stack.ComponentAt(index)
+		if c == nil {
+			return nil, errors.New("Component index " + strconv.Itoa(index) + " didn't specify a component")
+		}
+		result[i] = c
+	}
+
+	return result, nil
+}
+
+//tradeResourcesLegal returns an error if counts isn't a valid list of counts
+//of the names resources, or if player doesn't have that many of each.
+func tradeResourcesLegal(m moveInfoer, state boardgame.State, player boardgame.PlayerIndex, counts []int) error {
+	names := tradeResourceNames(m)
+
+	if len(counts) > len(names) {
+		return errors.New("More resource counts were provided than resources were configured")
+	}
+
+	readSetter := state.PlayerStates()[player].ReadSetter()
+
+	for i, count := range counts {
+		if count < 0 {
+			return errors.New("Resource counts may not be negative")
+		}
+		if count == 0 {
+			continue
+		}
+		have, err := readSetter.IntProp(names[i])
+		if err != nil {
+			return errors.New("Couldn't read resource " + names[i] + ": " + err.Error())
+		}
+		if have < count {
+			return errors.New("Player " + player.String() + " doesn't have enough " + names[i])
+		}
+	}
+
+	return nil
+}
+
+//tradeTransferResources moves counts of each resource from one player to
+//another.
+func tradeTransferResources(m moveInfoer, state boardgame.State, from, to boardgame.PlayerIndex, counts []int) error {
+	names := tradeResourceNames(m)
+
+	fromReadSetter := state.PlayerStates()[from].ReadSetter()
+	toReadSetter := state.PlayerStates()[to].ReadSetter()
+
+	for i, count := range counts {
+		if count == 0 {
+			continue
+		}
+		fromValue, err := fromReadSetter.IntProp(names[i])
+		if err != nil {
+			return err
+		}
+		toValue, err := toReadSetter.IntProp(names[i])
+		if err != nil {
+			return err
+		}
+		if err := fromReadSetter.SetIntProp(names[i], fromValue-count); err != nil {
+			return err
+		}
+		if err := toReadSetter.SetIntProp(names[i], toValue+count); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//tradeTransferComponents moves components to the next slot of player's stack.
+func tradeTransferComponents(m tradeMover, state boardgame.State, to boardgame.PlayerIndex, components []boardgame.ComponentInstance) error {
+	if len(components) == 0 {
+		return nil
+	}
+	stack := tradeStack(m, state, to)
+	if stack == nil {
+		return errors.New("Components can't be traded without a PlayerStack")
+	}
+	for _, c := range components {
+		if err := c.MoveToNextSlot(stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//tradeSidesLegal checks that both sides of a trade are still possible: that
+//the component indexes are valid and that each player has the resources they
+//would give.
+func tradeSidesLegal(m tradeMover, state boardgame.State, trade interfaces.TradeProperties) error {
+	proposer := trade.TradeProposingPlayer()
+	recipient := trade.TradeReceivingPlayer()
+
+	if _, err := tradeComponents(m, state, proposer, trade.TradeOfferedComponentIndexes()); err != nil {
+		return err
+	}
+	if _, err := tradeComponents(m, state, recipient, trade.TradeRequestedComponentIndexes()); err != nil {
+		return err
+	}
+	if err := tradeResourcesLegal(m, state, proposer, trade.TradeOfferedResourceCounts()); err != nil {
+		return err
+	}
+	return tradeResourcesLegal(m, state, recipient, trade.TradeRequestedResourceCounts())
+}
+
+//tradeRespondLegal checks that a trade is pending, and that target is the
+//proposer and is the trade's recipient (or, if toProposer is true, the
+//trade's proposer).
+func tradeRespondLegal(state boardgame.ImmutableState, target, proposer boardgame.PlayerIndex, toProposer bool) (interfaces.TradeProperties, error) {
+	if target < 0 || !target.Valid(state) {
+		return nil, errors.New("The specified target player is not valid")
+	}
+
+	if !target.Equivalent(proposer) {
+		return nil, errors.New("You may only respond to trades for yourself")
+	}
+
+	trade, ok := state.ImmutableGameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return nil, errors.New("GameState does not implement TradeProperties")
+	}
+
+	if !trade.TradePending() {
+		return nil, errors.New("There is no pending trade")
+	}
+
+	if toProposer {
+		if trade.TradeProposingPlayer() != target {
+			return nil, errors.New("Only the player who offered the trade may do that")
+		}
+	} else if trade.TradeReceivingPlayer() != target {
+		return nil, errors.New("Only the player the trade was offered to may do that")
+	}
+
+	return trade, nil
+}
+
+//tradeValidConfiguration checks that the GameState implements
+//interfaces.TradeProperties, that PlayerStack returns a stack if
+//WithPlayerProperty was configured, and that every resource named with
+//WithTradeResources is an int property on PlayerState.
+func tradeValidConfiguration(m tradeMover, exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.TradeProperties); !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	if _, ok := m.CustomConfiguration()[configPropPlayerProperty]; ok {
+		if tradeStack(m, exampleState, 0) == nil {
+			return errors.New("PlayerStack returned a nil stack")
+		}
+	}
+
+	readSetter := exampleState.PlayerStates()[0].ReadSetter()
+
+	for _, name := range tradeResourceNames(m) {
+		if _, err := readSetter.IntProp(name); err != nil {
+			return errors.New("Resource " + name + " isn't an int property on PlayerState: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+/*
+
+OfferTrade is a move for negotiation games, where the current player
+(TargetPlayerIndex) offers a trade to Recipient, who may then respond with
+AcceptTrade or DeclineTrade even though it isn't their turn. Until they do,
+the proposer may withdraw the offer with CancelTrade. Only one trade may be
+pending at a time.
+
+The proposer offers the components at OfferedComponents in their PlayerStack
+(configured with WithPlayerProperty) and OfferedResources, and asks for the
+components at RequestedComponents in the Recipient's PlayerStack and
+RequestedResources. Resources are int properties on PlayerState, named with
+WithTradeResources; the counts are in the same order as the names. Leave
+PlayerStack unconfigured to trade only resources, or don't configure any
+resources to trade only components.
+
+Configure AcceptTrade with the same WithPlayerProperty and WithTradeResources.
+Your GameState must implement interfaces.TradeProperties, typically by
+embedding behaviors.PendingTrade.
+
+boardgame:codegen
+*/
+type OfferTrade struct {
+	CurrentPlayer
+	Recipient           boardgame.PlayerIndex
+	OfferedComponents   []int
+	RequestedComponents []int
+	OfferedResources    []int
+	RequestedResources  []int
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the stack traded
+//components come from and go to. If that is not sufficient, override this in
+//your embedding struct.
+func (o *OfferTrade) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(o, playerState)
+}
+
+//DefaultsForState sets TargetPlayerIndex to the current player, and Recipient
+//to the player after them.
+func (o *OfferTrade) DefaultsForState(state boardgame.ImmutableState) {
+	o.CurrentPlayer.DefaultsForState(state)
+	o.Recipient = o.TargetPlayerIndex.Next(state)
+}
+
+//Legal checks that no trade is pending, that Recipient is another valid
+//player, that something is being traded, that the component indexes are
+//valid and that both players have the resources they would give.
+func (o *OfferTrade) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := o.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	trade, ok := state.ImmutableGameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	if trade.TradePending() {
+		return errors.New("There's already a pending trade")
+	}
+
+	if o.Recipient < 0 || !o.Recipient.Valid(state) {
+		return errors.New("The recipient is not a valid player")
+	}
+
+	if o.Recipient == o.TargetPlayerIndex {
+		return errors.New("You can't trade with yourself")
+	}
+
+	if len(o.OfferedComponents)+len(o.RequestedComponents) == 0 && sumInts(o.OfferedResources)+sumInts(o.RequestedResources) == 0 {
+		return errors.New("The trade doesn't include anything")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tradeComponents(o, mState, o.TargetPlayerIndex, o.OfferedComponents); err != nil {
+		return err
+	}
+	if _, err := tradeComponents(o, mState, o.Recipient, o.RequestedComponents); err != nil {
+		return err
+	}
+	if err := tradeResourcesLegal(o, mState, o.TargetPlayerIndex, o.OfferedResources); err != nil {
+		return err
+	}
+	return tradeResourcesLegal(o, mState, o.Recipient, o.RequestedResources)
+}
+
+//Apply records the offer via OpenTrade.
+func (o *OfferTrade) Apply(state boardgame.State) error {
+	trade, ok := state.GameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	trade.OpenTrade(o.TargetPlayerIndex, o.Recipient,
+		append([]int{}, o.OfferedComponents...),
+		append([]int{}, o.RequestedComponents...),
+		append([]int{}, o.OfferedResources...),
+		append([]int{}, o.RequestedResources...),
+	)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TradeProperties, that PlayerStack returns a stack if
+//WithPlayerProperty was used, and that the resources passed to
+//WithTradeResources are int properties on PlayerState.
+func (o *OfferTrade) ValidConfiguration(exampleState boardgame.State) error {
+	if err := o.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return tradeValidConfiguration(o, exampleState)
+}
+
+//FallbackName returns "Offer Trade"
+func (o *OfferTrade) FallbackName(m *boardgame.GameManager) string {
+	return "Offer Trade"
+}
+
+//FallbackHelpText returns "Offers a trade to another player."
+func (o *OfferTrade) FallbackHelpText() string {
+	return "Offers a trade to another player."
+}
+
+/*
+
+AcceptTrade is a move for the recipient of a trade offered with OfferTrade
+(TargetPlayerIndex) to accept it, even though it isn't their turn. It
+exchanges the components and resources all at once, failing without changing
+anything if either player no longer has what they would give. Configure it
+with the same WithPlayerProperty and WithTradeResources as OfferTrade.
+
+boardgame:codegen
+*/
+type AcceptTrade struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. If that is not sufficient,
+//override this in your embedding struct.
+func (a *AcceptTrade) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(a, playerState)
+}
+
+//DefaultsForState sets TargetPlayerIndex to the recipient of the pending
+//trade.
+func (a *AcceptTrade) DefaultsForState(state boardgame.ImmutableState) {
+	if trade, ok := state.ImmutableGameState().(interfaces.TradeProperties); ok {
+		a.TargetPlayerIndex = trade.TradeReceivingPlayer()
+	}
+}
+
+//Legal checks that a trade is pending, that TargetPlayerIndex is the proposer
+//and the trade's recipient, and that both sides of the trade are still
+//possible.
+func (a *AcceptTrade) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := a.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	trade, err := tradeRespondLegal(state, a.TargetPlayerIndex, proposer, false)
+
+	if err != nil {
+		return err
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	return tradeSidesLegal(a, mState, trade)
+}
+
+//Apply exchanges the offered and requested components and resources, and
+//closes the trade.
+func (a *AcceptTrade) Apply(state boardgame.State) error {
+	trade, ok := state.GameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	if err := tradeSidesLegal(a, state, trade); err != nil {
+		return err
+	}
+
+	proposer := trade.TradeProposingPlayer()
+	recipient := trade.TradeReceivingPlayer()
+
+	//Find every component before moving any, since moving them shifts the
+	//indexes.
+	offered, err := tradeComponents(a, state, proposer, trade.TradeOfferedComponentIndexes())
+	if err != nil {
+		return err
+	}
+	requested, err := tradeComponents(a, state, recipient, trade.TradeRequestedComponentIndexes())
+	if err != nil {
+		return err
+	}
+
+	if err := tradeTransferComponents(a, state, recipient, offered); err != nil {
+		return errors.New("Couldn't give offered components: " + err.Error())
+	}
+	if err := tradeTransferComponents(a, state, proposer, requested); err != nil {
+		return errors.New("Couldn't give requested components: " + err.Error())
+	}
+	if err := tradeTransferResources(a, state, proposer, recipient, trade.TradeOfferedResourceCounts()); err != nil {
+		return errors.New("Couldn't give offered resources: " + err.Error())
+	}
+	if err := tradeTransferResources(a, state, recipient, proposer, trade.TradeRequestedResourceCounts()); err != nil {
+		return errors.New("Couldn't give requested resources: " + err.Error())
+	}
+
+	trade.CloseTrade()
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TradeProperties, that PlayerStack returns a stack if
+//WithPlayerProperty was used, and that the resources passed to
+//WithTradeResources are int properties on PlayerState.
+func (a *AcceptTrade) ValidConfiguration(exampleState boardgame.State) error {
+	if err := a.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return tradeValidConfiguration(a, exampleState)
+}
+
+//FallbackName returns "Accept Trade"
+func (a *AcceptTrade) FallbackName(m *boardgame.GameManager) string {
+	return "Accept Trade"
+}
+
+//FallbackHelpText returns "Accepts the trade offered to you."
+func (a *AcceptTrade) FallbackHelpText() string {
+	return "Accepts the trade offered to you."
+}
+
+/*
+
+DeclineTrade is a move for the recipient of a trade offered with OfferTrade
+(TargetPlayerIndex) to turn it down, even though it isn't their turn.
+
+boardgame:codegen
+*/
+type DeclineTrade struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//DefaultsForState sets TargetPlayerIndex to the recipient of the pending
+//trade.
+func (d *DeclineTrade) DefaultsForState(state boardgame.ImmutableState) {
+	if trade, ok := state.ImmutableGameState().(interfaces.TradeProperties); ok {
+		d.TargetPlayerIndex = trade.TradeReceivingPlayer()
+	}
+}
+
+//Legal checks that a trade is pending and that TargetPlayerIndex is the
+//proposer and the trade's recipient.
+func (d *DeclineTrade) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := d.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+	_, err := tradeRespondLegal(state, d.TargetPlayerIndex, proposer, false)
+	return err
+}
+
+//Apply closes the trade without exchanging anything.
+func (d *DeclineTrade) Apply(state boardgame.State) error {
+	trade, ok := state.GameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	trade.CloseTrade()
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TradeProperties.
+func (d *DeclineTrade) ValidConfiguration(exampleState boardgame.State) error {
+	if err := d.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	if _, ok := exampleState.GameState().(interfaces.TradeProperties); !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+	return nil
+}
+
+//FallbackName returns "Decline Trade"
+func (d *DeclineTrade) FallbackName(m *boardgame.GameManager) string {
+	return "Decline Trade"
+}
+
+//FallbackHelpText returns "Declines the trade offered to you."
+func (d *DeclineTrade) FallbackHelpText() string {
+	return "Declines the trade offered to you."
+}
+
+/*
+
+CancelTrade is a move for the player who offered a trade with OfferTrade
+(TargetPlayerIndex) to withdraw it before it's accepted or declined.
+
+boardgame:codegen
+*/
+type CancelTrade struct {
+	Default
+	TargetPlayerIndex boardgame.PlayerIndex
+}
+
+//DefaultsForState sets TargetPlayerIndex to the proposer of the pending
+//trade.
+func (c *CancelTrade) DefaultsForState(state boardgame.ImmutableState) {
+	if trade, ok := state.ImmutableGameState().(interfaces.TradeProperties); ok {
+		c.TargetPlayerIndex = trade.TradeProposingPlayer()
+	}
+}
+
+//Legal checks that a trade is pending and that TargetPlayerIndex is the
+//proposer and offered the trade.
+func (c *CancelTrade) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := c.Default.Legal(state, proposer); err != nil {
+		return err
+	}
+	_, err := tradeRespondLegal(state, c.TargetPlayerIndex, proposer, true)
+	return err
+}
+
+//Apply closes the trade without exchanging anything.
+func (c *CancelTrade) Apply(state boardgame.State) error {
+	trade, ok := state.GameState().(interfaces.TradeProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+
+	trade.CloseTrade()
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TradeProperties.
+func (c *CancelTrade) ValidConfiguration(exampleState boardgame.State) error {
+	if err := c.Default.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	if _, ok := exampleState.GameState().(interfaces.TradeProperties); !ok {
+		return errors.New("GameState does not implement TradeProperties")
+	}
+	return nil
+}
+
+//FallbackName returns "Cancel Trade"
+func (c *CancelTrade) FallbackName(m *boardgame.GameManager) string {
+	return "Cancel Trade"
+}
+
+//FallbackHelpText returns "Withdraws the trade you offered."
+func (c *CancelTrade) FallbackHelpText() string {
+	return "Withdraws the trade you offered."
+}
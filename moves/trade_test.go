@@ -0,0 +1,136 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/components/playingcards"
+	"github.com/workfit/tester/assert"
+)
+
+func tradeMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(DealCountComponents),
+				WithGameProperty("DrawStack"),
+				WithPlayerProperty("Hand"),
+				WithTargetCount(2),
+			),
+			auto.MustConfig(
+				new(Increment),
+				WithPlayerProperty("Wood"),
+				WithAmount(3),
+			),
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(OfferTrade),
+				WithPlayerProperty("Hand"),
+				WithTradeResources("Wood", "Brick"),
+			),
+			auto.MustConfig(
+				new(AcceptTrade),
+				WithPlayerProperty("Hand"),
+				WithTradeResources("Wood", "Brick"),
+			),
+			auto.MustConfig(
+				new(DeclineTrade),
+			),
+			auto.MustConfig(
+				new(CancelTrade),
+			),
+		),
+	)
+}
+
+func TestTrade(t *testing.T) {
+	manager, err := newGameManager(tradeMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	_, players := concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(players[0].Wood).Equals(3)
+	assert.For(t).ThatActual(players[0].Hand.NumComponents()).Equals(2)
+
+	offer := func(proposer, recipient boardgame.PlayerIndex, offered, requested, offeredResources, requestedResources []int) error {
+		move := game.MoveByName("Offer Trade").(*OfferTrade)
+		move.TargetPlayerIndex = proposer
+		move.Recipient = recipient
+		move.OfferedComponents = offered
+		move.RequestedComponents = requested
+		move.OfferedResources = offeredResources
+		move.RequestedResources = requestedResources
+		return <-game.ProposeMove(move, proposer)
+	}
+
+	respond := func(name string, player boardgame.PlayerIndex) error {
+		move := game.MoveByName(name)
+		move.DefaultsForState(game.CurrentState())
+		return <-game.ProposeMove(move, player)
+	}
+
+	//Only the current player may offer, and only what they have.
+	assert.For(t).ThatActual(offer(1, 0, []int{0}, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 0, []int{0}, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 1, nil, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 1, []int{0, 0}, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 1, []int{5}, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 1, nil, nil, []int{4}, nil)).IsNotNil()
+	assert.For(t).ThatActual(offer(0, 1, nil, nil, nil, []int{0, 1})).IsNotNil()
+
+	assert.For(t).ThatActual(offer(0, 1, []int{0}, nil, []int{1}, nil)).IsNil()
+
+	gameState, _ := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.TradePending()).IsTrue()
+
+	//Only one trade at a time, and only the recipient may respond.
+	assert.For(t).ThatActual(offer(0, 2, []int{1}, nil, nil, nil)).IsNotNil()
+	assert.For(t).ThatActual(respond("Accept Trade", 2)).IsNotNil()
+	assert.For(t).ThatActual(respond("Cancel Trade", 1)).IsNotNil()
+
+	assert.For(t).ThatActual(respond("Decline Trade", 1)).IsNil()
+
+	gameState, players = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(gameState.TradePending()).IsFalse()
+	assert.For(t).ThatActual(players[0].Hand.NumComponents()).Equals(2)
+
+	assert.For(t).ThatActual(offer(0, 2, []int{1}, nil, nil, nil)).IsNil()
+	assert.For(t).ThatActual(respond("Cancel Trade", 0)).IsNil()
+
+	//Player 0 has the Ace and 5 of spades, and player 1 the 2 and 6.
+	assert.For(t).ThatActual(offer(0, 1, []int{0}, []int{1}, []int{2}, nil)).IsNil()
+	assert.For(t).ThatActual(respond("Accept Trade", 1)).IsNil()
+
+	gameState, players = concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(gameState.TradePending()).IsFalse()
+	assert.For(t).ThatActual(players[0].Wood).Equals(1)
+	assert.For(t).ThatActual(players[1].Wood).Equals(2)
+	assert.For(t).ThatActual(cardIndex(players[0].Hand, playingcards.SuitSpades, playingcards.RankAce)).Equals(-1)
+	assert.For(t).ThatActual(cardIndex(players[0].Hand, playingcards.SuitSpades, playingcards.Rank6)).DoesNotEqual(-1)
+	assert.For(t).ThatActual(cardIndex(players[1].Hand, playingcards.SuitSpades, playingcards.RankAce)).DoesNotEqual(-1)
+	assert.For(t).ThatActual(cardIndex(players[1].Hand, playingcards.SuitSpades, playingcards.Rank6)).Equals(-1)
+
+	//Gifts are fine, but the recipient must have what's asked for.
+	assert.For(t).ThatActual(offer(0, 1, nil, nil, []int{1}, nil)).IsNil()
+	assert.For(t).ThatActual(respond("Accept Trade", 1)).IsNil()
+
+	_, players = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(players[0].Wood).Equals(0)
+	assert.For(t).ThatActual(players[1].Wood).Equals(3)
+
+	assert.For(t).ThatActual(offer(0, 1, nil, []int{0}, nil, []int{0, 1})).IsNotNil()
+}
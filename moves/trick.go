@@ -0,0 +1,675 @@
+package moves
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//trickSizer is implemented by PlayCardToTrick and ResolveTrick. Like
+//roundRobinStarterPlayer we keep it private because embedders already have it.
+type trickSizer interface {
+	TrickSize(state boardgame.ImmutableState) int
+}
+
+func gameStackFromConfig(m moveInfoer, gameState boardgame.SubState) boardgame.Stack {
+	config := m.CustomConfiguration()
+
+	stackName, ok := config[configPropGameProperty]
+
+	if !ok {
+		return nil
+	}
+
+	strStackName, ok := stackName.(string)
+
+	if !ok {
+		return nil
+	}
+
+	stack, err := gameState.ReadSetter().StackProp(strStackName)
+
+	if err != nil {
+		return nil
+	}
+
+	return stack
+}
+
+func playerStackFromConfig(m moveInfoer, playerState boardgame.SubState) boardgame.Stack {
+	config := m.CustomConfiguration()
+
+	stackName, ok := config[configPropPlayerProperty]
+
+	if !ok {
+		return nil
+	}
+
+	strStackName, ok := stackName.(string)
+
+	if !ok {
+		return nil
+	}
+
+	stack, err := playerState.ReadSetter().StackProp(strStackName)
+
+	if err != nil {
+		return nil
+	}
+
+	return stack
+}
+
+//trickCardSuit returns the TrickSuit of c's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func trickCardSuit(c boardgame.ImmutableComponentInstance) int {
+	if c == nil {
+		return -1
+	}
+	card, ok := c.Values().(interfaces.TrickCard)
+	if !ok {
+		return -1
+	}
+	return card.TrickSuit()
+}
+
+//trickCardRank returns the TrickRank of c's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func trickCardRank(c boardgame.ImmutableComponentInstance) int {
+	if c == nil {
+		return -1
+	}
+	card, ok := c.Values().(interfaces.TrickCard)
+	if !ok {
+		return -1
+	}
+	return card.TrickRank()
+}
+
+//trickStacksValidConfiguration verifies the shared configuration of
+//PlayCardToTrick and ResolveTrick.
+func trickStacksValidConfiguration(topLevelStruct boardgame.Move, exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.TrickProperties); !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	playerStacker, ok := topLevelStruct.(interfaces.PlayerStacker)
+
+	if !ok {
+		return errors.New("Embedding move doesn't implement PlayerStacker")
+	}
+
+	if playerStacker.PlayerStack(exampleState.PlayerStates()[0]) == nil {
+		return errors.New("PlayerStack returned a nil stack")
+	}
+
+	gameStacker, ok := topLevelStruct.(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("Embedding move doesn't implement GameStacker")
+	}
+
+	if gameStacker.GameStack(exampleState.GameState()) == nil {
+		return errors.New("GameStack returned a nil stack")
+	}
+
+	if _, ok := topLevelStruct.(interfaces.TrickCardRanker); !ok {
+		return errors.New("Embedding move doesn't implement TrickCardRanker")
+	}
+
+	return nil
+}
+
+/*
+
+PlayCardToTrick is a move for trick-taking games where the current player plays
+the card at ComponentIndex in their hand (PlayerStack, configured with
+WithPlayerProperty) to the trick (GameStack, configured with WithGameProperty).
+
+The first card played to a trick sets TrickLedSuit and TrickLeadPlayer on your
+GameState, which must implement interfaces.TrickProperties (typically by
+embedding behaviors.Trick). Every later card must satisfy FollowSuitLegal,
+which by default requires following the led suit if the player can. After the
+card is played, the current player is advanced to the next player, unless the
+trick is now full, in which case ResolveTrick is expected to apply next. That
+means your GameState must also implement interfaces.CurrentPlayerSetter.
+
+Cards' suits and ranks come from CardSuit and CardRank, which by default
+defer to interfaces.TrickCard on the components' values.
+
+boardgame:codegen
+*/
+type PlayCardToTrick struct {
+	CurrentPlayer
+	ComponentIndex int
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the hand cards are played
+//from. If that is not sufficient, override this in your embedding struct.
+func (p *PlayCardToTrick) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(p, playerState)
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the trick cards are played to. If
+//that is not sufficient, override this in your embedding struct.
+func (p *PlayCardToTrick) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(p, gameState)
+}
+
+//TrickSize returns how many cards make up a full trick. By default it's the
+//number of players who may be active.
+func (p *PlayCardToTrick) TrickSize(state boardgame.ImmutableState) int {
+	return activePlayerCount(state)
+}
+
+//CardSuit returns the TrickSuit of the card's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func (p *PlayCardToTrick) CardSuit(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int {
+	return trickCardSuit(c)
+}
+
+//CardRank returns the TrickRank of the card's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func (p *PlayCardToTrick) CardRank(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int {
+	return trickCardRank(c)
+}
+
+//FollowSuitLegal returns nil if card leads the trick, is of the led suit, or if
+//there are no cards of the led suit in hand. Override it if your game has
+//different rules, for example if players must trump when they can't follow.
+func (p *PlayCardToTrick) FollowSuitLegal(state boardgame.ImmutableState, hand boardgame.ImmutableStack, card boardgame.ImmutableComponentInstance) error {
+	trick, ok := state.ImmutableGameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	if len(trick.TrickCardPlayers()) == 0 {
+		return nil
+	}
+
+	ranker, ok := p.TopLevelStruct().(interfaces.TrickCardRanker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement TrickCardRanker")
+	}
+
+	ledSuit := trick.TrickLedSuit()
+
+	if ranker.CardSuit(state, card) == ledSuit {
+		return nil
+	}
+
+	for _, c := range hand.ImmutableComponents() {
+		if c == nil {
+			continue
+		}
+		if ranker.CardSuit(state, c) == ledSuit {
+			return errors.New("you must follow suit")
+		}
+	}
+
+	return nil
+}
+
+//hand returns the current player's PlayerStack.
+func (p *PlayCardToTrick) hand(state boardgame.State) (boardgame.Stack, error) {
+	playerStacker, ok := p.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return nil, errors.New("The top level struct unexpectedly didn't implement PlayerStacker")
+	}
+
+	player := p.TargetPlayerIndex.EnsureValid(state)
+
+	if player < 0 || int(player) >= len(state.PlayerStates()) {
+		return nil, errors.New("The specified target player is not valid")
+	}
+
+	hand := playerStacker.PlayerStack(state.PlayerStates()[player])
+
+	if hand == nil {
+		return nil, errors.New("PlayerStack returned a nil stack")
+	}
+
+	return hand, nil
+}
+
+//DefaultsForState sets TargetPlayerIndex to the current player, and
+//ComponentIndex to the first card in their hand that FollowSuitLegal allows.
+func (p *PlayCardToTrick) DefaultsForState(state boardgame.ImmutableState) {
+	p.CurrentPlayer.DefaultsForState(state)
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return
+	}
+
+	hand, err := p.hand(mState)
+
+	if err != nil {
+		return
+	}
+
+	enforcer, ok := p.TopLevelStruct().(interfaces.FollowSuitEnforcer)
+
+	if !ok {
+		return
+	}
+
+	for i, c := range hand.ImmutableComponents() {
+		if c == nil {
+			continue
+		}
+		if enforcer.FollowSuitLegal(state, hand, c) != nil {
+			continue
+		}
+		p.ComponentIndex = i
+		return
+	}
+}
+
+//Legal checks CurrentPlayer.Legal, that the trick isn't already full, that
+//ComponentIndex is a card in the player's hand, and that FollowSuitLegal
+//returns nil for it.
+func (p *PlayCardToTrick) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := p.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	trick, ok := state.ImmutableGameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	sizer, ok := p.TopLevelStruct().(trickSizer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have TrickSize")
+	}
+
+	if len(trick.TrickCardPlayers()) >= sizer.TrickSize(state) {
+		return errors.New("The trick is already full")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	hand, err := p.hand(mState)
+
+	if err != nil {
+		return err
+	}
+
+	c := hand.ComponentAt(p.ComponentIndex)
+
+	if c == nil {
+		return errors.New("ComponentIndex didn't specify a card in hand")
+	}
+
+	enforcer, ok := p.TopLevelStruct().(interfaces.FollowSuitEnforcer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement FollowSuitEnforcer")
+	}
+
+	return enforcer.FollowSuitLegal(state, hand, c)
+}
+
+//Apply moves the card to the trick, records who played it (and the led suit
+//and lead player if it's the first card), and advances the current player if
+//the trick isn't full yet.
+func (p *PlayCardToTrick) Apply(state boardgame.State) error {
+	trick, ok := state.GameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	gameStacker, ok := p.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement GameStacker")
+	}
+
+	trickStack := gameStacker.GameStack(state.GameState())
+
+	if trickStack == nil {
+		return errors.New("GameStack returned a nil stack")
+	}
+
+	hand, err := p.hand(state)
+
+	if err != nil {
+		return err
+	}
+
+	c := hand.ComponentAt(p.ComponentIndex)
+
+	if c == nil {
+		return errors.New("ComponentIndex didn't specify a card in hand")
+	}
+
+	player := p.TargetPlayerIndex.EnsureValid(state)
+
+	players := trick.TrickCardPlayers()
+
+	if len(players) == 0 {
+		ranker, ok := p.TopLevelStruct().(interfaces.TrickCardRanker)
+		if !ok {
+			return errors.New("The top level struct unexpectedly didn't implement TrickCardRanker")
+		}
+		trick.SetTrickLeadPlayer(player)
+		trick.SetTrickLedSuit(ranker.CardSuit(state, c))
+	}
+
+	if err := c.MoveToNextSlot(trickStack); err != nil {
+		return errors.New("Couldn't move card to trick: " + err.Error())
+	}
+
+	players = append(append([]boardgame.PlayerIndex{}, players...), player)
+
+	trick.SetTrickCardPlayers(players)
+
+	sizer, ok := p.TopLevelStruct().(trickSizer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have TrickSize")
+	}
+
+	if len(players) >= sizer.TrickSize(state) {
+		//ResolveTrick will pick who goes next.
+		return nil
+	}
+
+	setter, ok := state.GameState().(interfaces.CurrentPlayerSetter)
+
+	if !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	setter.SetCurrentPlayer(player.Next(state))
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TrickProperties and interfaces.CurrentPlayerSetter, and that
+//PlayerStack and GameStack return non-nil stacks.
+func (p *PlayCardToTrick) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	if _, ok := exampleState.GameState().(interfaces.CurrentPlayerSetter); !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	if _, ok := p.TopLevelStruct().(interfaces.FollowSuitEnforcer); !ok {
+		return errors.New("Embedding move doesn't implement FollowSuitEnforcer")
+	}
+
+	return trickStacksValidConfiguration(p.TopLevelStruct(), exampleState)
+}
+
+//FallbackName returns "Play Card To Trick"
+func (p *PlayCardToTrick) FallbackName(m *boardgame.GameManager) string {
+	return "Play Card To Trick"
+}
+
+//FallbackHelpText returns "Plays a card from the current player's hand to the
+//trick."
+func (p *PlayCardToTrick) FallbackHelpText() string {
+	return "Plays a card from the current player's hand to the trick."
+}
+
+/*
+
+ResolveTrick is a fix up move for trick-taking games that applies once the
+trick (GameStack, configured with WithGameProperty) is full. It determines the
+winner--the highest card of TrumpSuit if any were played, otherwise the highest
+card of the led suit--and moves every card in the trick to the winner's won
+pile (PlayerStack, configured with WithPlayerProperty).
+
+The winner leads the next trick: ResolveTrick sets TrickLeadPlayer and the
+current player to them, so your GameState must implement
+interfaces.CurrentPlayerSetter as well as interfaces.TrickProperties. If your
+GameState also implements interfaces.RoundRobinProperties and no round robin is
+in progress, the winner is set as RoundRobinStarterPlayer, too. RoundRobin moves
+start with the current player by default, so a round robin that follows (for
+example to deal the next hand) will start with the winner.
+
+By default there is no trump; override TrumpSuit on your embedding move to
+change that.
+
+boardgame:codegen
+*/
+type ResolveTrick struct {
+	FixUp
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the pile won tricks are
+//moved to. If that is not sufficient, override this in your embedding struct.
+func (r *ResolveTrick) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(r, playerState)
+}
+
+//GameStack by default returns the property on GameState with the name passed
+//to auto.Config by WithGameProperty. It's the trick. If that is not
+//sufficient, override this in your embedding struct.
+func (r *ResolveTrick) GameStack(gameState boardgame.SubState) boardgame.Stack {
+	return gameStackFromConfig(r, gameState)
+}
+
+//TrickSize returns how many cards make up a full trick. By default it's the
+//number of players who may be active.
+func (r *ResolveTrick) TrickSize(state boardgame.ImmutableState) int {
+	return activePlayerCount(state)
+}
+
+//CardSuit returns the TrickSuit of the card's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func (r *ResolveTrick) CardSuit(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int {
+	return trickCardSuit(c)
+}
+
+//CardRank returns the TrickRank of the card's values, or -1 if they don't
+//implement interfaces.TrickCard.
+func (r *ResolveTrick) CardRank(state boardgame.ImmutableState, c boardgame.ImmutableComponentInstance) int {
+	return trickCardRank(c)
+}
+
+//TrumpSuit returns -1, meaning there is no trump. Override it if your game
+//has a trump suit.
+func (r *ResolveTrick) TrumpSuit(state boardgame.ImmutableState) int {
+	return -1
+}
+
+//TrickWinner returns the player who played the winning card in the current
+//trick.
+func (r *ResolveTrick) TrickWinner(state boardgame.ImmutableState) (boardgame.PlayerIndex, error) {
+	trick, ok := state.ImmutableGameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return boardgame.ObserverPlayerIndex, errors.New("GameState does not implement TrickProperties")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return boardgame.ObserverPlayerIndex, err
+	}
+
+	gameStacker, ok := r.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return boardgame.ObserverPlayerIndex, errors.New("The top level struct unexpectedly didn't implement GameStacker")
+	}
+
+	trickStack := gameStacker.GameStack(mState.GameState())
+
+	if trickStack == nil {
+		return boardgame.ObserverPlayerIndex, errors.New("GameStack returned a nil stack")
+	}
+
+	ranker, ok := r.TopLevelStruct().(interfaces.TrickCardRanker)
+
+	if !ok {
+		return boardgame.ObserverPlayerIndex, errors.New("The top level struct unexpectedly didn't implement TrickCardRanker")
+	}
+
+	trumper, ok := r.TopLevelStruct().(interfaces.TrumpSuiter)
+
+	if !ok {
+		return boardgame.ObserverPlayerIndex, errors.New("The top level struct unexpectedly didn't implement TrumpSuiter")
+	}
+
+	players := trick.TrickCardPlayers()
+	cards := trickStack.ImmutableComponents()
+
+	if len(cards) == 0 {
+		return boardgame.ObserverPlayerIndex, errors.New("There are no cards in the trick")
+	}
+
+	if len(cards) != len(players) {
+		return boardgame.ObserverPlayerIndex, errors.New("The cards in the trick don't match who played them")
+	}
+
+	trump := trumper.TrumpSuit(state)
+
+	winner := 0
+	winnerSuit := ranker.CardSuit(state, cards[0])
+	winnerRank := ranker.CardRank(state, cards[0])
+
+	for i, c := range cards {
+		if i == 0 {
+			continue
+		}
+		suit := ranker.CardSuit(state, c)
+		rank := ranker.CardRank(state, c)
+
+		beats := false
+
+		if suit == winnerSuit {
+			beats = rank > winnerRank
+		} else if trump >= 0 && suit == trump {
+			//winnerSuit isn't trump, or we would have hit the case above.
+			beats = true
+		}
+
+		if beats {
+			winner = i
+			winnerSuit = suit
+			winnerRank = rank
+		}
+	}
+
+	return players[winner], nil
+}
+
+//Legal returns nil once the trick has TrickSize cards in it.
+func (r *ResolveTrick) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	trick, ok := state.ImmutableGameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	sizer, ok := r.TopLevelStruct().(trickSizer)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't have TrickSize")
+	}
+
+	if len(trick.TrickCardPlayers()) < sizer.TrickSize(state) {
+		return errors.New("The trick isn't full yet")
+	}
+
+	return nil
+}
+
+//Apply moves the trick to the winner's PlayerStack, resets the trick, and
+//makes the winner the lead player and current player.
+func (r *ResolveTrick) Apply(state boardgame.State) error {
+	winner, err := r.TrickWinner(state)
+
+	if err != nil {
+		return errors.New("Couldn't determine trick winner: " + err.Error())
+	}
+
+	gameStacker, ok := r.TopLevelStruct().(interfaces.GameStacker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement GameStacker")
+	}
+
+	playerStacker, ok := r.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return errors.New("The top level struct unexpectedly didn't implement PlayerStacker")
+	}
+
+	trickStack := gameStacker.GameStack(state.GameState())
+	wonStack := playerStacker.PlayerStack(state.PlayerStates()[winner])
+
+	if trickStack == nil || wonStack == nil {
+		return errors.New("GameStack or PlayerStack returned a nil stack")
+	}
+
+	for trickStack.NumComponents() > 0 {
+		if err := trickStack.First().MoveToNextSlot(wonStack); err != nil {
+			return errors.New("Couldn't move card to won pile: " + err.Error())
+		}
+	}
+
+	trick, ok := state.GameState().(interfaces.TrickProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement TrickProperties")
+	}
+
+	trick.SetTrickCardPlayers(nil)
+	trick.SetTrickLeadPlayer(winner)
+
+	return goesNext(state, winner)
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.TrickProperties and interfaces.CurrentPlayerSetter, and that
+//PlayerStack and GameStack return non-nil stacks.
+func (r *ResolveTrick) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+
+	if _, ok := exampleState.GameState().(interfaces.CurrentPlayerSetter); !ok {
+		return errors.New("GameState does not implement CurrentPlayerSetter")
+	}
+
+	if _, ok := r.TopLevelStruct().(interfaces.TrumpSuiter); !ok {
+		return errors.New("Embedding move doesn't implement TrumpSuiter")
+	}
+
+	return trickStacksValidConfiguration(r.TopLevelStruct(), exampleState)
+}
+
+//FallbackName returns "Resolve Trick"
+func (r *ResolveTrick) FallbackName(m *boardgame.GameManager) string {
+	return "Resolve Trick"
+}
+
+//FallbackHelpText returns "Gives the trick to whoever played the winning
+//card, who leads the next trick."
+func (r *ResolveTrick) FallbackHelpText() string {
+	return "Gives the trick to whoever played the winning card, who leads the next trick."
+}
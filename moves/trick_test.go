@@ -0,0 +1,190 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/components/playingcards"
+	"github.com/workfit/tester/assert"
+)
+
+//boardgame:codegen
+type moveResolveTrickHeartsTrump struct {
+	ResolveTrick
+}
+
+func (m *moveResolveTrickHeartsTrump) TrumpSuit(state boardgame.ImmutableState) int {
+	return playingcards.SuitHearts
+}
+
+func trickMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(DealCountComponents),
+				WithGameProperty("DrawStack"),
+				WithPlayerProperty("Hand"),
+				WithTargetCount(4),
+			),
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(PlayCardToTrick),
+				WithGameProperty("TrickStack"),
+				WithPlayerProperty("Hand"),
+			),
+			auto.MustConfig(
+				new(ResolveTrick),
+				WithGameProperty("TrickStack"),
+				WithPlayerProperty("WonCards"),
+			),
+		),
+		AddForPhase(phaseDrawAgain,
+			auto.MustConfig(
+				new(moveResolveTrickHeartsTrump),
+				WithMoveName("Resolve Trick Hearts Trump"),
+				WithGameProperty("TrickStack"),
+				WithPlayerProperty("WonCards"),
+			),
+		),
+	)
+}
+
+//cardIndex returns the index of the card with the given suit and rank in
+//stack, or -1.
+func cardIndex(stack boardgame.ImmutableStack, suit, rank int) int {
+	for i, c := range stack.ImmutableComponents() {
+		card := c.Values().(*playingcards.Card)
+		if card.Suit.Value() == suit && card.Rank.Value() == rank {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestTrick(t *testing.T) {
+	manager, err := newGameManager(trickMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	_, players := concreteStates(game.CurrentState())
+
+	//Cards are dealt in canonical order, so every player has spades, and
+	//players 1 through 3 also have a heart.
+	heartIndex := cardIndex(players[1].Hand, playingcards.SuitHearts, playingcards.RankAce)
+	assert.For(t).ThatActual(heartIndex).DoesNotEqual(-1)
+
+	play := func(player boardgame.PlayerIndex, suit, rank int) error {
+		_, players := concreteStates(game.CurrentState())
+		move := game.MoveByName("Play Card To Trick").(*PlayCardToTrick)
+		move.TargetPlayerIndex = player
+		move.ComponentIndex = cardIndex(players[player].Hand, suit, rank)
+		return <-game.ProposeMove(move, player)
+	}
+
+	assert.For(t).ThatActual(play(0, playingcards.SuitSpades, playingcards.Rank5)).IsNil()
+
+	gameState, _ := concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(gameState.TrickLeadPlayer()).Equals(boardgame.PlayerIndex(0))
+	assert.For(t).ThatActual(gameState.TrickLedSuit()).Equals(playingcards.SuitSpades)
+	assert.For(t).ThatActual(gameState.CurrentPlayer).Equals(boardgame.PlayerIndex(1))
+
+	//Player 1 still has spades, so may not play their heart.
+	assert.For(t).ThatActual(play(1, playingcards.SuitHearts, playingcards.RankAce)).IsNotNil()
+
+	assert.For(t).ThatActual(play(1, playingcards.SuitSpades, playingcards.Rank2)).IsNil()
+	assert.For(t).ThatActual(play(2, playingcards.SuitSpades, playingcards.Rank3)).IsNil()
+	assert.For(t).ThatActual(play(3, playingcards.SuitSpades, playingcards.Rank8)).IsNil()
+
+	//ResolveTrick should have applied as a fix up.
+	gameState, players = concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(gameState.TrickStack.NumComponents()).Equals(0)
+	assert.For(t).ThatActual(len(gameState.TrickCardPlayers())).Equals(0)
+	assert.For(t).ThatActual(players[3].WonCards.NumComponents()).Equals(4)
+	assert.For(t).ThatActual(gameState.CurrentPlayer).Equals(boardgame.PlayerIndex(3))
+	assert.For(t).ThatActual(gameState.TrickLeadPlayer()).Equals(boardgame.PlayerIndex(3))
+	assert.For(t).ThatActual(gameState.RoundRobinStarterPlayer()).Equals(boardgame.PlayerIndex(3))
+
+	//The winner leads, and Aces are high.
+	assert.For(t).ThatActual(play(0, playingcards.SuitSpades, playingcards.RankAce)).IsNotNil()
+	assert.For(t).ThatActual(play(3, playingcards.SuitSpades, playingcards.Rank4)).IsNil()
+	assert.For(t).ThatActual(play(0, playingcards.SuitSpades, playingcards.RankAce)).IsNil()
+	assert.For(t).ThatActual(play(1, playingcards.SuitSpades, playingcards.Rank6)).IsNil()
+	assert.For(t).ThatActual(play(2, playingcards.SuitSpades, playingcards.Rank7)).IsNil()
+
+	gameState, players = concreteStates(game.CurrentState())
+
+	assert.For(t).ThatActual(players[0].WonCards.NumComponents()).Equals(4)
+	assert.For(t).ThatActual(gameState.CurrentPlayer).Equals(boardgame.PlayerIndex(0))
+
+}
+
+func TestTrickWinnerTrump(t *testing.T) {
+	manager, err := newGameManager(trickMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	state, err := game.CurrentState().Copy(false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	mState := state.(boardgame.State)
+
+	gameState, players := concreteStates(mState)
+
+	//Player 1 isn't actually void in spades, but that's only enforced by
+	//PlayCardToTrick.
+	cards := []struct {
+		player boardgame.PlayerIndex
+		suit   int
+		rank   int
+	}{
+		{0, playingcards.SuitSpades, playingcards.RankAce},
+		{1, playingcards.SuitHearts, playingcards.RankAce},
+		{2, playingcards.SuitSpades, playingcards.RankJack},
+		{3, playingcards.SuitSpades, playingcards.RankQueen},
+	}
+
+	var playedBy []boardgame.PlayerIndex
+
+	for _, card := range cards {
+		hand := players[card.player].Hand
+		err := hand.ComponentAt(cardIndex(hand, card.suit, card.rank)).MoveToNextSlot(gameState.TrickStack)
+		assert.For(t).ThatActual(err).IsNil()
+		playedBy = append(playedBy, card.player)
+	}
+
+	gameState.SetTrickCardPlayers(playedBy)
+	gameState.SetTrickLedSuit(playingcards.SuitSpades)
+
+	noTrump := game.MoveByName("Resolve Trick").(*ResolveTrick)
+
+	winner, err := noTrump.TrickWinner(mState)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(winner).Equals(boardgame.PlayerIndex(0))
+
+	heartsTrump := game.MoveByName("Resolve Trick Hearts Trump").(*moveResolveTrickHeartsTrump)
+
+	winner, err = heartsTrump.TrickWinner(mState)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(winner).Equals(boardgame.PlayerIndex(1))
+}
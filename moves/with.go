@@ -2,6 +2,7 @@ package moves
 
 import (
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/behaviors"
 	"github.com/jkomoros/boardgame/enum"
 )
 
@@ -23,6 +24,15 @@ const configPropLegalPhases = fullyQualifiedPackageName + "LegalPhases"
 const configPropLegalMoveProgression = fullyQualifiedPackageName + "LegalMoveProgression"
 const configPropLegalType = fullyQualifiedPackageName + "LegalType"
 const configPropAmount = fullyQualifiedPackageName + "Amount"
+const configPropAuctionType = fullyQualifiedPackageName + "AuctionType"
+const configPropMinBid = fullyQualifiedPackageName + "MinBid"
+const configPropMinIncrement = fullyQualifiedPackageName + "MinIncrement"
+const configPropPickedProperty = fullyQualifiedPackageName + "PickedProperty"
+const configPropPassDirection = fullyQualifiedPackageName + "PassDirection"
+const configPropTradeResources = fullyQualifiedPackageName + "TradeResources"
+const configPropRerollLimit = fullyQualifiedPackageName + "RerollLimit"
+const configPropCost = fullyQualifiedPackageName + "Cost"
+const configPropGain = fullyQualifiedPackageName + "Gain"
 
 //CustomConfigurationOption is a function that takes a PropertyCollection and
 //modifies a key on it. This package defines a number of functions that return
@@ -195,3 +205,86 @@ func WithAmount(amount int) CustomConfigurationOption {
 		config[configPropAmount] = amount
 	}
 }
+
+//WithAuctionType returns a function configuration option suitable for being
+//passed to auto.Config. PlaceBid, PassBid and ResolveAuction use it to decide
+//how the auction runs; pass the same AuctionType to all three. Defaults to
+//AuctionAscending.
+func WithAuctionType(auctionType AuctionType) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropAuctionType] = auctionType
+	}
+}
+
+//WithMinBid returns a function configuration option suitable for being
+//passed to auto.Config. PlaceBid uses it as the lowest legal first bid in an
+//auction. Defaults to 1.
+func WithMinBid(minBid int) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropMinBid] = minBid
+	}
+}
+
+//WithMinIncrement returns a function configuration option suitable for being
+//passed to auto.Config. PlaceBid uses it as how much a bid must beat the
+//current high bid by. Defaults to 1.
+func WithMinIncrement(minIncrement int) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropMinIncrement] = minIncrement
+	}
+}
+
+//WithPickedProperty returns a function configuration option suitable for
+//being passed to auto.Config. DraftPick uses it as the name of the stack on
+//each PlayerState that picked cards are moved to.
+func WithPickedProperty(stackPropName string) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropPickedProperty] = stackPropName
+	}
+}
+
+//WithPassDirection returns a function configuration option suitable for being
+//passed to auto.Config. RotateHands uses it to decide which way to pass hands.
+//Defaults to PassLeft.
+func WithPassDirection(direction PassDirection) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropPassDirection] = direction
+	}
+}
+
+//WithTradeResources returns a function configuration option suitable for
+//being passed to auto.Config. OfferTrade and AcceptTrade use it as the names
+//of the int properties on each PlayerState that may be traded; resource
+//counts in a trade are in the same order. Pass the same names to both.
+func WithTradeResources(resourcePropNames ...string) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropTradeResources] = resourcePropNames
+	}
+}
+
+//WithRerollLimit returns a function configuration option suitable for being
+//passed to auto.Config. RerollSelected uses it as how many times the dice may
+//be rerolled after RollDice. Defaults to 2.
+func WithRerollLimit(rerollLimit int) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropRerollLimit] = rerollLimit
+	}
+}
+
+//WithCost returns a function configuration option suitable for being passed
+//to auto.Config. PayCost and ExchangeResources use it as what the move costs
+//to make.
+func WithCost(cost behaviors.Cost) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropCost] = cost
+	}
+}
+
+//WithGain returns a function configuration option suitable for being passed
+//to auto.Config. GainResources and ExchangeResources use it as what the move
+//gains from the bank.
+func WithGain(gain behaviors.Cost) CustomConfigurationOption {
+	return func(config boardgame.PropertyCollection) {
+		config[configPropGain] = gain
+	}
+}
@@ -0,0 +1,415 @@
+package moves
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+)
+
+//gameBoarder is implemented by PlaceWorker and ReturnWorkers.
+type gameBoarder interface {
+	GameBoard(gameState boardgame.SubState) boardgame.Board
+}
+
+//gameBoardFromConfig returns the Board property on gameState named by
+//WithGameProperty, or nil if there isn't one.
+func gameBoardFromConfig(m moveInfoer, gameState boardgame.SubState) boardgame.Board {
+	propName, ok := m.CustomConfiguration()[configPropGameProperty].(string)
+
+	if !ok {
+		return nil
+	}
+
+	board, err := gameState.ReadSetter().BoardProp(propName)
+
+	if err != nil {
+		return nil
+	}
+
+	return board
+}
+
+//workerPlacementMover is implemented by the worker placement moves.
+type workerPlacementMover interface {
+	moveInfoer
+	TopLevelStruct() boardgame.Move
+}
+
+//workerPlacementStacks returns the Board workers are placed on and the
+//PlayerStack of each player, which holds the workers they have yet to place.
+func workerPlacementStacks(m workerPlacementMover, state boardgame.State) (boardgame.Board, []boardgame.Stack, error) {
+	boarder, ok := m.TopLevelStruct().(gameBoarder)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't have GameBoard")
+	}
+
+	playerStacker, ok := m.TopLevelStruct().(interfaces.PlayerStacker)
+
+	if !ok {
+		return nil, nil, errors.New("The top level struct unexpectedly didn't implement PlayerStacker")
+	}
+
+	board := boarder.GameBoard(state.GameState())
+
+	if board == nil {
+		return nil, nil, errors.New("GameBoard returned a nil board")
+	}
+
+	supplies := make([]boardgame.Stack, len(state.PlayerStates()))
+
+	for i, playerState := range state.PlayerStates() {
+		supplies[i] = playerStacker.PlayerStack(playerState)
+		if supplies[i] == nil {
+			return nil, nil, errors.New("PlayerStack returned a nil stack")
+		}
+	}
+
+	return board, supplies, nil
+}
+
+//actionSpaceDefiner returns the GameDelegate as an
+//interfaces.ActionSpaceDefiner.
+func actionSpaceDefiner(manager *boardgame.GameManager) (interfaces.ActionSpaceDefiner, error) {
+	definer, ok := manager.Delegate().(interfaces.ActionSpaceDefiner)
+
+	if !ok {
+		return nil, errors.New("GameDelegate does not implement ActionSpaceDefiner")
+	}
+
+	return definer, nil
+}
+
+//workerPlacementValidConfiguration checks that GameState implements
+//interfaces.ActionSpaceProperties, that the GameDelegate implements
+//interfaces.ActionSpaceDefiner, that GameBoard returns a board with a space
+//for each action space, and that PlayerStack returns a non-nil stack.
+func workerPlacementValidConfiguration(m workerPlacementMover, exampleState boardgame.State) error {
+	if _, ok := exampleState.GameState().(interfaces.ActionSpaceProperties); !ok {
+		return errors.New("GameState does not implement ActionSpaceProperties")
+	}
+
+	definer, err := actionSpaceDefiner(exampleState.Manager())
+
+	if err != nil {
+		return err
+	}
+
+	board, _, err := workerPlacementStacks(m, exampleState)
+
+	if err != nil {
+		return err
+	}
+
+	numSpaces := len(definer.ActionSpaceNames())
+
+	if board.Len() < numSpaces {
+		return errors.New("GameBoard has " + strconv.Itoa(board.Len()) + " spaces, but " + strconv.Itoa(numSpaces) + " action spaces are defined")
+	}
+
+	return nil
+}
+
+/*
+
+PlaceWorker is a move for worker placement games. It moves the first worker
+from the current player's supply (PlayerStack, configured with
+WithPlayerProperty) onto the action space at Space in GameBoard (a Board
+property on GameState, configured with WithGameProperty), and then carries out
+the space's action by calling your GameDelegate's ActionSpaceEffect, if it
+implements interfaces.ActionSpaceEffecter.
+
+A worker may not be placed on a space that is already at its capacity, or on
+an exclusive space that another player already has a worker on. Your
+GameDelegate must implement interfaces.ActionSpaceDefiner to say what the
+spaces are.
+
+Your GameState must implement interfaces.ActionSpaceProperties, typically by
+embedding behaviors.ActionSpaces.
+
+boardgame:codegen
+*/
+type PlaceWorker struct {
+	CurrentPlayer
+	Space int
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the supply of workers
+//that haven't been placed. If that is not sufficient, override this in your
+//embedding struct.
+func (p *PlaceWorker) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(p, playerState)
+}
+
+//GameBoard by default returns the Board property on GameState with the name
+//passed to auto.Config by WithGameProperty. If that is not sufficient,
+//override this in your embedding struct.
+func (p *PlaceWorker) GameBoard(gameState boardgame.SubState) boardgame.Board {
+	return gameBoardFromConfig(p, gameState)
+}
+
+//Legal checks that the current player has a worker left to place, and that
+//Space may take another of their workers.
+func (p *PlaceWorker) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := p.CurrentPlayer.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	spaces, ok := state.ImmutableGameState().(interfaces.ActionSpaceProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ActionSpaceProperties")
+	}
+
+	definer, err := actionSpaceDefiner(state.Manager())
+
+	if err != nil {
+		return err
+	}
+
+	names := definer.ActionSpaceNames()
+
+	if p.Space < 0 || p.Space >= len(names) {
+		return errors.New("Space didn't specify an action space")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	board, supplies, err := workerPlacementStacks(p, mState)
+
+	if err != nil {
+		return err
+	}
+
+	if supplies[p.TargetPlayerIndex].NumComponents() == 0 {
+		return errors.New("You have no workers left to place")
+	}
+
+	name := names[p.Space]
+
+	capacity := definer.ActionSpaceCapacity(p.Space)
+
+	if capacity > 0 && board.SpaceAt(p.Space).NumComponents() >= capacity {
+		return errors.New(name + " is full")
+	}
+
+	if !definer.ActionSpaceExclusive(p.Space) {
+		return nil
+	}
+
+	placedPlayers := spaces.ActionSpacePlacementPlayers()
+
+	for i, space := range spaces.ActionSpacePlacementSpaces() {
+		if space == p.Space && placedPlayers[i] != p.TargetPlayerIndex {
+			return errors.New(name + " is taken by player " + placedPlayers[i].String())
+		}
+	}
+
+	return nil
+}
+
+//Apply places the worker, records the placement, and then calls the
+//GameDelegate's ActionSpaceEffect.
+func (p *PlaceWorker) Apply(state boardgame.State) error {
+	spaces, ok := state.GameState().(interfaces.ActionSpaceProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ActionSpaceProperties")
+	}
+
+	board, supplies, err := workerPlacementStacks(p, state)
+
+	if err != nil {
+		return err
+	}
+
+	worker := supplies[p.TargetPlayerIndex].First()
+
+	if worker == nil {
+		return errors.New("There are no workers left to place")
+	}
+
+	if err := worker.MoveToNextSlot(board.SpaceAt(p.Space)); err != nil {
+		return errors.New("Couldn't place worker: " + err.Error())
+	}
+
+	placedSpaces := append([]int{}, spaces.ActionSpacePlacementSpaces()...)
+	placedPlayers := append([]boardgame.PlayerIndex{}, spaces.ActionSpacePlacementPlayers()...)
+
+	spaces.SetActionSpacePlacements(append(placedSpaces, p.Space), append(placedPlayers, p.TargetPlayerIndex))
+
+	effecter, ok := state.Manager().Delegate().(interfaces.ActionSpaceEffecter)
+
+	if !ok {
+		return nil
+	}
+
+	return effecter.ActionSpaceEffect(state, p.TargetPlayerIndex, p.Space)
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ActionSpaceProperties, that the GameDelegate implements
+//interfaces.ActionSpaceDefiner, that GameBoard has a space for each action
+//space, and that PlayerStack returns a non-nil stack.
+func (p *PlaceWorker) ValidConfiguration(exampleState boardgame.State) error {
+	if err := p.CurrentPlayer.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return workerPlacementValidConfiguration(p, exampleState)
+}
+
+//FallbackName returns "Place Worker"
+func (p *PlaceWorker) FallbackName(m *boardgame.GameManager) string {
+	return "Place Worker"
+}
+
+//FallbackHelpText returns "Places one of your workers on an action space to
+//take its action."
+func (p *PlaceWorker) FallbackHelpText() string {
+	return "Places one of your workers on an action space to take its action."
+}
+
+/*
+
+ReturnWorkers is a fix up move that applies at the end of a round of worker
+placement, once workers have been placed and no active player has any left in
+their supply (PlayerStack, configured with WithPlayerProperty). It returns
+every worker on GameBoard (configured with WithGameProperty) to the supply of
+the player who placed it, and clears the record of placements.
+
+Your GameState must implement interfaces.ActionSpaceProperties, typically by
+embedding behaviors.ActionSpaces.
+
+boardgame:codegen
+*/
+type ReturnWorkers struct {
+	FixUp
+}
+
+//PlayerStack by default returns the property on PlayerState with the name
+//passed to auto.Config by WithPlayerProperty. It's the supply workers are
+//returned to. If that is not sufficient, override this in your embedding
+//struct.
+func (r *ReturnWorkers) PlayerStack(playerState boardgame.SubState) boardgame.Stack {
+	return playerStackFromConfig(r, playerState)
+}
+
+//GameBoard by default returns the Board property on GameState with the name
+//passed to auto.Config by WithGameProperty. If that is not sufficient,
+//override this in your embedding struct.
+func (r *ReturnWorkers) GameBoard(gameState boardgame.SubState) boardgame.Board {
+	return gameBoardFromConfig(r, gameState)
+}
+
+//Legal returns nil once workers have been placed and no active player has
+//any left to place.
+func (r *ReturnWorkers) Legal(state boardgame.ImmutableState, proposer boardgame.PlayerIndex) error {
+	if err := r.FixUp.Legal(state, proposer); err != nil {
+		return err
+	}
+
+	spaces, ok := state.ImmutableGameState().(interfaces.ActionSpaceProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ActionSpaceProperties")
+	}
+
+	if len(spaces.ActionSpacePlacementSpaces()) == 0 {
+		return errors.New("No workers have been placed")
+	}
+
+	mState, err := stackerState(state)
+	if err != nil {
+		return err
+	}
+
+	_, supplies, err := workerPlacementStacks(r, mState)
+
+	if err != nil {
+		return err
+	}
+
+	for i, supply := range supplies {
+		player := boardgame.PlayerIndex(i)
+		if !player.Valid(state) {
+			continue
+		}
+		if supply.NumComponents() > 0 {
+			return errors.New("Player " + player.String() + " still has workers to place")
+		}
+	}
+
+	return nil
+}
+
+//Apply returns every placed worker to the supply of the player who placed it.
+func (r *ReturnWorkers) Apply(state boardgame.State) error {
+	spaces, ok := state.GameState().(interfaces.ActionSpaceProperties)
+
+	if !ok {
+		return errors.New("GameState does not implement ActionSpaceProperties")
+	}
+
+	board, supplies, err := workerPlacementStacks(r, state)
+
+	if err != nil {
+		return err
+	}
+
+	placedPlayers := spaces.ActionSpacePlacementPlayers()
+
+	//Each space's workers are in the order they were placed, so the nth
+	//placement on a space is the nth worker there. Find them all before
+	//moving any.
+	seen := make(map[int]int)
+	workers := make([]boardgame.ComponentInstance, len(placedPlayers))
+
+	for i, space := range spaces.ActionSpacePlacementSpaces() {
+		if space < 0 || space >= board.Len() {
+			return errors.New("A worker was recorded on a space that isn't on the board")
+		}
+		workers[i] = board.SpaceAt(space).ComponentAt(seen[space])
+		seen[space]++
+		if workers[i] == nil {
+			return errors.New("A worker was recorded on space " + strconv.Itoa(space) + " that isn't there")
+		}
+	}
+
+	for i, worker := range workers {
+		if err := worker.MoveToNextSlot(supplies[placedPlayers[i]]); err != nil {
+			return errors.New("Couldn't return worker: " + err.Error())
+		}
+	}
+
+	spaces.SetActionSpacePlacements(nil, nil)
+
+	return nil
+}
+
+//ValidConfiguration checks that GameState implements
+//interfaces.ActionSpaceProperties, that the GameDelegate implements
+//interfaces.ActionSpaceDefiner, that GameBoard has a space for each action
+//space, and that PlayerStack returns a non-nil stack.
+func (r *ReturnWorkers) ValidConfiguration(exampleState boardgame.State) error {
+	if err := r.FixUp.ValidConfiguration(exampleState); err != nil {
+		return err
+	}
+	return workerPlacementValidConfiguration(r, exampleState)
+}
+
+//FallbackName returns "Return Workers"
+func (r *ReturnWorkers) FallbackName(m *boardgame.GameManager) string {
+	return "Return Workers"
+}
+
+//FallbackHelpText returns "Returns every placed worker to its owner at the
+//end of the round."
+func (r *ReturnWorkers) FallbackHelpText() string {
+	return "Returns every placed worker to its owner at the end of the round."
+}
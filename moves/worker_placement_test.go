@@ -0,0 +1,120 @@
+package moves
+
+import (
+	"testing"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/components/playingcards"
+	"github.com/workfit/tester/assert"
+)
+
+//boardgame:codegen
+type movePlaceWorkerAndPass struct {
+	PlaceWorker
+}
+
+//Apply places the worker and then passes the turn to the next player.
+func (m *movePlaceWorkerAndPass) Apply(state boardgame.State) error {
+	if err := m.PlaceWorker.Apply(state); err != nil {
+		return err
+	}
+	game := state.GameState().(*gameState)
+	game.CurrentPlayer = game.CurrentPlayer.Next(state)
+	return nil
+}
+
+func workerPlacementMoveInstaller(manager *boardgame.GameManager) []boardgame.MoveConfig {
+
+	auto := NewAutoConfigurer(manager.Delegate())
+
+	return Combine(
+		AddOrderedForPhase(phaseSetUp,
+			auto.MustConfig(
+				new(DealCountComponents),
+				WithGameProperty("DrawStack"),
+				WithPlayerProperty("Hand"),
+				WithTargetCount(2),
+			),
+			auto.MustConfig(
+				new(StartPhase),
+				WithPhaseToStart(phaseNormalPlayDrawCard, phaseEnum),
+			),
+		),
+		AddForPhase(phaseNormalPlay,
+			auto.MustConfig(
+				new(movePlaceWorkerAndPass),
+				WithPlayerProperty("Hand"),
+				WithGameProperty("ActionBoard"),
+			),
+			auto.MustConfig(
+				new(ReturnWorkers),
+				WithPlayerProperty("Hand"),
+				WithGameProperty("ActionBoard"),
+			),
+		),
+	)
+}
+
+func TestWorkerPlacement(t *testing.T) {
+	manager, err := newGameManager(workerPlacementMoveInstaller, false)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	place := func(player boardgame.PlayerIndex, space int) error {
+		move := game.MoveByName("Place Worker").(*movePlaceWorkerAndPass)
+		move.TargetPlayerIndex = player
+		move.Space = space
+		return <-game.ProposeMove(move, player)
+	}
+
+	assert.For(t).ThatActual(place(1, 0)).IsNotNil()
+	assert.For(t).ThatActual(place(0, 3)).IsNotNil()
+
+	assert.For(t).ThatActual(place(0, 1)).IsNil()
+	assert.For(t).ThatActual(place(1, 2)).IsNil()
+	assert.For(t).ThatActual(place(2, 1)).IsNil()
+
+	//The quarry is full, and the market is taken by player 1.
+	assert.For(t).ThatActual(place(3, 1)).IsNotNil()
+	assert.For(t).ThatActual(place(3, 2)).IsNotNil()
+	assert.For(t).ThatActual(place(3, 0)).IsNil()
+
+	gameState, players := concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(players[0].Brick).Equals(1)
+	assert.For(t).ThatActual(players[3].Wood).Equals(1)
+	assert.For(t).ThatActual(players[0].Hand.NumComponents()).Equals(1)
+	assert.For(t).ThatActual(gameState.ActionBoard.SpaceAt(1).NumComponents()).Equals(2)
+
+	assert.For(t).ThatActual(place(0, 0)).IsNil()
+	//The market is exclusive, not limited, so player 1 may add to it.
+	assert.For(t).ThatActual(place(1, 2)).IsNil()
+	assert.For(t).ThatActual(place(2, 0)).IsNil()
+
+	gameState, _ = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(len(gameState.ActionSpacePlacementSpaces())).Equals(7)
+
+	//ReturnWorkers should apply once the last worker is placed.
+	assert.For(t).ThatActual(place(3, 0)).IsNil()
+
+	gameState, players = concreteStates(game.CurrentState())
+	assert.For(t).ThatActual(len(gameState.ActionSpacePlacementSpaces())).Equals(0)
+	assert.For(t).ThatActual(players[3].Wood).Equals(2)
+
+	for i, space := range gameState.ActionBoard.Spaces() {
+		assert.For(t, i).ThatActual(space.NumComponents()).Equals(0)
+	}
+
+	for i, player := range players {
+		assert.For(t, i).ThatActual(player.Hand.NumComponents()).Equals(2)
+	}
+
+	//Each worker goes back to whoever placed it.
+	assert.For(t).ThatActual(cardIndex(players[1].Hand, playingcards.SuitSpades, playingcards.Rank2)).DoesNotEqual(-1)
+	assert.For(t).ThatActual(cardIndex(players[1].Hand, playingcards.SuitSpades, playingcards.Rank6)).DoesNotEqual(-1)
+
+	assert.For(t).ThatActual(place(0, 2)).IsNil()
+}
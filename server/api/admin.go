@@ -0,0 +1,459 @@
+package api
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/audit"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+const maxUsersToList = 100
+const maxAuditRecordsToList = 100
+
+//requireAdmin fails the request if the user isn't allowed to be an admin.
+//Unlike most admin behavior, the admin console doesn't require the admin
+//query parameter; every request to it is an admin request.
+func (s *Server) requireAdmin(c *gin.Context) {
+
+	r := s.newRenderer(c)
+
+	if !s.getAdminAllowed(c) {
+		r.Error(errors.NewFriendly("You are not an admin"))
+		c.Abort()
+		return
+	}
+}
+
+//adminGameSetup fetches the game configured in the URL and puts it in
+//context. Unlike gameAPISetup it never seats the user.
+func (s *Server) adminGameSetup(c *gin.Context) {
+
+	game := s.gameFromID(s.getRequestGameID(c), s.getRequestGameName(c))
+
+	if game == nil {
+		return
+	}
+
+	s.setGame(c, game)
+}
+
+//recordAudit adds an entry for the given admin action to the audit log.
+func (s *Server) recordAudit(admin *users.StorageRecord, action audit.Action, gameID string, userID string, details string) {
+	if err := s.storage.AddAuditRecord(audit.New(admin.ID, action, gameID, userID, details)); err != nil {
+		s.logger.Errorln("Couldn't record " + string(action) + " by " + admin.ID + " in audit log: " + err.Error())
+	}
+}
+
+//auditDetails joins the non-empty parts of an audit record's details.
+func auditDetails(parts ...string) string {
+	var result []string
+	for _, part := range parts {
+		if part != "" {
+			result = append(result, part)
+		}
+	}
+	return strings.Join(result, "; ")
+}
+
+func (s *Server) adminListUsersHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	query := s.getRequestSearch(c)
+
+	s.doAdminListUsers(r, query)
+}
+
+func (s *Server) doAdminListUsers(r *renderer, query string) {
+	r.Success(gin.H{
+		"Users": s.storage.ListUsers(maxUsersToList, query),
+	})
+}
+
+func (s *Server) adminBanUserHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	userID := s.getRequestUserID(c)
+
+	banned := s.getRequestBanned(c)
+
+	reason := s.getRequestReason(c)
+
+	s.doAdminBanUser(r, admin, userID, banned, reason)
+}
+
+func (s *Server) doAdminBanUser(r *renderer, admin *users.StorageRecord, userID string, banned bool, reason string) {
+
+	user := s.storage.GetUserByID(userID)
+
+	if user == nil {
+		r.Error(errors.NewFriendly("No such user"))
+		return
+	}
+
+	if user.ID == admin.ID {
+		r.Error(errors.NewFriendly("You can't ban yourself"))
+		return
+	}
+
+	user.Banned = banned
+
+	if err := s.storage.UpdateUser(user); err != nil {
+		r.Error(errors.New("Couldn't save user: " + err.Error()))
+		return
+	}
+
+	action := audit.BanUser
+
+	if !banned {
+		action = audit.UnbanUser
+	}
+
+	s.recordAudit(admin, action, "", user.ID, reason)
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) adminAuditHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	s.doAdminAudit(r)
+}
+
+func (s *Server) doAdminAudit(r *renderer) {
+	r.Success(gin.H{
+		"Records": s.storage.AuditRecords(maxAuditRecordsToList),
+	})
+}
+
+func (s *Server) adminGameHistoryHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	game := s.getGame(c)
+
+	s.doAdminGameHistory(r, admin, game)
+}
+
+//doAdminGameHistory renders every state and move of the game exactly as
+//stored, without any sanitization.
+func (s *Server) doAdminGameHistory(r *renderer, admin *users.StorageRecord, game *boardgame.Game) {
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	version := game.Version()
+
+	states := make([]json.RawMessage, version+1)
+
+	for i := 0; i <= version; i++ {
+		state, err := s.storage.State(game.ID(), i)
+		if err != nil {
+			r.Error(errors.New("Couldn't fetch state " + strconv.Itoa(i) + ": " + err.Error()))
+			return
+		}
+		states[i] = json.RawMessage(state)
+	}
+
+	moves, err := s.storage.Moves(game.ID(), 0, version)
+
+	if err != nil {
+		r.Error(errors.New("Couldn't fetch moves: " + err.Error()))
+		return
+	}
+
+	s.recordAudit(admin, audit.ViewHistory, game.ID(), "", "")
+
+	r.Success(gin.H{
+		"Game":    game.StorageRecord(),
+		"States":  states,
+		"Moves":   moves,
+		"UserIDs": s.storage.UserIDsForGame(game.ID()),
+	})
+}
+
+func (s *Server) adminDeleteGameHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	game := s.getGame(c)
+
+	reason := s.getRequestReason(c)
+
+	s.doAdminDeleteGame(r, admin, game, reason)
+}
+
+//doAdminDeleteGame deletes the game. Games that are part of a tournament
+//can't be deleted, since the tournament's record of the match refers to
+//them; finish them with doAdminFinishGame instead.
+func (s *Server) doAdminDeleteGame(r *renderer, admin *users.StorageRecord, game *boardgame.Game, reason string) {
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	eGame, err := s.storage.ExtendedGame(game.ID())
+
+	if err != nil {
+		r.Error(errors.New("Couldn't fetch extended game: " + err.Error()))
+		return
+	}
+
+	if eGame.TournamentID != "" {
+		r.Error(errors.NewFriendly("Games in a tournament can't be deleted. Finish the game instead."))
+		return
+	}
+
+	//Finish the game first, so no more moves (which would recreate it in
+	//storage) can be applied to any copy of it still in memory.
+	if !game.Finished() {
+		if err := <-game.Manager().Internals().ForceFinish(game, nil); err != nil {
+			r.Error(errors.New("Couldn't finish game before deleting it: " + err.Error()))
+			return
+		}
+	}
+
+	if err := s.storage.DeleteGame(game.ID()); err != nil {
+		r.Error(errors.New("Couldn't delete game: " + err.Error()))
+		return
+	}
+
+	//Otherwise the manager would keep handing out its copy in memory.
+	game.Manager().Internals().EvictGame(game.ID())
+
+	s.recordAudit(admin, audit.DeleteGame, game.ID(), "", auditDetails(game.Name(), reason))
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) adminFinishGameHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	game := s.getGame(c)
+
+	winners, err := s.getRequestWinners(c)
+
+	if err != nil {
+		r.Error(errors.NewFriendly(err.Error()))
+		return
+	}
+
+	reason := s.getRequestReason(c)
+
+	s.doAdminFinishGame(r, admin, game, winners, reason)
+}
+
+//doAdminFinishGame ends the game with the given winners. Ratings aren't
+//updated for games that are finished this way, but any tournament the game
+//is part of moves on.
+func (s *Server) doAdminFinishGame(r *renderer, admin *users.StorageRecord, game *boardgame.Game, winners []boardgame.PlayerIndex, reason string) {
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	if err := <-game.Manager().Internals().ForceFinish(game, winners); err != nil {
+		if f, ok := err.(*errors.Friendly); ok {
+			r.Error(f)
+		} else {
+			r.Error(errors.New(err.Error()))
+		}
+		return
+	}
+
+	record := game.StorageRecord()
+
+	s.notifier.gameChanged(record)
+
+	s.touchInactivityClock(record, boardgame.AdminPlayerIndex, false)
+
+	s.dispatchGameFinished(record)
+
+	if err := s.tournamentGameFinished(record); err != nil {
+		s.logger.Errorln("Couldn't record tournament result for game " + game.ID() + ": " + err.Error())
+	}
+
+	winnerStrings := make([]string, len(winners))
+	for i, winner := range winners {
+		winnerStrings[i] = winner.String()
+	}
+
+	s.recordAudit(admin, audit.FinishGame, game.ID(), "", auditDetails("Winners: "+strings.Join(winnerStrings, ","), reason))
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) adminSetSeatHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	game := s.getGame(c)
+
+	seat := s.getRequestSeat(c)
+
+	userID := s.getRequestSeatUserID(c)
+
+	reason := s.getRequestReason(c)
+
+	s.doAdminSetSeat(r, admin, game, seat, userID, reason)
+}
+
+//doAdminSetSeat puts the given user in seat, replacing whoever was there. If
+//userID is "" the seat is emptied instead.
+func (s *Server) doAdminSetSeat(r *renderer, admin *users.StorageRecord, game *boardgame.Game, seat int, userID string, reason string) {
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	if seat < 0 || seat >= game.NumPlayers() {
+		r.Error(errors.NewFriendly("Invalid seat"))
+		return
+	}
+
+	slot := boardgame.PlayerIndex(seat)
+
+	var user *users.StorageRecord
+
+	if userID != "" {
+		user = s.storage.GetUserByID(userID)
+		if user == nil {
+			r.Error(errors.NewFriendly("No such user"))
+			return
+		}
+	}
+
+	userIDs := s.storage.UserIDsForGame(game.ID())
+
+	if seat >= len(userIDs) {
+		r.Error(errors.New("Couldn't fetch users for game"))
+		return
+	}
+
+	previous := userIDs[seat]
+
+	if previous == userID {
+		r.Error(errors.NewFriendly("That user is already in that seat"))
+		return
+	}
+
+	var err error
+
+	switch {
+	case user == nil:
+		//The player is leaving, so let the game logic know.
+		err = s.doUnseatPlayer(game, slot, "")
+	case previous == "":
+		err = s.doSeatPlayer(game, slot, user)
+	default:
+		//As far as the game logic is concerned the seat stays filled, so
+		//just swap who is sitting in it.
+		if err = s.storage.SetPlayerForGame(game.ID(), slot, ""); err == nil {
+			if err = s.storage.SetPlayerForGame(game.ID(), slot, user.ID); err == nil {
+				s.dispatchPlayerSeated(game, slot, user.ID)
+			}
+		}
+	}
+
+	if err != nil {
+		if f, ok := err.(*errors.Friendly); ok {
+			r.Error(f)
+		} else {
+			r.Error(errors.New("Couldn't change seat: " + err.Error()))
+		}
+		return
+	}
+
+	s.recordAudit(admin, audit.SetSeat, game.ID(), userID, auditDetails("Seat "+slot.String(), "Replaced "+previous, reason))
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) adminConfigureGameHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	admin := s.getUser(c)
+
+	game := s.getGame(c)
+
+	var gameInfo *extendedgame.StorageRecord
+
+	if game != nil {
+		gameInfo, _ = s.storage.ExtendedGame(game.ID())
+	}
+
+	open, setOpen := s.getRequestOptionalFlag(c, qryOpen)
+	visible, setVisible := s.getRequestOptionalFlag(c, qryVisible)
+	owner, setOwner := s.getRequestOwner(c)
+
+	reason := s.getRequestReason(c)
+
+	s.doAdminConfigureGame(r, admin, game, gameInfo, open, setOpen, visible, setVisible, owner, setOwner, reason)
+}
+
+//doAdminConfigureGame changes whichever of the game's extended record fields
+//were provided.
+func (s *Server) doAdminConfigureGame(r *renderer, admin *users.StorageRecord, game *boardgame.Game, gameInfo *extendedgame.StorageRecord, open, setOpen, visible, setVisible bool, owner string, setOwner bool, reason string) {
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	if gameInfo == nil {
+		r.Error(errors.New("Couldn't fetch game info"))
+		return
+	}
+
+	var changes []string
+
+	if setOpen && gameInfo.Open != open {
+		gameInfo.Open = open
+		changes = append(changes, "Open: "+strconv.FormatBool(open))
+	}
+
+	if setVisible && gameInfo.Visible != visible {
+		gameInfo.Visible = visible
+		changes = append(changes, "Visible: "+strconv.FormatBool(visible))
+	}
+
+	if setOwner && gameInfo.Owner != owner {
+		if owner != "" && s.storage.GetUserByID(owner) == nil {
+			r.Error(errors.NewFriendly("No such user"))
+			return
+		}
+		changes = append(changes, "Owner: "+gameInfo.Owner+" -> "+owner)
+		gameInfo.Owner = owner
+	}
+
+	if len(changes) == 0 {
+		r.Error(errors.NewFriendly("Nothing to change"))
+		return
+	}
+
+	if err := s.storage.UpdateExtendedGame(game.ID(), gameInfo); err != nil {
+		r.Error(errors.New("Couldn't save game info: " + err.Error()))
+		return
+	}
+
+	s.recordAudit(admin, audit.EditGame, game.ID(), "", auditDetails(strings.Join(changes, ", "), reason))
+
+	r.Success(gin.H{})
+}
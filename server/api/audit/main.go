@@ -0,0 +1,59 @@
+/*
+
+Package audit is the definition of the StorageRecord for the audit log of
+actions admins take, like banning users or deleting games. In a separate
+package to avoid dependency cycles.
+
+*/
+package audit
+
+import "time"
+
+//Action is the kind of thing an admin did.
+type Action string
+
+const (
+	//BanUser is when an admin bans a user, who can no longer sign in.
+	BanUser Action = "ban-user"
+	//UnbanUser is when an admin lifts a ban.
+	UnbanUser Action = "unban-user"
+	//DeleteGame is when an admin deletes a game and its history.
+	DeleteGame Action = "delete-game"
+	//FinishGame is when an admin ends a game early.
+	FinishGame Action = "finish-game"
+	//SetSeat is when an admin puts a user in (or removes a user from) a
+	//seat.
+	SetSeat Action = "set-seat"
+	//EditGame is when an admin changes a game's extended record.
+	EditGame Action = "edit-game"
+	//ViewHistory is when an admin views a game's unsanitized states.
+	ViewHistory Action = "view-history"
+)
+
+//StorageRecord is a single entry in the audit log.
+type StorageRecord struct {
+	//Timestamp is the UnixNano time the action was taken.
+	Timestamp int64
+	//AdminID is the ID of the user who took the action.
+	AdminID string
+	Action  Action
+	//GameID is the game acted on, if any.
+	GameID string `json:",omitempty"`
+	//UserID is the user acted on, if any.
+	UserID string `json:",omitempty"`
+	//Details is a human readable description of anything else about the
+	//action, like which fields changed.
+	Details string `json:",omitempty"`
+}
+
+//New returns a new record for an action taken just now.
+func New(adminID string, action Action, gameID string, userID string, details string) *StorageRecord {
+	return &StorageRecord{
+		Timestamp: time.Now().UnixNano(),
+		AdminID:   adminID,
+		Action:    action,
+		GameID:    gameID,
+		UserID:    userID,
+		Details:   details,
+	}
+}
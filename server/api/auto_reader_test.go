@@ -0,0 +1,1174 @@
+/************************************
+ *
+ * This file contains auto-generated methods to help certain structs
+ * implement boardgame.PropertyReader and friends. It was generated
+ * by the codegen package via 'boardgame-util codegen'.
+ *
+ * DO NOT EDIT by hand.
+ *
+ ************************************/
+
+package api
+
+import (
+	"errors"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/enum"
+)
+
+// Implementation for testGameState
+
+var ȧutoGeneratedTestGameStateReaderProps = map[string]boardgame.PropertyType{
+	"AuctionBid":               boardgame.TypeInt,
+	"AuctionBidder":            boardgame.TypePlayerIndex,
+	"AuctionInProgress":        boardgame.TypeBool,
+	"AuctionPassed":            boardgame.TypePlayerIndexSlice,
+	"AuctionSealed":            boardgame.TypeIntSlice,
+	"AuctionTurns":             boardgame.TypeInt,
+	"CurrentPlayer":            boardgame.TypePlayerIndex,
+	"Dice":                     boardgame.TypeStack,
+	"DicePoolLocked":           boardgame.TypeBoolSlice,
+	"DicePoolRerolls":          boardgame.TypeInt,
+	"DicePoolRolled":           boardgame.TypeBool,
+	"TradeOfferedComponents":   boardgame.TypeIntSlice,
+	"TradeOfferedResources":    boardgame.TypeIntSlice,
+	"TradeOpen":                boardgame.TypeBool,
+	"TradeProposer":            boardgame.TypePlayerIndex,
+	"TradeRecipient":           boardgame.TypePlayerIndex,
+	"TradeRequestedComponents": boardgame.TypeIntSlice,
+	"TradeRequestedResources":  boardgame.TypeIntSlice,
+}
+
+type ȧutoGeneratedTestGameStateReader struct {
+	data *testGameState
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedTestGameStateReaderProps
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) Prop(name string) (interface{}, error) {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return t.IntProp(name)
+	case boardgame.TypeBool:
+		return t.BoolProp(name)
+	case boardgame.TypeString:
+		return t.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return t.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return t.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return t.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return t.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return t.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return t.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return t.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return t.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return t.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) PropMutable(name string) bool {
+	switch name {
+	case "AuctionBid":
+		return true
+	case "AuctionBidder":
+		return true
+	case "AuctionInProgress":
+		return true
+	case "AuctionPassed":
+		return true
+	case "AuctionSealed":
+		return true
+	case "AuctionTurns":
+		return true
+	case "CurrentPlayer":
+		return true
+	case "Dice":
+		return true
+	case "DicePoolLocked":
+		return true
+	case "DicePoolRerolls":
+		return true
+	case "DicePoolRolled":
+		return true
+	case "TradeOfferedComponents":
+		return true
+	case "TradeOfferedResources":
+		return true
+	case "TradeOpen":
+		return true
+	case "TradeProposer":
+		return true
+	case "TradeRecipient":
+		return true
+	case "TradeRequestedComponents":
+		return true
+	case "TradeRequestedResources":
+		return true
+	}
+
+	return false
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetProp(name string, value interface{}) error {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return t.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return t.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return t.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return t.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return t.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return t.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureProp(name string, value interface{}) error {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return t.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return t.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return t.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return t.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return t.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return t.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return t.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return t.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return t.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return t.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return t.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return t.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return t.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return t.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "AuctionBid":
+		return t.data.AuctionBid, nil
+	case "AuctionTurns":
+		return t.data.AuctionTurns, nil
+	case "DicePoolRerolls":
+		return t.data.DicePoolRerolls, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "AuctionBid":
+		t.data.AuctionBid = value
+		return nil
+	case "AuctionTurns":
+		t.data.AuctionTurns = value
+		return nil
+	case "DicePoolRerolls":
+		t.data.DicePoolRerolls = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) BoolProp(name string) (bool, error) {
+
+	switch name {
+	case "AuctionInProgress":
+		return t.data.AuctionInProgress, nil
+	case "DicePoolRolled":
+		return t.data.DicePoolRolled, nil
+	case "TradeOpen":
+		return t.data.TradeOpen, nil
+
+	}
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetBoolProp(name string, value bool) error {
+
+	switch name {
+	case "AuctionInProgress":
+		t.data.AuctionInProgress = value
+		return nil
+	case "DicePoolRolled":
+		t.data.DicePoolRolled = value
+		return nil
+	case "TradeOpen":
+		t.data.TradeOpen = value
+		return nil
+
+	}
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "AuctionBidder":
+		return t.data.AuctionBidder, nil
+	case "CurrentPlayer":
+		return t.data.CurrentPlayer, nil
+	case "TradeProposer":
+		return t.data.TradeProposer, nil
+	case "TradeRecipient":
+		return t.data.TradeRecipient, nil
+
+	}
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	switch name {
+	case "AuctionBidder":
+		t.data.AuctionBidder = value
+		return nil
+	case "CurrentPlayer":
+		t.data.CurrentPlayer = value
+		return nil
+	case "TradeProposer":
+		t.data.TradeProposer = value
+		return nil
+	case "TradeRecipient":
+		t.data.TradeRecipient = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) IntSliceProp(name string) ([]int, error) {
+
+	switch name {
+	case "AuctionSealed":
+		return t.data.AuctionSealed, nil
+	case "TradeOfferedComponents":
+		return t.data.TradeOfferedComponents, nil
+	case "TradeOfferedResources":
+		return t.data.TradeOfferedResources, nil
+	case "TradeRequestedComponents":
+		return t.data.TradeRequestedComponents, nil
+	case "TradeRequestedResources":
+		return t.data.TradeRequestedResources, nil
+
+	}
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetIntSliceProp(name string, value []int) error {
+
+	switch name {
+	case "AuctionSealed":
+		t.data.AuctionSealed = value
+		return nil
+	case "TradeOfferedComponents":
+		t.data.TradeOfferedComponents = value
+		return nil
+	case "TradeOfferedResources":
+		t.data.TradeOfferedResources = value
+		return nil
+	case "TradeRequestedComponents":
+		t.data.TradeRequestedComponents = value
+		return nil
+	case "TradeRequestedResources":
+		t.data.TradeRequestedResources = value
+		return nil
+
+	}
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) BoolSliceProp(name string) ([]bool, error) {
+
+	switch name {
+	case "DicePoolLocked":
+		return t.data.DicePoolLocked, nil
+
+	}
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetBoolSliceProp(name string, value []bool) error {
+
+	switch name {
+	case "DicePoolLocked":
+		t.data.DicePoolLocked = value
+		return nil
+
+	}
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	switch name {
+	case "AuctionPassed":
+		return t.data.AuctionPassed, nil
+
+	}
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	switch name {
+	case "AuctionPassed":
+		t.data.AuctionPassed = value
+		return nil
+
+	}
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	switch name {
+	case "Dice":
+		return t.data.Dice, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	switch name {
+	case "Dice":
+		t.data.Dice = value
+		return nil
+
+	}
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	switch name {
+	case "Dice":
+		return boardgame.ErrPropertyImmutable
+
+	}
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) StackProp(name string) (boardgame.Stack, error) {
+
+	switch name {
+	case "Dice":
+		return t.data.Dice, nil
+
+	}
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestGameStateReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for testGameState
+func (t *testGameState) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedTestGameStateReader{t}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for testGameState
+func (t *testGameState) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedTestGameStateReader{t}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for testGameState
+func (t *testGameState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedTestGameStateReader{t}
+}
+
+// Implementation for testPlayerState
+
+var ȧutoGeneratedTestPlayerStateReaderProps = map[string]boardgame.PropertyType{
+	"Brick": boardgame.TypeInt,
+	"Wood":  boardgame.TypeInt,
+}
+
+type ȧutoGeneratedTestPlayerStateReader struct {
+	data *testPlayerState
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) Props() map[string]boardgame.PropertyType {
+	return ȧutoGeneratedTestPlayerStateReaderProps
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) Prop(name string) (interface{}, error) {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return nil, errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		return t.IntProp(name)
+	case boardgame.TypeBool:
+		return t.BoolProp(name)
+	case boardgame.TypeString:
+		return t.StringProp(name)
+	case boardgame.TypePlayerIndex:
+		return t.PlayerIndexProp(name)
+	case boardgame.TypeEnum:
+		return t.ImmutableEnumProp(name)
+	case boardgame.TypeIntSlice:
+		return t.IntSliceProp(name)
+	case boardgame.TypeBoolSlice:
+		return t.BoolSliceProp(name)
+	case boardgame.TypeStringSlice:
+		return t.StringSliceProp(name)
+	case boardgame.TypePlayerIndexSlice:
+		return t.PlayerIndexSliceProp(name)
+	case boardgame.TypeStack:
+		return t.ImmutableStackProp(name)
+	case boardgame.TypeBoard:
+		return t.ImmutableBoardProp(name)
+	case boardgame.TypeTimer:
+		return t.ImmutableTimerProp(name)
+
+	}
+
+	return nil, errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) PropMutable(name string) bool {
+	switch name {
+	case "Brick":
+		return true
+	case "Wood":
+		return true
+	}
+
+	return false
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetProp(name string, value interface{}) error {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return t.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return t.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return t.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return t.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return t.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return t.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeBoard:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+	case boardgame.TypeTimer:
+		return errors.New("SetProp does not allow setting mutable types; use ConfigureProp instead")
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureProp(name string, value interface{}) error {
+	props := t.Props()
+	propType, ok := props[name]
+
+	if !ok {
+		return errors.New("No such property with that name: " + name)
+	}
+
+	switch propType {
+	case boardgame.TypeInt:
+		val, ok := value.(int)
+		if !ok {
+			return errors.New("Provided value was not of type int")
+		}
+		return t.SetIntProp(name, val)
+	case boardgame.TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return errors.New("Provided value was not of type bool")
+		}
+		return t.SetBoolProp(name, val)
+	case boardgame.TypeString:
+		val, ok := value.(string)
+		if !ok {
+			return errors.New("Provided value was not of type string")
+		}
+		return t.SetStringProp(name, val)
+	case boardgame.TypePlayerIndex:
+		val, ok := value.(boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexProp(name, val)
+	case boardgame.TypeEnum:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(enum.Val)
+			if !ok {
+				return errors.New("Provided value was not of type enum.Val")
+			}
+			return t.ConfigureEnumProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(enum.ImmutableVal)
+		if !ok {
+			return errors.New("Provided value was not of type enum.ImmutableVal")
+		}
+		return t.ConfigureImmutableEnumProp(name, val)
+	case boardgame.TypeIntSlice:
+		val, ok := value.([]int)
+		if !ok {
+			return errors.New("Provided value was not of type []int")
+		}
+		return t.SetIntSliceProp(name, val)
+	case boardgame.TypeBoolSlice:
+		val, ok := value.([]bool)
+		if !ok {
+			return errors.New("Provided value was not of type []bool")
+		}
+		return t.SetBoolSliceProp(name, val)
+	case boardgame.TypeStringSlice:
+		val, ok := value.([]string)
+		if !ok {
+			return errors.New("Provided value was not of type []string")
+		}
+		return t.SetStringSliceProp(name, val)
+	case boardgame.TypePlayerIndexSlice:
+		val, ok := value.([]boardgame.PlayerIndex)
+		if !ok {
+			return errors.New("Provided value was not of type []boardgame.PlayerIndex")
+		}
+		return t.SetPlayerIndexSliceProp(name, val)
+	case boardgame.TypeStack:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Stack)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Stack")
+			}
+			return t.ConfigureStackProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableStack)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableStack")
+		}
+		return t.ConfigureImmutableStackProp(name, val)
+	case boardgame.TypeBoard:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Board)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Board")
+			}
+			return t.ConfigureBoardProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableBoard)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableBoard")
+		}
+		return t.ConfigureImmutableBoardProp(name, val)
+	case boardgame.TypeTimer:
+		if t.PropMutable(name) {
+			//Mutable variant
+			val, ok := value.(boardgame.Timer)
+			if !ok {
+				return errors.New("Provided value was not of type boardgame.Timer")
+			}
+			return t.ConfigureTimerProp(name, val)
+		}
+		//Immutable variant
+		val, ok := value.(boardgame.ImmutableTimer)
+		if !ok {
+			return errors.New("Provided value was not of type boardgame.ImmutableTimer")
+		}
+		return t.ConfigureImmutableTimerProp(name, val)
+
+	}
+
+	return errors.New("Unexpected property type: " + propType.String())
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) IntProp(name string) (int, error) {
+
+	switch name {
+	case "Brick":
+		return t.data.Brick, nil
+	case "Wood":
+		return t.data.Wood, nil
+
+	}
+
+	return 0, errors.New("No such Int prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetIntProp(name string, value int) error {
+
+	switch name {
+	case "Brick":
+		t.data.Brick = value
+		return nil
+	case "Wood":
+		t.data.Wood = value
+		return nil
+
+	}
+
+	return errors.New("No such Int prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) BoolProp(name string) (bool, error) {
+
+	return false, errors.New("No such Bool prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetBoolProp(name string, value bool) error {
+
+	return errors.New("No such Bool prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) StringProp(name string) (string, error) {
+
+	return "", errors.New("No such String prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetStringProp(name string, value string) error {
+
+	return errors.New("No such String prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) PlayerIndexProp(name string) (boardgame.PlayerIndex, error) {
+
+	return 0, errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetPlayerIndexProp(name string, value boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndex prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ImmutableEnumProp(name string) (enum.ImmutableVal, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureEnumProp(name string, value enum.Val) error {
+
+	return errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureImmutableEnumProp(name string, value enum.ImmutableVal) error {
+
+	return errors.New("No such ImmutableEnum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) EnumProp(name string) (enum.Val, error) {
+
+	return nil, errors.New("No such Enum prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) IntSliceProp(name string) ([]int, error) {
+
+	return []int{}, errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetIntSliceProp(name string, value []int) error {
+
+	return errors.New("No such IntSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) BoolSliceProp(name string) ([]bool, error) {
+
+	return []bool{}, errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetBoolSliceProp(name string, value []bool) error {
+
+	return errors.New("No such BoolSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) StringSliceProp(name string) ([]string, error) {
+
+	return []string{}, errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetStringSliceProp(name string, value []string) error {
+
+	return errors.New("No such StringSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) PlayerIndexSliceProp(name string) ([]boardgame.PlayerIndex, error) {
+
+	return []boardgame.PlayerIndex{}, errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) SetPlayerIndexSliceProp(name string, value []boardgame.PlayerIndex) error {
+
+	return errors.New("No such PlayerIndexSlice prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ImmutableStackProp(name string) (boardgame.ImmutableStack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureStackProp(name string, value boardgame.Stack) error {
+
+	return errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureImmutableStackProp(name string, value boardgame.ImmutableStack) error {
+
+	return errors.New("No such ImmutableStack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) StackProp(name string) (boardgame.Stack, error) {
+
+	return nil, errors.New("No such Stack prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ImmutableBoardProp(name string) (boardgame.ImmutableBoard, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureBoardProp(name string, value boardgame.Board) error {
+
+	return errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureImmutableBoardProp(name string, value boardgame.ImmutableBoard) error {
+
+	return errors.New("No such ImmutableBoard prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) BoardProp(name string) (boardgame.Board, error) {
+
+	return nil, errors.New("No such Board prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ImmutableTimerProp(name string) (boardgame.ImmutableTimer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureTimerProp(name string, value boardgame.Timer) error {
+
+	return errors.New("No such Timer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) ConfigureImmutableTimerProp(name string, value boardgame.ImmutableTimer) error {
+
+	return errors.New("No such ImmutableTimer prop: " + name)
+
+}
+
+func (t *ȧutoGeneratedTestPlayerStateReader) TimerProp(name string) (boardgame.Timer, error) {
+
+	return nil, errors.New("No such Timer prop: " + name)
+
+}
+
+//Reader returns an autp-generated boardgame.PropertyReader for testPlayerState
+func (t *testPlayerState) Reader() boardgame.PropertyReader {
+	return &ȧutoGeneratedTestPlayerStateReader{t}
+}
+
+//ReadSetter returns an autp-generated boardgame.PropertyReadSetter for testPlayerState
+func (t *testPlayerState) ReadSetter() boardgame.PropertyReadSetter {
+	return &ȧutoGeneratedTestPlayerStateReader{t}
+}
+
+//ReadSetConfigurer returns an autp-generated boardgame.PropertyReadSetConfigurer for testPlayerState
+func (t *testPlayerState) ReadSetConfigurer() boardgame.PropertyReadSetConfigurer {
+	return &ȧutoGeneratedTestPlayerStateReader{t}
+}
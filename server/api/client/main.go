@@ -0,0 +1,518 @@
+/*
+
+Package client is a typed Go client for the REST API of a running boardgame
+server, useful for scripting games, load tests and bots. The API it talks to
+is described by the OpenAPI document the server serves at /api/openapi.json.
+
+	c, err := client.New("http://localhost:8888")
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.Auth("my-uid", token, "", "", ""); err != nil {
+		return err
+	}
+
+	game, err := c.NewGame(&client.NewGameOptions{Manager: "tictactoe"})
+
+Every method returns an *Error if the server reported a failure.
+
+*/
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//CookieName is the name of the cookie the server keeps the signed in user's
+//session in. It matches the api package's.
+const CookieName = "c"
+
+const (
+	statusSuccess = "Success"
+	statusFailure = "Failure"
+)
+
+//Error is a failure reported by the server.
+type Error struct {
+	//Message is the full error, for debugging.
+	Message string `json:"Error"`
+	//FriendlyMessage is a version of the error appropriate to show to users.
+	FriendlyMessage string `json:"FriendlyError"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+//Client talks to a single server. It keeps track of the auth cookie the
+//server sets, so once Auth succeeds later calls are made as that user. A
+//Client is safe to use from multiple goroutines.
+type Client struct {
+	base *url.URL
+	http *http.Client
+}
+
+//New returns a client for the server at baseURL, for example
+//"http://localhost:8888". It returns an error if baseURL isn't a valid URL.
+func New(baseURL string) (*Client, error) {
+
+	base, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+
+	if err != nil {
+		return nil, errors.New("Invalid base URL: " + err.Error())
+	}
+
+	jar, _ := cookiejar.New(nil)
+
+	return &Client{
+		base: base,
+		http: &http.Client{
+			Jar: jar,
+		},
+	}, nil
+}
+
+//AuthCookie returns the value of the auth cookie the server set, or "" if
+//there isn't one. It can be passed to SetAuthCookie on another Client to act
+//as the same user.
+func (c *Client) AuthCookie() string {
+	for _, cookie := range c.http.Jar.Cookies(c.base) {
+		if cookie.Name == CookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+//SetAuthCookie makes future calls as the user the given auth cookie belongs
+//to.
+func (c *Client) SetAuthCookie(value string) {
+	c.http.Jar.SetCookies(c.base, []*http.Cookie{
+		{
+			Name:  CookieName,
+			Value: value,
+			Path:  "/",
+		},
+	})
+}
+
+func (c *Client) endpoint(path string, query url.Values) string {
+	result := c.base.String() + "/api/" + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		result += "?" + query.Encode()
+	}
+	return result
+}
+
+//Get calls the endpoint at path (relative to /api/) with the given query
+//and decodes the Success payload into result, which may be nil. It's useful
+//for endpoints that don't have a typed method.
+func (c *Client) Get(path string, query url.Values, result interface{}) error {
+	resp, err := c.http.Get(c.endpoint(path, query))
+	if err != nil {
+		return err
+	}
+	return decode(resp, result)
+}
+
+//Post is like Get, but posts form to the endpoint.
+func (c *Client) Post(path string, query url.Values, form url.Values, result interface{}) error {
+	resp, err := c.http.PostForm(c.endpoint(path, query), form)
+	if err != nil {
+		return err
+	}
+	return decode(resp, result)
+}
+
+//decode reads resp's body and decodes it into result if it's a Success, or
+//returns an *Error if it's a Failure.
+func decode(resp *http.Response, result interface{}) error {
+	defer resp.Body.Close()
+
+	blob, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return errors.New("Couldn't read response: " + err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("Unexpected status " + resp.Status + ": " + string(blob))
+	}
+
+	var envelope struct {
+		Status string
+		Error
+	}
+
+	if err := json.Unmarshal(blob, &envelope); err != nil {
+		return errors.New("Couldn't decode response: " + err.Error())
+	}
+
+	switch envelope.Status {
+	case statusFailure:
+		return &envelope.Error
+	case statusSuccess:
+	default:
+		return errors.New("Response had unexpected status " + envelope.Status)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(blob, result); err != nil {
+		return errors.New("Couldn't decode response payload: " + err.Error())
+	}
+
+	return nil
+}
+
+func flag(val bool) string {
+	if val {
+		return "1"
+	}
+	return "0"
+}
+
+func gamePath(gameName, gameID string, rest string) string {
+	return "game/" + url.PathEscape(gameName) + "/" + url.PathEscape(gameID) + "/" + rest
+}
+
+//AuthResponse is the result of Auth.
+type AuthResponse struct {
+	//User is nil if no one is signed in.
+	User         *users.StorageRecord
+	AdminAllowed bool
+	Message      string
+}
+
+//Auth signs in as the user with the given uid, verified by token (a
+//Firebase ID token, which servers in offline dev mode don't check). The
+//other fields are only used if this is the first time the server has seen
+//the user.
+func (c *Client) Auth(uid, token, email, photoURL, displayName string) (*AuthResponse, error) {
+	result := &AuthResponse{}
+	form := url.Values{
+		"uid":         {uid},
+		"token":       {token},
+		"email":       {email},
+		"photo":       {photoURL},
+		"displayname": {displayName},
+	}
+	if err := c.Post("auth", nil, form, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+//SignOut signs the current user out.
+func (c *Client) SignOut() error {
+	return c.Post("auth", nil, url.Values{}, nil)
+}
+
+//Player is the public information about a seat in a game.
+type Player struct {
+	DisplayName string
+	IsAgent     bool
+	IsEmpty     bool
+	PhotoURL    string
+}
+
+//GameListing is a game as it appears in a GameLists.
+type GameListing struct {
+	extendedgame.CombinedStorageRecord
+	Players              []*Player
+	ReadableLastActivity string
+	HasPassword          bool
+}
+
+//GameLists is the result of ListGames.
+type GameLists struct {
+	ParticipatingActiveGames   []*GameListing
+	ParticipatingFinishedGames []*GameListing
+	VisibleJoinableActiveGames []*GameListing
+	VisibleActiveGames         []*GameListing
+	//AllGames is only set for admins who asked for admin privileges.
+	AllGames []*extendedgame.CombinedStorageRecord
+}
+
+//ListGames lists games relevant to the signed in user. If gameName is not
+//"", only games of that type are listed. If admin is true and the user is
+//allowed to be an admin, AllGames is set, too.
+func (c *Client) ListGames(gameName string, admin bool) (*GameLists, error) {
+	query := url.Values{}
+	if gameName != "" {
+		query.Set("name", gameName)
+	}
+	if admin {
+		query.Set("admin", "1")
+	}
+	result := &GameLists{}
+	if err := c.Get("list/game", query, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+//Agent is an agent that can play a type of game.
+type Agent struct {
+	Name        string
+	DisplayName string
+}
+
+//VariantValue is one of the values a Variant can be set to.
+type VariantValue struct {
+	Value       string
+	DisplayName string
+	Description string
+}
+
+//Variant is a way a type of game can be configured. The first of its Values
+//is the default.
+type Variant struct {
+	Name        string
+	DisplayName string
+	Description string
+	Values      []*VariantValue
+}
+
+//Manager describes a type of game the server can create.
+type Manager struct {
+	Name              string
+	DisplayName       string
+	Description       string
+	DefaultNumPlayers int
+	MinNumPlayers     int
+	MaxNumPlayers     int
+	Agents            []*Agent
+	Variant           []*Variant
+}
+
+//ListManagers lists the types of games the server can create.
+func (c *Client) ListManagers() ([]*Manager, error) {
+	var result struct {
+		Managers []*Manager
+	}
+	if err := c.Get("list/manager", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Managers, nil
+}
+
+//NewGameOptions configures NewGame.
+type NewGameOptions struct {
+	//Manager is the type of game to create. Required.
+	Manager string
+	//NumPlayers defaults to the type's default if 0.
+	NumPlayers int
+	//Agents is the name of the agent to play each seat, or "" for a human.
+	Agents []string
+	//Variant maps variant names to values. Variants that aren't set have
+	//their default value.
+	Variant map[string]string
+	Open    bool
+	Visible bool
+}
+
+//GameRef identifies a game.
+type GameRef struct {
+	GameID   string
+	GameName string
+}
+
+//NewGame creates a game owned by the signed in user.
+func (c *Client) NewGame(options *NewGameOptions) (*GameRef, error) {
+	if options == nil {
+		return nil, errors.New("No options provided")
+	}
+	form := url.Values{
+		"manager": {options.Manager},
+		"open":    {flag(options.Open)},
+		"visible": {flag(options.Visible)},
+	}
+	if options.NumPlayers > 0 {
+		form.Set("numplayers", strconv.Itoa(options.NumPlayers))
+	}
+	for i, agent := range options.Agents {
+		form.Set("agent-player-"+strconv.Itoa(i), agent)
+	}
+	for key, val := range options.Variant {
+		form.Set("variant_"+key, val)
+	}
+	result := &GameRef{}
+	if err := c.Post("new/game", nil, form, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+//MoveFormField is a field of a MoveForm.
+type MoveFormField struct {
+	Name         string
+	Type         boardgame.PropertyType
+	EnumName     string
+	DefaultValue interface{}
+}
+
+//MoveForm describes a move that players may propose, and what values it
+//needs.
+type MoveForm struct {
+	Name                string
+	HelpText            string
+	Fields              []*MoveFormField
+	LegalForPlayer      bool
+	LegalForPlayerError string
+	LegalForAnyone      bool
+}
+
+//ViewOptions configures how a game is viewed. The zero value views it as
+//the signed in user's player, or as an observer if they aren't playing.
+type ViewOptions struct {
+	//Admin asks for admin privileges, which are only granted if the signed
+	//in user is allowed them.
+	Admin bool
+	//Player is the player to view the game as. Only used if Admin.
+	Player boardgame.PlayerIndex
+	//AutoCurrentPlayer views each state as its current player. Only used if
+	//Admin.
+	AutoCurrentPlayer bool
+	//FromVersion is the version the caller already has.
+	FromVersion int
+}
+
+func (v *ViewOptions) query() url.Values {
+	result := url.Values{}
+	if v == nil {
+		return result
+	}
+	if v.Admin {
+		result.Set("admin", "1")
+		result.Set("player", strconv.Itoa(int(v.Player)))
+		if v.AutoCurrentPlayer {
+			result.Set("current", "1")
+		}
+	}
+	if v.FromVersion != 0 {
+		result.Set("from", strconv.Itoa(v.FromVersion))
+	}
+	return result
+}
+
+//GameInfo is the result of GameInfo.
+type GameInfo struct {
+	//Chest is the game type's component chest.
+	Chest json.RawMessage
+	Forms []*MoveForm
+	//Game is the game, sanitized for ViewingAsPlayer.
+	Game            json.RawMessage
+	Error           string
+	Players         []*Player
+	ViewingAsPlayer boardgame.PlayerIndex
+	HasEmptySlots   bool
+	GameOpen        bool
+	GameVisible     bool
+	GameHasPassword bool
+	IsOwner         bool
+	StateVersion    int
+	//Invites is only set for the game's owner.
+	Invites []*extendedgame.Invite
+}
+
+//GameInfo returns everything needed to render the given game.
+func (c *Client) GameInfo(gameName, gameID string, options *ViewOptions) (*GameInfo, error) {
+	result := &GameInfo{}
+	if err := c.Get(gamePath(gameName, gameID, "info"), options.query(), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+//Bundle is the game as of one move, as returned by GameVersion.
+type Bundle struct {
+	Game            json.RawMessage
+	Move            *boardgame.MoveStorageRecord
+	ViewingAsPlayer boardgame.PlayerIndex
+	Forms           []*MoveForm
+}
+
+//GameVersion returns a Bundle for each move after options.FromVersion up to
+//and including version.
+func (c *Client) GameVersion(gameName, gameID string, version int, options *ViewOptions) ([]*Bundle, error) {
+	var result struct {
+		Bundles []*Bundle
+	}
+	if err := c.Get(gamePath(gameName, gameID, "version/"+strconv.Itoa(version)), options.query(), &result); err != nil {
+		return nil, err
+	}
+	return result.Bundles, nil
+}
+
+//ProposeMove proposes the move with the given name, with the given values
+//for its fields (bools are "1" or "0"), as the signed in user's player. If
+//options is non-nil and Admin, it's proposed as options.Player instead.
+func (c *Client) ProposeMove(gameName, gameID, moveType string, fields map[string]string, options *ViewOptions) error {
+	form := url.Values{
+		"MoveType": {moveType},
+	}
+	for key, val := range fields {
+		form.Set(key, val)
+	}
+	return c.Post(gamePath(gameName, gameID, "move"), options.query(), form, nil)
+}
+
+//JoinGame seats the signed in user in an empty seat of the given game. invite
+//and password may be "" if the game doesn't need them.
+func (c *Client) JoinGame(gameName, gameID, invite, password string) error {
+	form := url.Values{}
+	if invite != "" {
+		form.Set("invite", invite)
+	}
+	if password != "" {
+		form.Set("password", password)
+	}
+	return c.Post(gamePath(gameName, gameID, "join"), nil, form, nil)
+}
+
+//ConfigureGame sets whether the given game is open and visible. Only the
+//game's owner (or an admin, if admin is true) may do this.
+func (c *Client) ConfigureGame(gameName, gameID string, open, visible, admin bool) error {
+	query := url.Values{}
+	if admin {
+		query.Set("admin", "1")
+	}
+	form := url.Values{
+		"open":    {flag(open)},
+		"visible": {flag(visible)},
+	}
+	return c.Post(gamePath(gameName, gameID, "configure"), query, form, nil)
+}
+
+//OpenAPI returns the server's OpenAPI document.
+func (c *Client) OpenAPI() (json.RawMessage, error) {
+	resp, err := c.http.Get(c.endpoint("openapi.json", nil))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	blob, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("Couldn't read response: " + err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("Unexpected status " + resp.Status)
+	}
+	return json.RawMessage(blob), nil
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/workfit/tester/assert"
+)
+
+//fakeServer answers the handful of endpoints the tests need the way a real
+//server would.
+func fakeServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+
+	respond := func(w http.ResponseWriter, payload map[string]interface{}) {
+		blob, err := json.Marshal(payload)
+		assert.For(t).ThatActual(err).IsNil()
+		w.Write(blob)
+	}
+
+	mux.HandleFunc("/api/auth", func(w http.ResponseWriter, r *http.Request) {
+		assert.For(t).ThatActual(r.Method).Equals(http.MethodPost)
+		http.SetCookie(w, &http.Cookie{Name: CookieName, Value: "cookie-" + r.PostFormValue("uid"), Path: "/"})
+		respond(w, map[string]interface{}{
+			"Status": "Success",
+			"User": map[string]interface{}{
+				"ID":          r.PostFormValue("uid"),
+				"DisplayName": r.PostFormValue("displayname"),
+			},
+			"AdminAllowed": false,
+			"Message":      "Created new cookie to point to uid",
+		})
+	})
+
+	mux.HandleFunc("/api/new/game", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(CookieName)
+		if err != nil {
+			respond(w, map[string]interface{}{
+				"Status":        "Failure",
+				"Error":         "No user",
+				"FriendlyError": "You must be signed in to create a game.",
+			})
+			return
+		}
+		assert.For(t).ThatActual(cookie.Value).Equals("cookie-alice")
+		assert.For(t).ThatActual(r.PostFormValue("manager")).Equals("tictactoe")
+		assert.For(t).ThatActual(r.PostFormValue("agent-player-1")).Equals("ai")
+		assert.For(t).ThatActual(r.PostFormValue("variant_color")).Equals("blue")
+		assert.For(t).ThatActual(r.PostFormValue("open")).Equals("1")
+		respond(w, map[string]interface{}{
+			"Status":   "Success",
+			"GameID":   "ABC",
+			"GameName": "tictactoe",
+		})
+	})
+
+	mux.HandleFunc("/api/game/tictactoe/ABC/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.For(t).ThatActual(r.URL.Query().Get("admin")).Equals("1")
+		assert.For(t).ThatActual(r.URL.Query().Get("player")).Equals("1")
+		respond(w, map[string]interface{}{
+			"Status":          "Success",
+			"Game":            map[string]interface{}{"Version": 3},
+			"ViewingAsPlayer": 1,
+			"StateVersion":    3,
+			"Forms": []map[string]interface{}{
+				{"Name": "Place Token", "LegalForPlayer": true},
+			},
+		})
+	})
+
+	mux.HandleFunc("/api/game/tictactoe/ABC/move", func(w http.ResponseWriter, r *http.Request) {
+		assert.For(t).ThatActual(r.PostFormValue("MoveType")).Equals("Place Token")
+		assert.For(t).ThatActual(r.PostFormValue("Slot")).Equals("4")
+		respond(w, map[string]interface{}{
+			"Status":        "Failure",
+			"Error":         "Slot taken",
+			"FriendlyError": "That slot is already taken.",
+		})
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	server := fakeServer(t)
+	defer server.Close()
+
+	c, err := New(server.URL)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	_, err = c.NewGame(&NewGameOptions{Manager: "tictactoe"})
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(err.(*Error).FriendlyMessage).Equals("You must be signed in to create a game.")
+
+	auth, err := c.Auth("alice", "token", "", "", "Alice")
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(auth.User.ID).Equals("alice")
+	assert.For(t).ThatActual(auth.User.DisplayName).Equals("Alice")
+	assert.For(t).ThatActual(c.AuthCookie()).Equals("cookie-alice")
+
+	ref, err := c.NewGame(&NewGameOptions{
+		Manager: "tictactoe",
+		Agents:  []string{"", "ai"},
+		Variant: map[string]string{"color": "blue"},
+		Open:    true,
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(ref.GameID).Equals("ABC")
+
+	info, err := c.GameInfo(ref.GameName, ref.GameID, &ViewOptions{Admin: true, Player: 1})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(int(info.ViewingAsPlayer)).Equals(1)
+	assert.For(t).ThatActual(info.StateVersion).Equals(3)
+	assert.For(t).ThatActual(info.Forms[0].LegalForPlayer).IsTrue()
+	assert.For(t).ThatActual(string(info.Game)).Equals(`{"Version":3}`)
+
+	err = c.ProposeMove(ref.GameName, ref.GameID, "Place Token", map[string]string{"Slot": "4"}, nil)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(err.Error()).Equals("Slot taken")
+
+	other, err := New(server.URL)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	other.SetAuthCookie(c.AuthCookie())
+
+	_, err = other.NewGame(&NewGameOptions{
+		Manager: "tictactoe",
+		Agents:  []string{"", "ai"},
+		Variant: map[string]string{"color": "blue"},
+		Open:    true,
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+}
+
+func TestNewInvalidURL(t *testing.T) {
+	c, err := New("://localhost")
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(c == nil).IsTrue()
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jkomoros/boardgame"
 	"github.com/jkomoros/boardgame/base"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 )
 
@@ -18,6 +21,7 @@ const (
 	ctxViewingPlayerAsKey = "ctxViewingPlayerAs"
 	ctxUserKey            = "ctxUser"
 	ctxHasEmptySlots      = "ctxHasEmptySlots"
+	ctxTournamentKey      = "ctxTournament"
 )
 
 const (
@@ -33,6 +37,23 @@ const (
 	qryOpen                 = "open"
 	qryVisible              = "visible"
 	qryFromVersion          = "from"
+	qryUserIDKey            = "userid"
+	qryNotifyByEmail        = "email"
+	qryNotifyWebhook        = "webhook"
+	qryInvite               = "invite"
+	qryPassword             = "password"
+	qrySeat                 = "seat"
+	qryAgentName            = "agent"
+	qryTournamentKey        = "tournament"
+	qryTournamentName       = "tournamentname"
+	qryFormat               = "format"
+	qryRounds               = "rounds"
+	qrySearch               = "q"
+	qryBanned               = "banned"
+	qryWinners              = "winners"
+	qryOwner                = "owner"
+	qryReason               = "reason"
+	qryConfirm              = "confirm"
 )
 
 const (
@@ -153,10 +174,173 @@ func (s *Server) getRequestVisible(c *gin.Context) bool {
 	return visibleInt > 0
 }
 
+//getRequestNotifyByEmail returns nil if the preference wasn't sent.
+func (s *Server) getRequestNotifyByEmail(c *gin.Context) *bool {
+	notifyByEmail, ok := c.GetPostForm(qryNotifyByEmail)
+
+	if !ok {
+		return nil
+	}
+
+	result := false
+
+	if notifyByEmailInt, err := strconv.Atoi(notifyByEmail); err == nil {
+		result = notifyByEmailInt > 0
+	}
+
+	return &result
+}
+
+//getRequestNotifyWebhook returns nil if the preference wasn't sent.
+func (s *Server) getRequestNotifyWebhook(c *gin.Context) *string {
+	webhookURL, ok := c.GetPostForm(qryNotifyWebhook)
+
+	if !ok {
+		return nil
+	}
+
+	return &webhookURL
+}
+
+func (s *Server) getRequestInvite(c *gin.Context) string {
+	result := c.Query(qryInvite)
+	if result != "" {
+		return result
+	}
+	return c.PostForm(qryInvite)
+}
+
+func (s *Server) getRequestPassword(c *gin.Context) string {
+	return c.PostForm(qryPassword)
+}
+
+//getRequestAgentName returns the name of the agent that should take over a
+//seat, or "" if none was provided.
+func (s *Server) getRequestAgentName(c *gin.Context) string {
+	return c.PostForm(qryAgentName)
+}
+
+//getRequestSeat returns the seat the request is about, or
+//extendedgame.AnySeat if none was provided.
+func (s *Server) getRequestSeat(c *gin.Context) int {
+	rawVal := c.PostForm(qrySeat)
+
+	if rawVal == "" {
+		return extendedgame.AnySeat
+	}
+
+	seat, err := strconv.Atoi(rawVal)
+
+	if err != nil {
+		return extendedgame.AnySeat
+	}
+
+	return seat
+}
+
+func (s *Server) getRequestTournamentName(c *gin.Context) string {
+	return c.PostForm(qryTournamentName)
+}
+
+func (s *Server) getRequestFormat(c *gin.Context) tournaments.Format {
+	return tournaments.Format(c.PostForm(qryFormat))
+}
+
+//getRequestRounds returns the number of rounds requested for a Swiss
+//tournament, or 0 if none was provided.
+func (s *Server) getRequestRounds(c *gin.Context) int {
+	rawVal := c.PostForm(qryRounds)
+
+	if rawVal == "" {
+		return 0
+	}
+
+	rounds, err := strconv.Atoi(rawVal)
+
+	if err != nil {
+		return 0
+	}
+
+	return rounds
+}
+
+func (s *Server) getRequestSearch(c *gin.Context) string {
+	return c.Query(qrySearch)
+}
+
+func (s *Server) getRequestBanned(c *gin.Context) bool {
+	banned, _ := s.getRequestOptionalFlag(c, qryBanned)
+	return banned
+}
+
+//getRequestOptionalFlag returns whether the posted form value for key is a
+//positive integer, and whether it was provided at all.
+func (s *Server) getRequestOptionalFlag(c *gin.Context, key string) (value bool, ok bool) {
+	rawVal, ok := c.GetPostForm(key)
+
+	if !ok {
+		return false, false
+	}
+
+	intVal, err := strconv.Atoi(rawVal)
+
+	if err != nil {
+		return false, true
+	}
+
+	return intVal > 0, true
+}
+
+//getRequestWinners returns the player indexes in the comma separated list of
+//winners. An empty list is a draw.
+func (s *Server) getRequestWinners(c *gin.Context) ([]boardgame.PlayerIndex, error) {
+	rawVal := c.PostForm(qryWinners)
+
+	if rawVal == "" {
+		return nil, nil
+	}
+
+	var result []boardgame.PlayerIndex
+
+	for _, item := range strings.Split(rawVal, ",") {
+		index, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil {
+			return nil, errors.New("Invalid winner: " + item)
+		}
+		result = append(result, boardgame.PlayerIndex(index))
+	}
+
+	return result, nil
+}
+
+//getRequestOwner returns the posted new owner of a game, and whether one was
+//provided at all.
+func (s *Server) getRequestOwner(c *gin.Context) (string, bool) {
+	return c.GetPostForm(qryOwner)
+}
+
+//getRequestSeatUserID returns the posted ID of the user who should sit in a
+//seat, or "" to empty it.
+func (s *Server) getRequestSeatUserID(c *gin.Context) string {
+	return c.PostForm(qryUserIDKey)
+}
+
+func (s *Server) getRequestReason(c *gin.Context) string {
+	return c.PostForm(qryReason)
+}
+
+func (s *Server) getRequestTournamentID(c *gin.Context) string {
+	return c.Param(qryTournamentKey)
+}
+
 func (s *Server) getRequestGameID(c *gin.Context) string {
 	return c.Param(qryGameIDKey)
 }
 
+func (s *Server) getRequestUserID(c *gin.Context) string {
+	return c.Param(qryUserIDKey)
+}
+
 func (s *Server) getRequestGameName(c *gin.Context) string {
 	result := c.Param(qryGameNameKey)
 	if result != "" {
@@ -216,6 +400,26 @@ func (s *Server) getGame(c *gin.Context) *boardgame.Game {
 	return game
 }
 
+func (s *Server) setTournament(c *gin.Context, tournament *tournaments.StorageRecord) {
+	c.Set(ctxTournamentKey, tournament)
+}
+
+func (s *Server) getTournament(c *gin.Context) *tournaments.StorageRecord {
+	obj, ok := c.Get(ctxTournamentKey)
+
+	if !ok {
+		return nil
+	}
+
+	tournament, ok := obj.(*tournaments.StorageRecord)
+
+	if !ok {
+		return nil
+	}
+
+	return tournament
+}
+
 func (s *Server) setViewingAsPlayer(c *gin.Context, playerIndex boardgame.PlayerIndex) {
 	c.Set(ctxViewingPlayerAsKey, playerIndex)
 }
@@ -425,8 +629,14 @@ func (s *Server) getAdminAllowed(c *gin.Context) bool {
 }
 
 func (s *Server) getMoveFromForm(c *gin.Context, game *boardgame.Game) (boardgame.Move, error) {
+	return s.calcMove(game, c.PostForm("MoveType"), c.PostForm)
+}
+
+//calcMove returns the move with the given name, with each of its form fields
+//set from value(fieldName), formatted the way the move endpoint expects.
+func (s *Server) calcMove(game *boardgame.Game, moveType string, value func(fieldName string) string) (boardgame.Move, error) {
 
-	move := game.MoveByName(c.PostForm("MoveType"))
+	move := game.MoveByName(moveType)
 
 	if move == nil {
 		return nil, errors.New("Invalid MoveType")
@@ -440,7 +650,7 @@ func (s *Server) getMoveFromForm(c *gin.Context, game *boardgame.Game) (boardgam
 
 	for _, field := range formFields(move) {
 
-		rawVal := c.PostForm(field.Name)
+		rawVal := value(field.Name)
 
 		switch field.Type {
 		case boardgame.TypeInt:
@@ -483,6 +693,21 @@ func (s *Server) getMoveFromForm(c *gin.Context, game *boardgame.Game) (boardgam
 			if err := move.ReadSetter().SetBoolProp(field.Name, val); err != nil {
 				return nil, errors.New("Couldnt set bool prop " + field.Name + ": " + err.Error())
 			}
+		case boardgame.TypeIntSlice:
+			//Int slices are comma separated, and empty means an empty slice.
+			var nums []int
+			if rawVal != "" {
+				for _, part := range strings.Split(rawVal, ",") {
+					num, err := strconv.Atoi(strings.TrimSpace(part))
+					if err != nil {
+						return nil, errors.New("Couldn't set field " + field.Name + " " + err.Error())
+					}
+					nums = append(nums, num)
+				}
+			}
+			if err := move.ReadSetter().SetIntSliceProp(field.Name, nums); err != nil {
+				return nil, errors.New("Couldn't set int slice prop " + field.Name + " " + err.Error())
+			}
 		case boardgame.TypeEnum:
 			eVar, err := move.ReadSetter().EnumProp(field.Name)
 			if err != nil {
@@ -7,17 +7,70 @@ separate package to avoid dependency cycles.
 package extendedgame
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"time"
 
 	"github.com/jkomoros/boardgame"
+	"golang.org/x/crypto/bcrypt"
 )
 
+//AnySeat is the Seat of an Invite that may be used to join any empty seat.
+const AnySeat = -1
+
+const inviteTokenLength = 16
+
 //StorageRecord is the extra information the server wants stored along with the
 //game.
 type StorageRecord struct {
 	Open    bool
 	Visible bool
 	Owner   string
+	//PasswordHash is the bcrypt hash of the password required to join the
+	//game, or "" if no password is required. Set it with SetPassword. Users
+	//with a valid invite don't need the password.
+	PasswordHash string `json:",omitempty"`
+	//Invites are the outstanding invite tokens for this game. Users with a
+	//valid invite may join even if the game isn't Open.
+	Invites []*Invite `json:",omitempty"`
+	InactivityClock
+	//TournamentID is the ID of the tournament this game is a match in, or ""
+	//if it isn't part of a tournament.
+	TournamentID string `json:",omitempty"`
+}
+
+//InactivityClock is the part of a StorageRecord the server uses to notice
+//players who stop taking their turns. It changes on every move, so it's
+//saved on its own with UpdateInactivityClock, and UpdateExtendedGame leaves it
+//alone.
+type InactivityClock struct {
+	//SeatLastMove is, for each seat, the UnixNano time the player in it last
+	//made a move (or was seated). Only tracked for game types with an
+	//inactivity policy.
+	SeatLastMove []int64 `json:",omitempty"`
+	//TurnStarted is the UnixNano time of the most recent player move. The
+	//current player's inactivity clock runs from here.
+	TurnStarted int64 `json:",omitempty"`
+	//InactivityDeadline is the UnixNano time at which the server should next
+	//check whether the current player has been inactive for too long, or 0
+	//if no check is pending. It's stored so pending checks survive restarts.
+	InactivityDeadline int64 `json:",omitempty"`
+	//InactivityWarned is whether the current player has already been warned
+	//that they're about to time out.
+	InactivityWarned bool `json:",omitempty"`
+}
+
+//Invite is a token that allows whoever has it to join a game, optionally only
+//in a specific seat.
+type Invite struct {
+	Token string
+	//Seat is the player index the invite is for, or AnySeat. Invites for a
+	//specific seat are single use; invites for AnySeat may be used until they
+	//are revoked.
+	Seat    int
+	Created int64
 }
 
 //CombinedStorageRecord combines the base GameStorageRecord and StorageRecord
@@ -37,6 +90,87 @@ func DefaultStorageRecord() *StorageRecord {
 	}
 }
 
+//randomHex returns a hex encoded string of numBytes of cryptographically
+//secure random bytes.
+func randomHex(numBytes int) (string, error) {
+	bytes := make([]byte, numBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+//SetPassword sets the password required to join the game. If password is "",
+//the game no longer requires a password.
+func (s *StorageRecord) SetPassword(password string) error {
+	if password == "" {
+		s.PasswordHash = ""
+		return nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	s.PasswordHash = string(hash)
+	return nil
+}
+
+//CheckPassword returns true if the game doesn't require a password, or if
+//password is the game's password.
+func (s *StorageRecord) CheckPassword(password string) bool {
+	if s.PasswordHash == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(s.PasswordHash), []byte(password)) == nil
+}
+
+//AddInvite creates a new invite for the given seat (or AnySeat), adds it to
+//the record, and returns it.
+func (s *StorageRecord) AddInvite(seat int) (*Invite, error) {
+	token, err := randomHex(inviteTokenLength)
+	if err != nil {
+		return nil, err
+	}
+	invite := &Invite{
+		Token:   token,
+		Seat:    seat,
+		Created: time.Now().UnixNano(),
+	}
+	s.Invites = append(s.Invites, invite)
+	return invite, nil
+}
+
+//Invite returns the outstanding invite with the given token, or nil if there
+//isn't one.
+func (s *StorageRecord) Invite(token string) *Invite {
+	if token == "" {
+		return nil
+	}
+	for _, invite := range s.Invites {
+		if subtle.ConstantTimeCompare([]byte(invite.Token), []byte(token)) == 1 {
+			return invite
+		}
+	}
+	return nil
+}
+
+//RevokeInvite removes the invite with the given token. Returns false if there
+//was no such invite.
+func (s *StorageRecord) RevokeInvite(token string) bool {
+	invite := s.Invite(token)
+	if invite == nil {
+		return false
+	}
+	var invites []*Invite
+	for _, other := range s.Invites {
+		if other != invite {
+			invites = append(invites, other)
+		}
+	}
+	s.Invites = invites
+	return true
+}
+
 func (c *CombinedStorageRecord) String() string {
 	blob, _ := json.Marshal(c)
 	return string(blob) + "\n"
@@ -0,0 +1,58 @@
+package extendedgame
+
+import (
+	"testing"
+
+	"github.com/workfit/tester/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPassword(t *testing.T) {
+	record := DefaultStorageRecord()
+
+	assert.For(t).ThatActual(record.CheckPassword("")).IsTrue()
+	assert.For(t).ThatActual(record.CheckPassword("foo")).IsTrue()
+
+	err := record.SetPassword("secret")
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(record.PasswordHash).DoesNotEqual("")
+	assert.For(t).ThatActual(record.PasswordHash).DoesNotEqual("secret")
+
+	cost, err := bcrypt.Cost([]byte(record.PasswordHash))
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(cost).Equals(bcrypt.DefaultCost)
+
+	assert.For(t).ThatActual(record.CheckPassword("secret")).IsTrue()
+	assert.For(t).ThatActual(record.CheckPassword("Secret")).IsFalse()
+	assert.For(t).ThatActual(record.CheckPassword("")).IsFalse()
+
+	record.SetPassword("")
+
+	assert.For(t).ThatActual(record.CheckPassword("anything")).IsTrue()
+}
+
+func TestInvites(t *testing.T) {
+	record := DefaultStorageRecord()
+
+	assert.For(t).ThatActual(record.Invite("") == nil).IsTrue()
+
+	anySeat, err := record.AddInvite(AnySeat)
+	assert.For(t).ThatActual(err).IsNil()
+
+	seatOne, err := record.AddInvite(1)
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(anySeat.Token).DoesNotEqual(seatOne.Token)
+	assert.For(t).ThatActual(len(anySeat.Token)).Equals(inviteTokenLength * 2)
+
+	assert.For(t).ThatActual(record.Invite(anySeat.Token)).Equals(anySeat)
+	assert.For(t).ThatActual(record.Invite(seatOne.Token).Seat).Equals(1)
+	assert.For(t).ThatActual(record.Invite("bogus") == nil).IsTrue()
+
+	assert.For(t).ThatActual(record.RevokeInvite(anySeat.Token)).IsTrue()
+	assert.For(t).ThatActual(record.RevokeInvite(anySeat.Token)).IsFalse()
+	assert.For(t).ThatActual(record.Invite(anySeat.Token) == nil).IsTrue()
+	assert.For(t).ThatActual(record.Invites).Equals([]*Invite{seatOne})
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/boardgame-util/lib/config"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/sirupsen/logrus"
+)
+
+//Frontend is an additional way of serving the API alongside the REST
+//endpoints, for example the gRPC service in server/grpc. Frontends share the
+//server's storage, managers and auth via the exported methods in this file,
+//which behave the same as the corresponding REST endpoints.
+type Frontend interface {
+	//Start is called in its own goroutine by Server.Start, once config is
+	//loaded and storage is connected. It should serve until the server shuts
+	//down. Frontends that aren't configured to run should return nil
+	//immediately.
+	Start(s *Server) error
+}
+
+//AddFrontend adds a Frontend that will be started alongside the REST
+//endpoints. We return a reference to ourself to allow chaining of
+//configurations.
+func (s *Server) AddFrontend(frontend Frontend) *Server {
+	s.frontends = append(s.frontends, frontend)
+	return s
+}
+
+//startFrontends starts each frontend in its own goroutine. Called by Start().
+func (s *Server) startFrontends() {
+	for _, frontend := range s.frontends {
+		go func(frontend Frontend) {
+			if err := frontend.Start(s); err != nil {
+				s.logger.Errorln("Frontend stopped: " + err.Error())
+			}
+		}(frontend)
+	}
+}
+
+//Config returns the config for the mode the server is running in. It's nil
+//until Start is called.
+func (s *Server) Config() *config.Mode {
+	return s.config
+}
+
+//Logger returns the logger the server logs to.
+func (s *Server) Logger() *logrus.Logger {
+	return s.logger
+}
+
+//UserForCookie returns the user the given auth cookie belongs to, noting
+//that they were just seen, or nil if there's no such user or they're banned.
+func (s *Server) UserForCookie(cookie string) *users.StorageRecord {
+
+	user := s.storage.GetUserByCookie(cookie)
+
+	if user == nil {
+		s.logger.Debugln("No user associated with that cookie")
+		return nil
+	}
+
+	if user.Banned {
+		//Banned users are treated as though they aren't signed in.
+		s.logger.Debugln("Ignoring banned user " + user.ID)
+		return nil
+	}
+
+	user.LastSeen = time.Now().UnixNano()
+	s.storage.UpdateUser(user)
+
+	return user
+}
+
+//AllowRequest returns an error if the client at ip, or user (who may be nil),
+//has made too many requests recently, under the same per-IP and per-user
+//limits the REST endpoints enforce. Otherwise it counts the request against
+//those limits.
+func (s *Server) AllowRequest(ip string, user *users.StorageRecord) *errors.Friendly {
+	return s.calcRateLimit(ip, user)
+}
+
+//Game returns the game of the given type with the given ID, or nil if there
+//isn't one.
+func (s *Server) Game(gameName, gameID string) *boardgame.Game {
+	return s.gameFromID(gameID, gameName)
+}
+
+//ListGames returns up to max games in the given list, as seen by user, who
+//may be nil. If gameName is not "", only games of that type are returned.
+func (s *Server) ListGames(max int, list listing.Type, user *users.StorageRecord, gameName string) []*GameListing {
+	var userID string
+	if user != nil {
+		userID = user.ID
+	}
+	return s.listGamesWithUsers(max, list, userID, gameName)
+}
+
+//NewGame creates a new game of the given type, owned by owner. If numPlayers
+//is 0, the game type's default is used. agents are the names of the agents
+//to play each seat, or "" for humans, and may be shorter than numPlayers.
+//Illegal variant values are ignored.
+func (s *Server) NewGame(owner *users.StorageRecord, gameName string, numPlayers int, agents []string, open, visible bool, variant map[string]string) (*boardgame.Game, *errors.Friendly) {
+
+	info := s.managers[gameName]
+
+	if info == nil {
+		return nil, errors.NewFriendly("That is not a legal type of game").WithError(gameName + " is not a legal manager for this server")
+	}
+
+	manager := info.manager
+
+	if numPlayers == 0 {
+		numPlayers = manager.Delegate().DefaultNumPlayers()
+	}
+
+	paddedAgents := make([]string, numPlayers)
+	copy(paddedAgents, agents)
+
+	legalVariant := make(map[string]string)
+
+	for key, value := range variant {
+		variantInfo, ok := manager.Variants()[key]
+		if !ok {
+			continue
+		}
+		for _, val := range variantInfo.Values {
+			if val.Name == value {
+				legalVariant[key] = value
+				break
+			}
+		}
+	}
+
+	return s.calcNewGame(owner, manager, numPlayers, paddedAgents, open, visible, legalVariant)
+}
+
+//ViewingAsPlayer returns the seat user is in in game, or
+//boardgame.ObserverPlayerIndex if they aren't in one. user may be nil.
+func (s *Server) ViewingAsPlayer(user *users.StorageRecord, game *boardgame.Game) boardgame.PlayerIndex {
+	player, _ := s.calcViewingAsPlayerAndEmptySlots(s.storage.UserIDsForGame(game.ID()), user, game.Agents(), s.closedSeatsForGame(game))
+	return player
+}
+
+//JoinGame seats user in an empty seat of game, like the join endpoint, and
+//returns the seat they were given.
+func (s *Server) JoinGame(user *users.StorageRecord, game *boardgame.Game, inviteToken string, password string) (boardgame.PlayerIndex, *errors.Friendly) {
+	viewingAsPlayer, emptySlots := s.calcViewingAsPlayerAndEmptySlots(s.storage.UserIDsForGame(game.ID()), user, game.Agents(), s.closedSeatsForGame(game))
+	return s.calcJoinGame(game, viewingAsPlayer, emptySlots, user, inviteToken, password)
+}
+
+//MoveForms returns the moves that may be proposed in game, with their
+//legality for player in state.
+func (s *Server) MoveForms(game *boardgame.Game, state boardgame.ImmutableState, player boardgame.PlayerIndex) []*MoveForm {
+	return s.generateFormsWithLegality(game, state, player)
+}
+
+//ProposeMove proposes the move with the given name on behalf of the seat
+//user is in, and waits for it to be applied or rejected. values are the
+//values of the move's fields (see MoveForms), formatted as the move endpoint
+//expects them.
+func (s *Server) ProposeMove(user *users.StorageRecord, game *boardgame.Game, moveName string, values map[string]string) *errors.Friendly {
+
+	if user == nil {
+		return errors.NewFriendly("You must be signed in to make a move.")
+	}
+
+	proposer := s.ViewingAsPlayer(user, game)
+
+	if proposer == boardgame.ObserverPlayerIndex {
+		return errors.NewFriendly("You aren't a player in that game.")
+	}
+
+	move, err := s.calcMove(game, moveName, func(fieldName string) string {
+		return values[fieldName]
+	})
+
+	if err != nil {
+		return errors.New("Couldn't get move: " + err.Error())
+	}
+
+	return s.calcMakeMove(game, proposer, move)
+}
+
+//WatchGame returns a channel that receives the game's version every time it
+//changes, and a function to call when done watching. Versions are skipped if
+//the receiver falls behind; only the newest is kept. Must not be called
+//before Start.
+func (s *Server) WatchGame(gameID string) (<-chan int, func()) {
+	watcher := &gameWatcher{
+		gameID:   gameID,
+		versions: make(chan int, 1),
+	}
+
+	s.notifier.watch <- watcher
+
+	return watcher.versions, func() {
+		s.notifier.unwatch <- watcher
+	}
+}
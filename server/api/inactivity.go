@@ -0,0 +1,381 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+	"github.com/jkomoros/boardgame/server/api/inactivity"
+	"github.com/jkomoros/boardgame/server/api/notify"
+)
+
+//inactivityCheckInterval is how often the server looks for games whose
+//inactivity deadline has passed.
+const inactivityCheckInterval = time.Minute
+
+//inactivityRetryInterval is how long after a policy's action fails that it's
+//tried again.
+const inactivityRetryInterval = 15 * time.Minute
+
+//targetPlayerIndexProp is the property that, if a forfeit move has it, is set
+//to the player who timed out.
+const targetPlayerIndexProp = "TargetPlayerIndex"
+
+//SetInactivityPolicy sets the policy for players who stop taking their turns
+//in games of type gameName, replacing any policy from the inactivity section
+//of config. A nil policy means games of that type never time out. We return a
+//reference to ourself to allow chaining.
+func (s *Server) SetInactivityPolicy(gameName string, policy *inactivity.Policy) *Server {
+	if policy == nil {
+		delete(s.inactivityPolicies, gameName)
+		return s
+	}
+	if err := policy.Valid(); err != nil {
+		s.logger.Errorln("Ignoring invalid inactivity policy for " + gameName + ": " + err.Error())
+		return s
+	}
+	s.inactivityPolicies[gameName] = policy
+	return s
+}
+
+//configureInactivity adds the policies described in config that weren't
+//already set via SetInactivityPolicy. Called by Start() once config is
+//loaded.
+func (s *Server) configureInactivity() {
+	for gameName, policyConfig := range s.config.Inactivity {
+		if policyConfig == nil {
+			continue
+		}
+		if _, ok := s.inactivityPolicies[gameName]; ok {
+			continue
+		}
+		if s.managers[gameName] == nil {
+			s.logger.Warnln("Inactivity policy configured for unknown game type " + gameName)
+		}
+		s.SetInactivityPolicy(gameName, &inactivity.Policy{
+			WarnAfter:   time.Duration(policyConfig.WarnAfterMinutes) * time.Minute,
+			Deadline:    time.Duration(policyConfig.DeadlineMinutes) * time.Minute,
+			Action:      inactivity.Action(policyConfig.Action),
+			ForfeitMove: policyConfig.ForfeitMove,
+			AgentName:   policyConfig.Agent,
+		})
+	}
+}
+
+//startInactivityChecks checks for overdue games right away (to catch up on
+//anything that came due while the server was down) and then periodically
+//from then on. Does nothing if no game type has an inactivity policy.
+func (s *Server) startInactivityChecks() {
+	if len(s.inactivityPolicies) == 0 {
+		return
+	}
+
+	go func() {
+		s.checkInactivity(time.Now())
+		for now := range time.Tick(inactivityCheckInterval) {
+			s.checkInactivity(now)
+		}
+	}()
+}
+
+//checkInactivity processes every game whose inactivity deadline has passed.
+func (s *Server) checkInactivity(now time.Time) {
+	for _, gameID := range s.storage.DueInactivityChecks(now.UnixNano()) {
+		if err := s.doInactivityCheck(gameID, now); err != nil {
+			s.logger.Errorln("Inactivity check for game " + gameID + " failed: " + err.Error())
+		}
+	}
+}
+
+//inactivityMoveApplied is called after every player move. It records when
+//the move's proposer last moved, and restarts the inactivity clock for
+//whoever's turn it is now.
+func (s *Server) inactivityMoveApplied(game *boardgame.GameStorageRecord) {
+	if s.inactivityPolicies[game.Name] == nil {
+		return
+	}
+
+	seat := boardgame.AdminPlayerIndex
+
+	if playerMove, err := s.storage.initiatingMove(game); err == nil {
+		seat = playerMove.Proposer
+	} else {
+		s.logger.Errorln("Couldn't fetch initiating move for inactivity: " + err.Error())
+	}
+
+	s.touchInactivityClock(game, seat, true)
+}
+
+//touchInactivityClock records that seat (which may be AdminPlayerIndex) was
+//just active in game. If newTurn is true, or no clock is running yet, the
+//inactivity clock for the current player is (re)started.
+func (s *Server) touchInactivityClock(game *boardgame.GameStorageRecord, seat boardgame.PlayerIndex, newTurn bool) {
+	policy := s.inactivityPolicies[game.Name]
+
+	if policy == nil {
+		return
+	}
+
+	s.inactivityLock.Lock()
+	defer s.inactivityLock.Unlock()
+
+	eGame, err := s.storage.ExtendedGame(game.ID)
+
+	if err != nil {
+		s.logger.Errorln("Couldn't fetch extended game for inactivity: " + err.Error())
+		return
+	}
+
+	clock := eGame.InactivityClock
+
+	now := time.Now()
+
+	if seat >= 0 && int(seat) < game.NumPlayers {
+		seatLastMove := make([]int64, game.NumPlayers)
+		copy(seatLastMove, clock.SeatLastMove)
+		clock.SeatLastMove = seatLastMove
+		clock.SeatLastMove[seat] = now.UnixNano()
+	}
+
+	if game.Finished {
+		clock.InactivityDeadline = 0
+		clock.InactivityWarned = false
+	} else if newTurn || clock.InactivityDeadline == 0 {
+		clock.TurnStarted = now.UnixNano()
+		clock.InactivityDeadline = policy.FirstDeadline(now).UnixNano()
+		clock.InactivityWarned = false
+	}
+
+	if err := s.storage.UpdateInactivityClock(game.ID, &clock); err != nil {
+		s.logger.Errorln("Couldn't save inactivity clock: " + err.Error())
+	}
+}
+
+//doInactivityCheck is called once the given game's inactivity deadline has
+//passed. It warns the current player or takes the policy's action, and
+//stores when the game should next be checked.
+func (s *Server) doInactivityCheck(gameID string, now time.Time) error {
+
+	game, player, policy, err := s.calcInactivityStep(gameID, now)
+
+	if err != nil || game == nil {
+		return err
+	}
+
+	if err := s.doInactivityAction(game, player, policy); err != nil {
+		s.rearmInactivityClock(gameID, now)
+		return err
+	}
+
+	return nil
+}
+
+//calcInactivityStep updates the game's inactivity clock, warning the current
+//player if it's time. If the policy's action should be taken, it stops the
+//clock and returns the game, the player who timed out, and the policy;
+//otherwise it returns a nil game.
+func (s *Server) calcInactivityStep(gameID string, now time.Time) (*boardgame.Game, boardgame.PlayerIndex, *inactivity.Policy, error) {
+
+	//The action itself isn't taken while holding the lock, since a move it
+	//applies will touch the clock.
+	s.inactivityLock.Lock()
+	defer s.inactivityLock.Unlock()
+
+	record, err := s.storage.Game(gameID)
+
+	if err != nil {
+		return nil, 0, nil, errors.New("Couldn't fetch game: " + err.Error())
+	}
+
+	eGame, err := s.storage.ExtendedGame(gameID)
+
+	if err != nil {
+		return nil, 0, nil, errors.New("Couldn't fetch extended game: " + err.Error())
+	}
+
+	clock := eGame.InactivityClock
+
+	stopClock := func() error {
+		clock.InactivityDeadline = 0
+		clock.InactivityWarned = false
+		return s.storage.UpdateInactivityClock(gameID, &clock)
+	}
+
+	policy := s.inactivityPolicies[record.Name]
+	info := s.managers[record.Name]
+
+	if policy == nil || info == nil || record.Finished {
+		return nil, 0, nil, stopClock()
+	}
+
+	if clock.TurnStarted == 0 {
+		//A clock that was never started; start it now rather than timing out
+		//immediately.
+		clock.TurnStarted = now.UnixNano()
+		clock.InactivityDeadline = policy.FirstDeadline(now).UnixNano()
+		return nil, 0, nil, s.storage.UpdateInactivityClock(gameID, &clock)
+	}
+
+	game := info.manager.Game(gameID)
+
+	if game == nil {
+		return nil, 0, nil, errors.New("Couldn't load game")
+	}
+
+	currentState := game.CurrentState()
+
+	currentPlayer := game.Manager().Delegate().CurrentPlayerIndex(currentState)
+
+	if !currentPlayer.WithinBounds(currentState) {
+		//No one in particular is holding up the game.
+		return nil, 0, nil, stopClock()
+	}
+
+	if agents := game.Agents(); int(currentPlayer) < len(agents) && agents[currentPlayer] != "" {
+		return nil, 0, nil, stopClock()
+	}
+
+	userIDs := s.storage.UserIDsForGame(gameID)
+
+	if int(currentPlayer) >= len(userIDs) || userIDs[currentPlayer] == "" {
+		//Waiting on an empty seat isn't anyone's fault.
+		return nil, 0, nil, stopClock()
+	}
+
+	step, next := policy.Check(time.Unix(0, clock.TurnStarted), clock.InactivityWarned, now)
+
+	switch step {
+	case inactivity.Wait:
+		clock.InactivityDeadline = next.UnixNano()
+		return nil, 0, nil, s.storage.UpdateInactivityClock(gameID, &clock)
+	case inactivity.Warn:
+		clock.InactivityDeadline = next.UnixNano()
+		clock.InactivityWarned = true
+		if err := s.storage.UpdateInactivityClock(gameID, &clock); err != nil {
+			return nil, 0, nil, err
+		}
+		s.warnInactivePlayer(game, currentPlayer, userIDs[currentPlayer], policy, next)
+		return nil, 0, nil, nil
+	}
+
+	//Stop the clock before acting. If the action applies a move, that move
+	//will start the next player's clock.
+	if err := stopClock(); err != nil {
+		return nil, 0, nil, err
+	}
+
+	return game, currentPlayer, policy, nil
+}
+
+//rearmInactivityClock schedules another check of a game whose inactivity
+//action failed, so that it's retried rather than forgotten. It does nothing
+//if the clock was started again in the meantime.
+func (s *Server) rearmInactivityClock(gameID string, now time.Time) {
+	s.inactivityLock.Lock()
+	defer s.inactivityLock.Unlock()
+
+	eGame, err := s.storage.ExtendedGame(gameID)
+
+	if err != nil {
+		s.logger.Errorln("Couldn't fetch extended game to re-arm inactivity clock: " + err.Error())
+		return
+	}
+
+	clock := eGame.InactivityClock
+
+	if clock.InactivityDeadline != 0 {
+		return
+	}
+
+	clock.InactivityDeadline = now.Add(inactivityRetryInterval).UnixNano()
+
+	if err := s.storage.UpdateInactivityClock(gameID, &clock); err != nil {
+		s.logger.Errorln("Couldn't re-arm inactivity clock: " + err.Error())
+	}
+}
+
+//doInactivityAction takes the policy's action against player, who has timed
+//out.
+func (s *Server) doInactivityAction(game *boardgame.Game, player boardgame.PlayerIndex, policy *inactivity.Policy) error {
+	switch policy.Action {
+	case inactivity.Agent:
+		return s.doUnseatPlayer(game, player, policy.AgentName)
+	case inactivity.Inactivate:
+		moveName := managerInactivatePlayerMove(game.Manager())
+		if moveName == "" {
+			return errors.New("Game type has no InactivatePlayer move configured")
+		}
+		return s.proposeInactivityMove(game, moveName, player)
+	case inactivity.Forfeit:
+		return s.proposeInactivityMove(game, policy.ForfeitMove, player)
+	}
+	return errors.New("Unknown inactivity action: " + string(policy.Action))
+}
+
+//proposeInactivityMove proposes the move with the given name as the admin,
+//targeting player if the move has a TargetPlayerIndex property.
+func (s *Server) proposeInactivityMove(game *boardgame.Game, moveName string, player boardgame.PlayerIndex) error {
+	move := game.MoveByName(moveName)
+
+	if move == nil {
+		return errors.New("Game has no move named " + moveName)
+	}
+
+	if propType, ok := move.Reader().Props()[targetPlayerIndexProp]; ok && propType == boardgame.TypePlayerIndex {
+		if err := move.ReadSetter().SetPlayerIndexProp(targetPlayerIndexProp, player); err != nil {
+			return errors.New("Couldn't set " + targetPlayerIndexProp + ": " + err.Error())
+		}
+	}
+
+	if err := <-game.ProposeMove(move, boardgame.AdminPlayerIndex); err != nil {
+		return errors.New("Couldn't apply " + moveName + ": " + err.Error())
+	}
+
+	return nil
+}
+
+//managerInactivatePlayerMove returns the name of the manager's
+//InactivatePlayer move, or "" if it doesn't have one.
+func managerInactivatePlayerMove(manager *boardgame.GameManager) string {
+	for _, move := range manager.ExampleMoves() {
+		if inactivator, ok := move.(interfaces.InactivatePlayerMover); ok && inactivator.IsInactivatePlayerMove() {
+			return move.Info().Name()
+		}
+	}
+	return ""
+}
+
+//warnInactivePlayer tells the user in player's seat that they have until
+//deadline to move before the policy's action is taken.
+func (s *Server) warnInactivePlayer(game *boardgame.Game, player boardgame.PlayerIndex, userID string, policy *inactivity.Policy, deadline time.Time) {
+
+	user := s.storage.GetUserByID(userID)
+
+	if user == nil {
+		return
+	}
+
+	displayName := game.Manager().Delegate().DisplayName()
+
+	var consequence string
+
+	switch policy.Action {
+	case inactivity.Forfeit:
+		consequence = "you will forfeit"
+	case inactivity.Inactivate:
+		consequence = "you will be skipped"
+	case inactivity.Agent:
+		consequence = "a bot will take over your seat"
+	}
+
+	s.deliverNotification(user, &notify.Notification{
+		UserID:          user.ID,
+		GameName:        game.Name(),
+		GameDisplayName: displayName,
+		GameID:          game.ID(),
+		PlayerIndex:     int(player),
+		URL:             s.gameURL(game.Name(), game.ID()),
+		Message:         "It's still your turn in " + displayName + ". If you don't move by " + deadline.UTC().Format(time.RFC1123) + ", " + consequence + ".",
+	})
+}
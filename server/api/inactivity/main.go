@@ -0,0 +1,125 @@
+/*
+
+Package inactivity defines the policies the server uses to deal with players
+who stop taking their turns in asynchronous games: warning them, then
+forfeiting, skipping, or handing their seat to an agent.
+
+A Policy says how long the current player may go without moving before they
+are warned, and how long before the server gives up on them and takes Action.
+The server keeps the next point in time it needs to look at a game (the
+"deadline") in storage, so pending warnings and actions survive restarts; it
+uses Check to decide what to do when that deadline passes.
+
+*/
+package inactivity
+
+import (
+	"errors"
+	"time"
+)
+
+//Action is what the server does once a player misses the deadline.
+type Action string
+
+const (
+	//Forfeit proposes the game's forfeit move, named by Policy.ForfeitMove,
+	//on behalf of the admin. If the move has a TargetPlayerIndex property,
+	//it's set to the inactive player.
+	Forfeit Action = "forfeit"
+	//Inactivate proposes the game's moves.InactivatePlayer move for the
+	//inactive player, so game logic using behaviors.InactivePlayer skips
+	//them.
+	Inactivate Action = "inactivate"
+	//Agent removes the player from their seat and hands it to the agent
+	//named by Policy.AgentName.
+	Agent Action = "agent"
+)
+
+//Step is what should happen to a game when it is checked.
+type Step int
+
+const (
+	//Wait means the current player still has time.
+	Wait Step = iota
+	//Warn means the current player should be told they're about to time
+	//out.
+	Warn
+	//Act means the current player has timed out and the policy's Action
+	//should be taken.
+	Act
+)
+
+//Policy describes how the server treats inactive players for a game type.
+type Policy struct {
+	//WarnAfter is how long after their turn starts that the current player
+	//is warned. If 0, or not less than Deadline, no warning is sent.
+	WarnAfter time.Duration
+	//Deadline is how long after their turn starts that the current player
+	//times out.
+	Deadline time.Duration
+	Action   Action
+	//ForfeitMove is the name of the move to propose for the Forfeit action.
+	ForfeitMove string
+	//AgentName is the name of the agent to take over for the Agent action.
+	AgentName string
+}
+
+//Valid returns an error if the policy is not well formed.
+func (p *Policy) Valid() error {
+	if p == nil {
+		return errors.New("no policy")
+	}
+	if p.Deadline <= 0 {
+		return errors.New("deadline must be positive")
+	}
+	switch p.Action {
+	case Forfeit:
+		if p.ForfeitMove == "" {
+			return errors.New("forfeit action requires a forfeit move")
+		}
+	case Agent:
+		if p.AgentName == "" {
+			return errors.New("agent action requires an agent name")
+		}
+	case Inactivate:
+	default:
+		return errors.New("unknown action: " + string(p.Action))
+	}
+	return nil
+}
+
+func (p *Policy) warns() bool {
+	return p.WarnAfter > 0 && p.WarnAfter < p.Deadline
+}
+
+//FirstDeadline returns when a game whose current turn started at turnStarted
+//should first be checked.
+func (p *Policy) FirstDeadline(turnStarted time.Time) time.Time {
+	if p.warns() {
+		return turnStarted.Add(p.WarnAfter)
+	}
+	return turnStarted.Add(p.Deadline)
+}
+
+//Check returns what should happen to a game whose current turn started at
+//turnStarted, given whether the current player has already been warned.
+//next is when the game should be checked again, or the zero time if it
+//shouldn't be (because it's time to Act).
+func (p *Policy) Check(turnStarted time.Time, warned bool, now time.Time) (step Step, next time.Time) {
+
+	timeout := turnStarted.Add(p.Deadline)
+
+	if !now.Before(timeout) {
+		return Act, time.Time{}
+	}
+
+	if warned || !p.warns() {
+		return Wait, timeout
+	}
+
+	if now.Before(turnStarted.Add(p.WarnAfter)) {
+		return Wait, turnStarted.Add(p.WarnAfter)
+	}
+
+	return Warn, timeout
+}
@@ -0,0 +1,132 @@
+package inactivity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/workfit/tester/assert"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		description string
+		policy      *Policy
+		valid       bool
+	}{
+		{
+			"No policy",
+			nil,
+			false,
+		},
+		{
+			"No deadline",
+			&Policy{Action: Inactivate},
+			false,
+		},
+		{
+			"Inactivate",
+			&Policy{Deadline: time.Hour, Action: Inactivate},
+			true,
+		},
+		{
+			"Forfeit without move",
+			&Policy{Deadline: time.Hour, Action: Forfeit},
+			false,
+		},
+		{
+			"Forfeit",
+			&Policy{Deadline: time.Hour, Action: Forfeit, ForfeitMove: "Resign"},
+			true,
+		},
+		{
+			"Agent without name",
+			&Policy{Deadline: time.Hour, Action: Agent},
+			false,
+		},
+		{
+			"Unknown action",
+			&Policy{Deadline: time.Hour, Action: "explode"},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.policy.Valid()
+		assert.For(t, test.description).ThatActual(err == nil).Equals(test.valid)
+	}
+}
+
+func TestCheck(t *testing.T) {
+
+	start := time.Unix(1000, 0)
+
+	policy := &Policy{
+		WarnAfter: time.Hour,
+		Deadline:  3 * time.Hour,
+		Action:    Inactivate,
+	}
+
+	assert.For(t).ThatActual(policy.FirstDeadline(start)).Equals(start.Add(time.Hour))
+
+	tests := []struct {
+		description  string
+		now          time.Time
+		warned       bool
+		expectedStep Step
+		expectedNext time.Time
+	}{
+		{
+			"Just started",
+			start,
+			false,
+			Wait,
+			start.Add(time.Hour),
+		},
+		{
+			"Time to warn",
+			start.Add(90 * time.Minute),
+			false,
+			Warn,
+			start.Add(3 * time.Hour),
+		},
+		{
+			"Already warned",
+			start.Add(90 * time.Minute),
+			true,
+			Wait,
+			start.Add(3 * time.Hour),
+		},
+		{
+			"Timed out",
+			start.Add(3 * time.Hour),
+			false,
+			Act,
+			time.Time{},
+		},
+		{
+			"Timed out after warning",
+			start.Add(5 * time.Hour),
+			true,
+			Act,
+			time.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		step, next := policy.Check(start, test.warned, test.now)
+		assert.For(t, test.description).ThatActual(step).Equals(test.expectedStep)
+		assert.For(t, test.description).ThatActual(next).Equals(test.expectedNext)
+	}
+
+	noWarning := &Policy{
+		Deadline: time.Hour,
+		Action:   Inactivate,
+	}
+
+	assert.For(t).ThatActual(noWarning.FirstDeadline(start)).Equals(start.Add(time.Hour))
+
+	step, next := noWarning.Check(start, false, start.Add(30*time.Minute))
+
+	assert.For(t).ThatActual(step).Equals(Wait)
+	assert.For(t).ThatActual(next).Equals(start.Add(time.Hour))
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//calcCanManageGame returns a non-nil error if user is not allowed to change
+//the settings of the given game.
+func (s *Server) calcCanManageGame(user *users.StorageRecord, isAdmin bool, game *boardgame.Game, gameInfo *extendedgame.StorageRecord) *errors.Friendly {
+	if user == nil {
+		return errors.New("No user provided")
+	}
+
+	if game == nil {
+		return errors.New("Invalid game")
+	}
+
+	if gameInfo == nil {
+		return errors.New("Couldn't fetch game info")
+	}
+
+	if !isAdmin && user.ID != gameInfo.Owner {
+		return errors.NewFriendly("You are neither the owner nor an admin.")
+	}
+
+	return nil
+}
+
+func (s *Server) createInviteHandler(c *gin.Context) {
+	game := s.getGame(c)
+
+	var gameID string
+
+	if game != nil {
+		gameID = game.ID()
+	}
+
+	gameInfo, _ := s.storage.ExtendedGame(gameID)
+
+	adminAllowed := s.getAdminAllowed(c)
+	requestAdmin := s.getRequestAdmin(c)
+
+	isAdmin := s.calcIsAdmin(adminAllowed, requestAdmin)
+
+	user := s.getUser(c)
+
+	seat := s.getRequestSeat(c)
+
+	r := s.newRenderer(c)
+
+	s.doCreateInvite(r, user, isAdmin, game, gameInfo, seat)
+}
+
+func (s *Server) doCreateInvite(r *renderer, user *users.StorageRecord, isAdmin bool, game *boardgame.Game, gameInfo *extendedgame.StorageRecord, seat int) {
+
+	if err := s.calcCanManageGame(user, isAdmin, game, gameInfo); err != nil {
+		r.Error(err)
+		return
+	}
+
+	if seat != extendedgame.AnySeat && (seat < 0 || seat >= game.NumPlayers()) {
+		r.Error(errors.NewFriendly("Seat " + strconv.Itoa(seat) + " is not a valid seat in this game."))
+		return
+	}
+
+	invite, err := gameInfo.AddInvite(seat)
+
+	if err != nil {
+		r.Error(errors.New("Couldn't create invite: " + err.Error()))
+		return
+	}
+
+	if err := s.storage.UpdateExtendedGame(game.ID(), gameInfo); err != nil {
+		r.Error(errors.New("Error updating the extended game: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{
+		"Invite": invite,
+	})
+}
+
+func (s *Server) revokeInviteHandler(c *gin.Context) {
+	game := s.getGame(c)
+
+	var gameID string
+
+	if game != nil {
+		gameID = game.ID()
+	}
+
+	gameInfo, _ := s.storage.ExtendedGame(gameID)
+
+	adminAllowed := s.getAdminAllowed(c)
+	requestAdmin := s.getRequestAdmin(c)
+
+	isAdmin := s.calcIsAdmin(adminAllowed, requestAdmin)
+
+	user := s.getUser(c)
+
+	token := s.getRequestInvite(c)
+
+	r := s.newRenderer(c)
+
+	s.doRevokeInvite(r, user, isAdmin, game, gameInfo, token)
+}
+
+func (s *Server) doRevokeInvite(r *renderer, user *users.StorageRecord, isAdmin bool, game *boardgame.Game, gameInfo *extendedgame.StorageRecord, token string) {
+
+	if err := s.calcCanManageGame(user, isAdmin, game, gameInfo); err != nil {
+		r.Error(err)
+		return
+	}
+
+	if !gameInfo.RevokeInvite(token) {
+		r.Error(errors.NewFriendly("There is no such invite for this game."))
+		return
+	}
+
+	if err := s.storage.UpdateExtendedGame(game.ID(), gameInfo); err != nil {
+		r.Error(errors.New("Error updating the extended game: " + err.Error()))
+		return
+	}
+
+	r.Success(nil)
+}
+
+func (s *Server) gamePasswordHandler(c *gin.Context) {
+	game := s.getGame(c)
+
+	var gameID string
+
+	if game != nil {
+		gameID = game.ID()
+	}
+
+	gameInfo, _ := s.storage.ExtendedGame(gameID)
+
+	adminAllowed := s.getAdminAllowed(c)
+	requestAdmin := s.getRequestAdmin(c)
+
+	isAdmin := s.calcIsAdmin(adminAllowed, requestAdmin)
+
+	user := s.getUser(c)
+
+	password := s.getRequestPassword(c)
+
+	r := s.newRenderer(c)
+
+	s.doGamePassword(r, user, isAdmin, game, gameInfo, password)
+}
+
+//doGamePassword sets the password required to join the game. An empty
+//password removes the requirement.
+func (s *Server) doGamePassword(r *renderer, user *users.StorageRecord, isAdmin bool, game *boardgame.Game, gameInfo *extendedgame.StorageRecord, password string) {
+
+	if err := s.calcCanManageGame(user, isAdmin, game, gameInfo); err != nil {
+		r.Error(err)
+		return
+	}
+
+	if err := gameInfo.SetPassword(password); err != nil {
+		r.Error(errors.New("Couldn't set password: " + err.Error()))
+		return
+	}
+
+	if err := s.storage.UpdateExtendedGame(game.ID(), gameInfo); err != nil {
+		r.Error(errors.New("Error updating the extended game: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{
+		"GameHasPassword": gameInfo.PasswordHash != "",
+	})
+}
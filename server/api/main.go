@@ -6,6 +6,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -18,8 +19,12 @@ import (
 	"github.com/jkomoros/boardgame/errors"
 	"github.com/jkomoros/boardgame/moves/interfaces"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/inactivity"
 	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/notify"
+	"github.com/jkomoros/boardgame/server/api/ratelimit"
 	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/jkomoros/boardgame/server/api/webhooks"
 	"github.com/sirupsen/logrus"
 )
 
@@ -30,6 +35,9 @@ type Server struct {
 	//map of game ID to players to seat
 	playersToSeat map[string][]*playerToSeat
 
+	//map of game ID to players to unseat
+	playersToUnseat map[string][]*playerToUnseat
+
 	storage *ServerStorageManager
 	//We store the last error so that next time viewHandler is called we can
 	//display it. Yes, this is a hack.
@@ -42,6 +50,33 @@ type Server struct {
 
 	notifier *versionNotifier
 	logger   *logrus.Logger
+
+	notificationBackends []notify.Backend
+
+	webhooks *webhooks.Dispatcher
+
+	//Limiters are nil (which allows everything) unless configured.
+	ipLimiter      *ratelimit.Limiter
+	userLimiter    *ratelimit.Limiter
+	newGameLimiter *ratelimit.Limiter
+
+	//map of game type to the policy for players who stop taking turns
+	inactivityPolicies map[string]*inactivity.Policy
+
+	//inactivityLock is held while a game's inactivity clock is read,
+	//modified and saved, so moves and inactivity checks don't clobber each
+	//other.
+	inactivityLock sync.Mutex
+
+	//tournamentsLock is held while a tournament is read, modified and
+	//saved, so concurrent results don't clobber each other.
+	tournamentsLock sync.Mutex
+
+	//tournamentGamesLock is held while games are created for a tournament's
+	//round, so the same match doesn't get two games.
+	tournamentGamesLock sync.Mutex
+
+	frontends []Frontend
 }
 
 type renderer struct {
@@ -52,10 +87,11 @@ type renderer struct {
 	cookieValue  string
 }
 
-type moveForm struct {
+//MoveForm describes a move players may propose, and the fields it takes.
+type MoveForm struct {
 	Name                string
 	HelpText            string
-	Fields              []*moveFormField
+	Fields              []*MoveFormField
 	LegalForPlayer      bool   `json:",omitempty"`
 	LegalForPlayerError string `json:",omitempty"`
 	LegalForAnyone      bool   `json:",omitempty"`
@@ -63,7 +99,9 @@ type moveForm struct {
 
 type moveFormFieldType int
 
-type moveFormField struct {
+//MoveFormField is a field of a MoveForm. Its Type determines how its value
+//must be formatted when proposing the move.
+type MoveFormField struct {
 	Name         string
 	Type         boardgame.PropertyType
 	EnumName     string `json:",omitempty"`
@@ -73,6 +111,8 @@ type moveFormField struct {
 type managerInfo struct {
 	manager         *boardgame.GameManager
 	seatPlayerMoves []string
+	//unseatPlayerMoves is like seatPlayerMoves, but for moves.UnseatPlayer.
+	unseatPlayerMoves []string
 	//If the game's playerState has seatPlayer. Typically the answer is is yes
 	//if len(seatPlayerMoves) != 0, as moves.SeatPlayer and behaviors.Seat are
 	//used in conjunction most often.
@@ -119,10 +159,13 @@ func NewServer(storage *ServerStorageManager, delegates ...boardgame.GameDelegat
 	logger := logrus.New()
 
 	result := &Server{
-		managers:      make(managerMap),
-		playersToSeat: make(map[string][]*playerToSeat),
-		storage:       storage,
-		logger:        logger,
+		managers:           make(managerMap),
+		playersToSeat:      make(map[string][]*playerToSeat),
+		playersToUnseat:    make(map[string][]*playerToUnseat),
+		storage:            storage,
+		logger:             logger,
+		webhooks:           webhooks.NewDispatcher(nil, logger),
+		inactivityPolicies: make(map[string]*inactivity.Policy),
 	}
 
 	storage.server = result
@@ -148,9 +191,10 @@ func NewServer(storage *ServerStorageManager, delegates ...boardgame.GameDelegat
 		}
 
 		result.managers[name] = &managerInfo{
-			manager:         manager,
-			seatPlayerMoves: managerSeatPlayerMoves(manager),
-			playerHasSeat:   playerHasSeat,
+			manager:           manager,
+			seatPlayerMoves:   managerSeatPlayerMoves(manager),
+			unseatPlayerMoves: managerUnseatPlayerMoves(manager),
+			playerHasSeat:     playerHasSeat,
 		}
 		managers = append(managers, manager)
 		if manager.Storage() != storage {
@@ -324,14 +368,11 @@ func (s *Server) userSetup(c *gin.Context) {
 		return
 	}
 
-	user := s.storage.GetUserByCookie(cookie)
+	user := s.UserForCookie(cookie)
 
 	if user == nil {
-		s.logger.Debugln("No user associated with that cookie")
 		return
 	}
-	user.LastSeen = time.Now().UnixNano()
-	s.storage.UpdateUser(user)
 
 	s.setUser(c, user)
 
@@ -340,14 +381,14 @@ func (s *Server) userSetup(c *gin.Context) {
 
 func (s *Server) gameFromID(gameID, gameName string) *boardgame.Game {
 
-	manager := s.managers[gameName].manager
+	info := s.managers[gameName]
 
-	if manager == nil {
+	if info == nil {
 		s.logger.Errorln("Couldn't find manager for", gameName)
 		return nil
 	}
 
-	game := manager.Game(gameID)
+	game := info.manager.Game(gameID)
 
 	//TODO: figure out a way to return a meaningful error
 
@@ -476,7 +517,11 @@ func (s *Server) joinGameHandler(c *gin.Context) {
 
 	viewingAsPlayer, emptySlots := s.calcViewingAsPlayerAndEmptySlots(userIds, user, game.Agents(), closedSeats)
 
-	s.doJoinGame(r, game, viewingAsPlayer, emptySlots, user)
+	inviteToken := s.getRequestInvite(c)
+
+	password := s.getRequestPassword(c)
+
+	s.doJoinGame(r, game, viewingAsPlayer, emptySlots, user, inviteToken, password)
 
 }
 
@@ -520,45 +565,96 @@ func (s *Server) doSeatPlayer(game *boardgame.Game, slot boardgame.PlayerIndex,
 		//is pending but before theyr'e actually seated. See #221.
 	}
 
-	return s.storage.SetPlayerForGame(game.ID(), slot, user.ID)
+	if err := s.storage.SetPlayerForGame(game.ID(), slot, user.ID); err != nil {
+		return err
+	}
+
+	s.dispatchPlayerSeated(game, slot, user.ID)
+
+	s.touchInactivityClock(game.StorageRecord(), slot, false)
+
+	return nil
 }
 
-func (s *Server) doJoinGame(r *renderer, game *boardgame.Game, viewingAsPlayer boardgame.PlayerIndex, emptySlots []boardgame.PlayerIndex, user *users.StorageRecord) {
+func (s *Server) doJoinGame(r *renderer, game *boardgame.Game, viewingAsPlayer boardgame.PlayerIndex, emptySlots []boardgame.PlayerIndex, user *users.StorageRecord, inviteToken string, password string) {
 
-	if user == nil {
-		r.Error(errors.New("no user provided"))
+	if _, err := s.calcJoinGame(game, viewingAsPlayer, emptySlots, user, inviteToken, password); err != nil {
+		r.Error(err)
 		return
 	}
 
+	r.Success(nil)
+}
+
+//calcJoinGame seats user in game, in the seat their invite is for or else
+//the first empty one, and returns the seat they were given.
+func (s *Server) calcJoinGame(game *boardgame.Game, viewingAsPlayer boardgame.PlayerIndex, emptySlots []boardgame.PlayerIndex, user *users.StorageRecord, inviteToken string, password string) (boardgame.PlayerIndex, *errors.Friendly) {
+
+	if user == nil {
+		return invalidPlayerIndex, errors.New("no user provided")
+	}
+
 	eGame, err := s.storage.ExtendedGame(game.ID())
 
 	if err != nil {
-		r.Error(errors.New("Couldn't get extended information about game: " + err.Error()))
-		return
+		return invalidPlayerIndex, errors.New("Couldn't get extended information about game: " + err.Error())
 	}
 
-	if !eGame.Open {
-		r.Error(errors.NewFriendly("the game is not open to people joining"))
-		return
+	invite := eGame.Invite(inviteToken)
+
+	if inviteToken != "" && invite == nil {
+		return invalidPlayerIndex, errors.NewFriendly("That invite is no longer valid.")
+	}
+
+	//A valid invite lets you in even if the game is closed or has a
+	//password.
+	if invite == nil {
+		if !eGame.Open {
+			return invalidPlayerIndex, errors.NewFriendly("the game is not open to people joining")
+		}
+
+		if !eGame.CheckPassword(password) {
+			return invalidPlayerIndex, errors.NewFriendly("That is not the right password for this game.")
+		}
 	}
 
 	if viewingAsPlayer != boardgame.ObserverPlayerIndex {
-		r.Error(errors.NewFriendly("The given player is already in the game."))
-		return
+		return invalidPlayerIndex, errors.NewFriendly("The given player is already in the game.")
 	}
 
 	if len(emptySlots) == 0 {
-		r.Error(errors.NewFriendly("There aren't any empty slots in the game to join."))
-		return
+		return invalidPlayerIndex, errors.NewFriendly("There aren't any empty slots in the game to join.")
 	}
 
 	slot := emptySlots[0]
 
+	if invite != nil && invite.Seat != extendedgame.AnySeat {
+		slot = boardgame.PlayerIndex(invite.Seat)
+		seatEmpty := false
+		for _, emptySlot := range emptySlots {
+			if emptySlot == slot {
+				seatEmpty = true
+				break
+			}
+		}
+		if !seatEmpty {
+			return invalidPlayerIndex, errors.NewFriendly("The seat you were invited to is no longer available.")
+		}
+	}
+
 	if err := s.doSeatPlayer(game, slot, user); err != nil {
-		r.Error(errors.New("Tried to set the user as player " + slot.String() + " but failed: " + err.Error()))
+		return invalidPlayerIndex, errors.New("Tried to set the user as player " + slot.String() + " but failed: " + err.Error())
 	}
 
-	r.Success(nil)
+	if invite != nil && invite.Seat != extendedgame.AnySeat {
+		//Invites for a specific seat are single use.
+		eGame.RevokeInvite(invite.Token)
+		if err := s.storage.UpdateExtendedGame(game.ID(), eGame); err != nil {
+			s.logger.Errorln("Couldn't revoke used invite: " + err.Error())
+		}
+	}
+
+	return slot, nil
 }
 
 func (s *Server) newGameHandler(c *gin.Context) {
@@ -595,14 +691,33 @@ func (s *Server) newGameHandler(c *gin.Context) {
 
 func (s *Server) doNewGame(r *renderer, owner *users.StorageRecord, manager *boardgame.GameManager, numPlayers int, agents []string, open bool, visible bool, variant map[string]string) {
 
-	if manager == nil {
-		r.Error(errors.New("No manager provided"))
+	game, err := s.calcNewGame(owner, manager, numPlayers, agents, open, visible, variant)
+
+	if err != nil {
+		r.Error(err)
 		return
 	}
 
+	r.Success(gin.H{
+		"GameID":   game.ID(),
+		"GameName": game.Name(),
+	})
+}
+
+//calcNewGame creates a new game owned by owner and saves its extended
+//metadata.
+func (s *Server) calcNewGame(owner *users.StorageRecord, manager *boardgame.GameManager, numPlayers int, agents []string, open bool, visible bool, variant map[string]string) (*boardgame.Game, *errors.Friendly) {
+
+	if manager == nil {
+		return nil, errors.New("No manager provided")
+	}
+
 	if owner == nil {
-		r.Error(errors.NewFriendly("You must be signed in to create a game."))
-		return
+		return nil, errors.NewFriendly("You must be signed in to create a game.")
+	}
+
+	if !s.newGameLimiter.Allow(owner.ID) {
+		return nil, errors.NewFriendly("You've created too many games recently. Please wait a while before creating another.").WithError("User " + owner.ID + " exceeded their new game rate limit")
 	}
 
 	game, err := manager.NewGame(numPlayers, variant, agents)
@@ -610,18 +725,15 @@ func (s *Server) doNewGame(r *renderer, owner *users.StorageRecord, manager *boa
 	if err != nil {
 		//TODO: communicate the error state back to the client in a sane way
 		if f, ok := err.(*errors.Friendly); ok {
-			r.Error(f)
-		} else {
-			r.Error(errors.New(err.Error()))
+			return nil, f
 		}
-		return
+		return nil, errors.New(err.Error())
 	}
 
 	eGame, err := s.storage.ExtendedGame(game.ID())
 
 	if err != nil {
-		r.Error(errors.New("Couldn't retrieve saved game: " + err.Error()))
-		return
+		return nil, errors.New("Couldn't retrieve saved game: " + err.Error())
 	}
 
 	eGame.Owner = owner.ID
@@ -631,14 +743,12 @@ func (s *Server) doNewGame(r *renderer, owner *users.StorageRecord, manager *boa
 	//TODO: set Open, Visible based on query params.
 
 	if err := s.storage.UpdateExtendedGame(game.ID(), eGame); err != nil {
-		r.Error(errors.New("Couldn't save extended game metadata: " + err.Error()))
-		return
+		return nil, errors.New("Couldn't save extended game metadata: " + err.Error())
 	}
 
-	r.Success(gin.H{
-		"GameID":   game.ID(),
-		"GameName": game.Name(),
-	})
+	s.dispatchGameCreated(game, owner.ID)
+
+	return game, nil
 }
 
 func (s *Server) listGamesHandler(c *gin.Context) {
@@ -674,16 +784,19 @@ func (s *Server) doListGames(r *renderer, user *users.StorageRecord, gameName st
 	r.Success(result)
 }
 
-type gameStorageRecordWithUsers struct {
+//GameListing is a game in a list of games, sanitized so it's safe to share
+//with anyone who can see the game.
+type GameListing struct {
 	*extendedgame.CombinedStorageRecord
-	Players              []*playerBoardInfo
+	Players              []*PlayerInfo
 	ReadableLastActivity string
+	HasPassword          bool
 }
 
-func (s *Server) listGamesWithUsers(max int, list listing.Type, userID string, gameName string) []*gameStorageRecordWithUsers {
+func (s *Server) listGamesWithUsers(max int, list listing.Type, userID string, gameName string) []*GameListing {
 	games := s.storage.ListGames(max, list, userID, gameName)
 
-	result := make([]*gameStorageRecordWithUsers, len(games))
+	result := make([]*GameListing, len(games))
 
 	for i, game := range games {
 
@@ -695,10 +808,17 @@ func (s *Server) listGamesWithUsers(max int, list listing.Type, userID string, g
 		//important SecretSalt here?
 		game.SecretSalt = ""
 
-		result[i] = &gameStorageRecordWithUsers{
+		//Whether a game has a password is fine to share, but the hash and
+		//the invites aren't.
+		hasPassword := game.PasswordHash != ""
+		game.PasswordHash = ""
+		game.Invites = nil
+
+		result[i] = &GameListing{
 			game,
 			s.gamePlayerInfo(&game.GameStorageRecord, manager),
 			humanize.Time(game.Modified),
+			hasPassword,
 		}
 	}
 
@@ -857,16 +977,22 @@ func (s *Server) moveBundles(game *boardgame.Game, moves []*boardgame.MoveStorag
 		// Only compute legality for the last bundle (the state the player
 		// will interact with). Intermediate animation bundles use plain
 		// forms without legality — zero extra cost.
-		var forms []*moveForm
+		var forms []*MoveForm
 		if i == len(moves)-1 {
 			forms = s.generateFormsWithLegality(game, state, playerIndex)
 		} else {
 			forms = s.generateForms(game)
 		}
 
+		moveRecord, err := game.MoveRecordForPlayer(playerIndex, move)
+
+		if err != nil {
+			return nil, errors.New("Couldn't sanitize move for " + strconv.Itoa(i) + ": " + err.Error())
+		}
+
 		bundle := gin.H{
 			"Game":            gameJSON,
-			"Move":            move,
+			"Move":            moveRecord,
 			"ViewingAsPlayer": playerIndex,
 			"Forms":           forms,
 		}
@@ -1014,27 +1140,28 @@ func (s *Server) gameInfoHandler(c *gin.Context) {
 
 }
 
-type playerBoardInfo struct {
+//PlayerInfo describes who is playing a seat in a game.
+type PlayerInfo struct {
 	DisplayName string
 	IsAgent     bool
 	IsEmpty     bool
 	PhotoURL    string
 }
 
-func (s *Server) gamePlayerInfo(game *boardgame.GameStorageRecord, manager *boardgame.GameManager) []*playerBoardInfo {
+func (s *Server) gamePlayerInfo(game *boardgame.GameStorageRecord, manager *boardgame.GameManager) []*PlayerInfo {
 
 	if manager == nil {
 		return nil
 	}
 
-	result := make([]*playerBoardInfo, game.NumPlayers)
+	result := make([]*PlayerInfo, game.NumPlayers)
 
 	userIds := s.storage.UserIDsForGame(game.ID)
 	agentNames := game.Agents
 
 	for i := range result {
 
-		player := &playerBoardInfo{}
+		player := &PlayerInfo{}
 
 		result[i] = player
 
@@ -1058,6 +1185,13 @@ func (s *Server) gamePlayerInfo(game *boardgame.GameStorageRecord, manager *boar
 			continue
 		}
 
+		if userID == users.DeletedUserID {
+			player.IsAgent = false
+			player.IsEmpty = false
+			player.DisplayName = "Deleted user"
+			continue
+		}
+
 		user := s.storage.GetUserByID(userID)
 
 		if user == nil {
@@ -1139,6 +1273,7 @@ func (s *Server) doGameInfo(r *renderer, game *boardgame.Game, playerIndex board
 		"HasEmptySlots":   hasEmptySlots,
 		"GameOpen":        gameInfo.Open,
 		"GameVisible":     gameInfo.Visible,
+		"GameHasPassword": gameInfo.PasswordHash != "",
 		"IsOwner":         isOwner,
 		//The StateVersion is almost always the Game.Version, except in the
 		//special case described above where lots of fix up moves have been
@@ -1147,6 +1282,11 @@ func (s *Server) doGameInfo(r *renderer, game *boardgame.Game, playerIndex board
 		"StateVersion": state.Version(),
 	}
 
+	if isOwner {
+		//Only the owner gets to see (and hand out) invites.
+		args["Invites"] = gameInfo.Invites
+	}
+
 	s.lastErrorMessage = ""
 
 	r.Success(args)
@@ -1193,13 +1333,8 @@ func (s *Server) moveHandler(c *gin.Context) {
 
 func (s *Server) doMakeMove(r *renderer, game *boardgame.Game, proposer boardgame.PlayerIndex, move boardgame.Move) {
 
-	if err := <-game.ProposeMove(move, proposer); err != nil {
-
-		if f, ok := err.(*errors.Friendly); ok {
-			r.Error(f)
-		} else {
-			r.Error(errors.New(err.Error()))
-		}
+	if err := s.calcMakeMove(game, proposer, move); err != nil {
+		r.Error(err)
 		return
 	}
 	//TODO: it would be nice if we could show which fixup moves we made, too,
@@ -1208,9 +1343,24 @@ func (s *Server) doMakeMove(r *renderer, game *boardgame.Game, proposer boardgam
 	r.Success(nil)
 }
 
-func (s *Server) generateForms(game *boardgame.Game) []*moveForm {
+//calcMakeMove proposes move on behalf of proposer and waits for it to be
+//applied or rejected.
+func (s *Server) calcMakeMove(game *boardgame.Game, proposer boardgame.PlayerIndex, move boardgame.Move) *errors.Friendly {
 
-	var result []*moveForm
+	if err := <-game.ProposeMove(move, proposer); err != nil {
+
+		if f, ok := err.(*errors.Friendly); ok {
+			return f
+		}
+		return errors.New(err.Error())
+	}
+
+	return nil
+}
+
+func (s *Server) generateForms(game *boardgame.Game) []*MoveForm {
+
+	var result []*MoveForm
 
 	for _, move := range game.Moves() {
 
@@ -1218,7 +1368,7 @@ func (s *Server) generateForms(game *boardgame.Game) []*moveForm {
 			continue
 		}
 
-		moveItem := &moveForm{
+		moveItem := &MoveForm{
 			Name:     move.Info().Name(),
 			HelpText: move.HelpText(),
 			Fields:   formFields(move),
@@ -1233,15 +1383,15 @@ func (s *Server) generateForms(game *boardgame.Game) []*moveForm {
 // information for each move against the given state and player. This tells the
 // client which moves are currently legal (for enabling/disabling buttons) and
 // which are structurally possible (for showing/hiding buttons).
-func (s *Server) generateFormsWithLegality(game *boardgame.Game, state boardgame.ImmutableState, playerIndex boardgame.PlayerIndex) []*moveForm {
-	var result []*moveForm
+func (s *Server) generateFormsWithLegality(game *boardgame.Game, state boardgame.ImmutableState, playerIndex boardgame.PlayerIndex) []*MoveForm {
+	var result []*MoveForm
 
 	for _, move := range game.Moves() {
 		if base.IsFixUp(move) {
 			continue
 		}
 
-		moveItem := &moveForm{
+		moveItem := &MoveForm{
 			Name:     move.Info().Name(),
 			HelpText: move.HelpText(),
 			Fields:   formFields(move),
@@ -1267,15 +1417,15 @@ func (s *Server) generateFormsWithLegality(game *boardgame.Game, state boardgame
 	return result
 }
 
-func formFields(move boardgame.Move) []*moveFormField {
+func formFields(move boardgame.Move) []*MoveFormField {
 
-	var result []*moveFormField
+	var result []*MoveFormField
 
 	for fieldName, fieldType := range move.ReadSetter().Props() {
 
 		val, _ := move.ReadSetter().Prop(fieldName)
 
-		info := &moveFormField{
+		info := &MoveFormField{
 			Name:         fieldName,
 			Type:         fieldType,
 			DefaultValue: val,
@@ -1304,6 +1454,87 @@ func (s *Server) genericHandler(c *gin.Context) {
 	})
 }
 
+//registerRoutes adds every API endpoint to router. Every route it adds must
+//be described in openAPISpec, too.
+func (s *Server) registerRoutes(router *gin.Engine) {
+
+	//We have everything prefixed by /api just in case at some point we do
+	//want to host both static and api on the same logical server.
+	mainGroup := router.Group("/api")
+	mainGroup.Use(s.userSetup, s.rateLimit)
+
+	{
+		mainGroup.GET("openapi.json", s.openAPIHandler)
+
+		mainGroup.GET("list/game", s.listGamesHandler)
+		mainGroup.GET("list/manager", s.listManagerHandler)
+
+		mainGroup.GET("leaderboard/:name", s.leaderboardHandler)
+		mainGroup.GET("user/:userid/ratings", s.userRatingsHandler)
+		mainGroup.GET("list/tournament", s.listTournamentsHandler)
+
+		mainGroup.POST("auth", s.authCookieHandler)
+
+		protectedMainGroup := mainGroup.Group("")
+		protectedMainGroup.Use(s.requireLoggedIn)
+		protectedMainGroup.POST("new/game", s.newGameHandler)
+		protectedMainGroup.POST("settings/notifications", s.notificationPreferencesHandler)
+		protectedMainGroup.GET("settings/export", s.exportUserDataHandler)
+		protectedMainGroup.POST("settings/delete", s.deleteUserHandler)
+		protectedMainGroup.POST("new/tournament", s.newTournamentHandler)
+
+		tournamentAPIGroup := mainGroup.Group("tournament/:tournament")
+		tournamentAPIGroup.Use(s.tournamentAPISetup)
+		{
+			tournamentAPIGroup.GET("info", s.tournamentInfoHandler)
+			tournamentAPIGroup.GET("socket", s.tournamentSocketHandler)
+
+			protectedTournamentAPIGroup := tournamentAPIGroup.Group("")
+			protectedTournamentAPIGroup.Use(s.requireLoggedIn)
+			protectedTournamentAPIGroup.POST("register", s.registerTournamentHandler)
+			protectedTournamentAPIGroup.POST("start", s.startTournamentHandler)
+		}
+
+		adminGroup := mainGroup.Group("admin")
+		adminGroup.Use(s.requireLoggedIn, s.requireAdmin)
+		{
+			adminGroup.GET("users", s.adminListUsersHandler)
+			adminGroup.POST("user/:userid/ban", s.adminBanUserHandler)
+			adminGroup.GET("audit", s.adminAuditHandler)
+
+			adminGameGroup := adminGroup.Group("game/:name/:id")
+			adminGameGroup.Use(s.adminGameSetup)
+			adminGameGroup.GET("history", s.adminGameHistoryHandler)
+			adminGameGroup.POST("delete", s.adminDeleteGameHandler)
+			adminGameGroup.POST("finish", s.adminFinishGameHandler)
+			adminGameGroup.POST("seat", s.adminSetSeatHandler)
+			adminGameGroup.POST("configure", s.adminConfigureGameHandler)
+		}
+
+		gameAPIGroup := mainGroup.Group("game/:name/:id")
+		gameAPIGroup.Use(s.gameAPISetup)
+		{
+			gameAPIGroup.GET("socket", s.socketHandler)
+			gameAPIGroup.GET("info", s.gameInfoHandler)
+			gameAPIGroup.GET("version/:version", s.gameVersionHandler)
+
+			//The statusHandler is conceptually here, but becuase we want to
+			//optimize it so much we have it congfigured at the top level.
+
+			protectedGameAPIGroup := gameAPIGroup.Group("")
+			protectedGameAPIGroup.Use(s.requireLoggedIn)
+			protectedGameAPIGroup.POST("move", s.moveHandler)
+			protectedGameAPIGroup.POST("join", s.joinGameHandler)
+			protectedGameAPIGroup.POST("configure", s.configureGameHandler)
+			protectedGameAPIGroup.POST("password", s.gamePasswordHandler)
+			protectedGameAPIGroup.POST("invite", s.createInviteHandler)
+			protectedGameAPIGroup.POST("invite/revoke", s.revokeInviteHandler)
+			protectedGameAPIGroup.POST("leave", s.leaveGameHandler)
+			protectedGameAPIGroup.POST("kick", s.kickPlayerHandler)
+		}
+	}
+}
+
 //Start is where you start the server, and it never returns until it's time to shut down.
 func (s *Server) Start() {
 
@@ -1347,9 +1578,24 @@ func (s *Server) Start() {
 
 	s.notifier = newVersionNotifier(s)
 
+	s.configureNotificationBackends()
+
+	s.configureWebhooks()
+
+	s.configureRateLimits()
+
+	s.configureInactivity()
+
+	s.startInactivityChecks()
+
 	router := gin.New()
 
-	router.Use(gin.Recovery(), gin.LoggerWithWriter(os.Stdout, "/_ah/health"))
+	//Otherwise ClientIP trusts headers any client may set.
+	router.ForwardedByClientIP = s.behindProxy()
+
+	router.Use(gin.Recovery(), gin.LoggerWithWriter(os.Stdout, "/_ah/health"), s.recordRequestMetrics)
+
+	s.configureMetrics(router)
 
 	router.NoRoute(s.genericHandler)
 	router.Use(cors.Middleware(cors.Config{
@@ -1360,38 +1606,9 @@ func (s *Server) Start() {
 		Credentials:    true,
 	}))
 
-	//We have everything prefixed by /api just in case at some point we do
-	//want to host both static and api on the same logical server.
-	mainGroup := router.Group("/api")
-	mainGroup.Use(s.userSetup)
-
-	{
-		mainGroup.GET("list/game", s.listGamesHandler)
-		mainGroup.GET("list/manager", s.listManagerHandler)
-
-		mainGroup.POST("auth", s.authCookieHandler)
-
-		protectedMainGroup := mainGroup.Group("")
-		protectedMainGroup.Use(s.requireLoggedIn)
-		protectedMainGroup.POST("new/game", s.newGameHandler)
-
-		gameAPIGroup := mainGroup.Group("game/:name/:id")
-		gameAPIGroup.Use(s.gameAPISetup)
-		{
-			gameAPIGroup.GET("socket", s.socketHandler)
-			gameAPIGroup.GET("info", s.gameInfoHandler)
-			gameAPIGroup.GET("version/:version", s.gameVersionHandler)
-
-			//The statusHandler is conceptually here, but becuase we want to
-			//optimize it so much we have it congfigured at the top level.
+	s.registerRoutes(router)
 
-			protectedGameAPIGroup := gameAPIGroup.Group("")
-			protectedGameAPIGroup.Use(s.requireLoggedIn)
-			protectedGameAPIGroup.POST("move", s.moveHandler)
-			protectedGameAPIGroup.POST("join", s.joinGameHandler)
-			protectedGameAPIGroup.POST("configure", s.configureGameHandler)
-		}
-	}
+	s.startFrontends()
 
 	if p := os.Getenv("PORT"); p != "" {
 		router.Run(":" + p)
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/base"
+	"github.com/jkomoros/boardgame/behaviors"
+	"github.com/jkomoros/boardgame/components/dice"
+	"github.com/jkomoros/boardgame/moves"
+	"github.com/workfit/tester/assert"
+)
+
+//testStorageManager is a minimal in-memory boardgame.StorageManager. The
+//packages in boardgame/storage all import this package, so tests here can't
+//use them.
+type testStorageManager struct {
+	lock   sync.Mutex
+	games  map[string]*boardgame.GameStorageRecord
+	states map[string][]boardgame.StateStorageRecord
+	moves  map[string][]*boardgame.MoveStorageRecord
+}
+
+func newTestStorageManager() *testStorageManager {
+	return &testStorageManager{
+		games:  make(map[string]*boardgame.GameStorageRecord),
+		states: make(map[string][]boardgame.StateStorageRecord),
+		moves:  make(map[string][]*boardgame.MoveStorageRecord),
+	}
+}
+
+func (t *testStorageManager) State(gameID string, version int) (boardgame.StateStorageRecord, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	states := t.states[gameID]
+	if version < 0 || version >= len(states) {
+		return nil, errors.New("No such state")
+	}
+	return states[version], nil
+}
+
+func (t *testStorageManager) Move(gameID string, version int) (*boardgame.MoveStorageRecord, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	moves := t.moves[gameID]
+	if version < 1 || version > len(moves) {
+		return nil, errors.New("No such move")
+	}
+	return moves[version-1], nil
+}
+
+func (t *testStorageManager) Moves(gameID string, fromVersion, toVersion int) ([]*boardgame.MoveStorageRecord, error) {
+	if fromVersion == toVersion {
+		fromVersion--
+	}
+	var result []*boardgame.MoveStorageRecord
+	for version := fromVersion + 1; version <= toVersion; version++ {
+		move, err := t.Move(gameID, version)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, move)
+	}
+	return result, nil
+}
+
+func (t *testStorageManager) Game(id string) (*boardgame.GameStorageRecord, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	game := t.games[id]
+	if game == nil {
+		return nil, errors.New("No such game")
+	}
+	result := *game
+	return &result, nil
+}
+
+func (t *testStorageManager) AgentState(gameID string, player boardgame.PlayerIndex) ([]byte, error) {
+	return nil, nil
+}
+
+func (t *testStorageManager) SaveGameAndCurrentState(game *boardgame.GameStorageRecord, state boardgame.StateStorageRecord, move *boardgame.MoveStorageRecord) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	record := *game
+	t.games[game.ID] = &record
+	t.states[game.ID] = append(t.states[game.ID], state)
+	if move != nil {
+		t.moves[game.ID] = append(t.moves[game.ID], move)
+	}
+	return nil
+}
+
+func (t *testStorageManager) SaveAgentState(gameID string, player boardgame.PlayerIndex, state []byte) error {
+	return errors.New("Agents aren't supported")
+}
+
+func (t *testStorageManager) PlayerMoveApplied(game *boardgame.GameStorageRecord) error {
+	return nil
+}
+
+func (t *testStorageManager) FetchInjectedDataForGame(gameID string, dataType string) interface{} {
+	return nil
+}
+
+//boardgame:codegen
+type testGameState struct {
+	base.SubState
+	behaviors.CurrentPlayerBehavior
+	behaviors.Auction
+	behaviors.PendingTrade
+	behaviors.DicePool
+	Dice boardgame.Stack `stack:"dice"`
+}
+
+//boardgame:codegen
+type testPlayerState struct {
+	base.SubState
+	Wood  int
+	Brick int
+}
+
+type testGameDelegate struct {
+	base.GameDelegate
+}
+
+//Name returns "api", because the name must match the package the delegate is
+//in.
+func (t *testGameDelegate) Name() string {
+	return "api"
+}
+
+func (t *testGameDelegate) GameStateConstructor() boardgame.ConfigurableSubState {
+	return new(testGameState)
+}
+
+func (t *testGameDelegate) PlayerStateConstructor(index boardgame.PlayerIndex) boardgame.ConfigurableSubState {
+	return new(testPlayerState)
+}
+
+func (t *testGameDelegate) ConfigureDecks() map[string]*boardgame.Deck {
+	diceDeck := boardgame.NewDeck()
+	for i := 0; i < 3; i++ {
+		diceDeck.AddComponent(dice.DefaultDie())
+	}
+	return map[string]*boardgame.Deck{
+		"dice": diceDeck,
+	}
+}
+
+func (t *testGameDelegate) DistributeComponentToStarterStack(state boardgame.ImmutableState, c boardgame.Component) (boardgame.ImmutableStack, error) {
+	return state.ImmutableGameState().(*testGameState).Dice, nil
+}
+
+func (t *testGameDelegate) DynamicComponentValuesConstructor(deck *boardgame.Deck) boardgame.ConfigurableSubState {
+	return &dice.DynamicValue{
+		Value: 1,
+	}
+}
+
+func (t *testGameDelegate) ConfigureMoves() []boardgame.MoveConfig {
+	auto := moves.NewAutoConfigurer(t)
+
+	return []boardgame.MoveConfig{
+		auto.MustConfig(
+			new(moves.PlaceBid),
+			moves.WithAuctionType(moves.AuctionSealed),
+		),
+		auto.MustConfig(
+			new(moves.OfferTrade),
+			moves.WithTradeResources("Wood", "Brick"),
+		),
+		auto.MustConfig(
+			new(moves.RerollSelected),
+			moves.WithGameProperty("Dice"),
+		),
+	}
+}
+
+func newTestGame(t *testing.T) *boardgame.Game {
+	manager, err := boardgame.NewGameManager(&testGameDelegate{}, newTestStorageManager())
+	assert.For(t).ThatActual(err).IsNil()
+
+	game, err := manager.NewDefaultGame()
+	assert.For(t).ThatActual(err).IsNil()
+
+	return game
+}
+
+//postMove returns the move getMoveFromForm makes from a request posting
+//values.
+func postMove(game *boardgame.Game, values url.Values) (boardgame.Move, error) {
+	s := &Server{}
+
+	request := httptest.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = request
+
+	return s.getMoveFromForm(c, game)
+}
+
+//bundleMoveBlob returns the blob of the move in the last bundle
+//moveBundles makes for player.
+func bundleMoveBlob(t *testing.T, game *boardgame.Game, player boardgame.PlayerIndex) map[string]interface{} {
+	s := &Server{}
+
+	record, err := game.Manager().Storage().Move(game.ID(), game.Version())
+	assert.For(t).ThatActual(err).IsNil()
+
+	bundles, err := s.moveBundles(game, []*boardgame.MoveStorageRecord{record}, player, false)
+	assert.For(t).ThatActual(err).IsNil()
+
+	var result map[string]interface{}
+	assert.For(t).ThatActual(json.Unmarshal(bundles[len(bundles)-1]["Move"].(*boardgame.MoveStorageRecord).Blob, &result)).IsNil()
+
+	return result
+}
+
+func TestMoveBundlesHideSealedBids(t *testing.T) {
+	game := newTestGame(t)
+
+	move := game.MoveByName("Place Bid").(*moves.PlaceBid)
+	move.TargetPlayerIndex = 1
+	move.Bid = 7
+
+	assert.For(t).ThatActual(<-game.ProposeMove(move, 1)).IsNil()
+
+	assert.For(t).ThatActual(bundleMoveBlob(t, game, 0)["Bid"]).Equals(0.0)
+	assert.For(t).ThatActual(bundleMoveBlob(t, game, boardgame.ObserverPlayerIndex)["Bid"]).Equals(0.0)
+	assert.For(t).ThatActual(bundleMoveBlob(t, game, 1)["Bid"]).Equals(7.0)
+}
+
+func TestPostIntSliceFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	game := newTestGame(t)
+
+	move, err := postMove(game, url.Values{
+		"MoveType":            {"Offer Trade"},
+		"TargetPlayerIndex":   {"0"},
+		"Recipient":           {"1"},
+		"OfferedComponents":   {""},
+		"RequestedComponents": {""},
+		"OfferedResources":    {"2, 0"},
+		"RequestedResources":  {"0,1"},
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	offer := move.(*moves.OfferTrade)
+
+	assert.For(t).ThatActual(offer.Recipient).Equals(boardgame.PlayerIndex(1))
+	assert.For(t).ThatActual(len(offer.OfferedComponents)).Equals(0)
+	assert.For(t).ThatActual(offer.OfferedResources).Equals([]int{2, 0})
+	assert.For(t).ThatActual(offer.RequestedResources).Equals([]int{0, 1})
+
+	move, err = postMove(game, url.Values{
+		"MoveType":          {"Reroll Selected"},
+		"TargetPlayerIndex": {"0"},
+		"ComponentIndexes":  {"0,2"},
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(move.(*moves.RerollSelected).ComponentIndexes).Equals([]int{0, 2})
+
+	_, err = postMove(game, url.Values{
+		"MoveType":          {"Reroll Selected"},
+		"TargetPlayerIndex": {"0"},
+		"ComponentIndexes":  {"0,two"},
+	})
+
+	assert.For(t).ThatActual(err).IsNotNil()
+}
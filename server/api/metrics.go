@@ -0,0 +1,331 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/audit"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const defaultMetricsPath = "/metrics"
+
+var (
+	metricRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "boardgame",
+		Subsystem: "server",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests handled, by handler, method and status code.",
+	}, []string{"handler", "method", "status"})
+
+	metricRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "boardgame",
+		Subsystem: "server",
+		Name:      "request_duration_seconds",
+		Help:      "How long HTTP requests took to handle, by handler.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler"})
+
+	metricOpenSockets = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "boardgame",
+		Subsystem: "server",
+		Name:      "open_sockets",
+		Help:      "Number of sockets registered with the version notifier.",
+	})
+
+	metricStorageDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "boardgame",
+		Subsystem: "storage",
+		Name:      "call_duration_seconds",
+		Help:      "How long calls to the storage manager took, by backend and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "method"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		metricRequests,
+		metricRequestDuration,
+		metricOpenSockets,
+		metricStorageDuration,
+	)
+}
+
+//handlerLabel returns a short name for the handler that will serve c, like
+//"moveHandler", to label request metrics with. Unlike the request's path it
+//doesn't include game IDs and the like, so it has few distinct values.
+func handlerLabel(c *gin.Context) string {
+	name := c.HandlerName()
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
+
+//recordRequestMetrics is middleware that counts and times every request.
+func (s *Server) recordRequestMetrics(c *gin.Context) {
+	start := time.Now()
+	handler := handlerLabel(c)
+
+	c.Next()
+
+	metricRequests.WithLabelValues(handler, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+	metricRequestDuration.WithLabelValues(handler).Observe(time.Since(start).Seconds())
+}
+
+//configureMetrics exposes the metrics as described in config, either on the
+//given router or, if a port is configured, on a separate listener. Called by
+//Start() once config is loaded. Does nothing if metrics aren't configured.
+func (s *Server) configureMetrics(router *gin.Engine) {
+	metricsConfig := s.config.Metrics
+
+	if metricsConfig == nil {
+		return
+	}
+
+	if err := boardgame.RegisterMetrics(prometheus.DefaultRegisterer); err != nil {
+		s.logger.Errorln("Couldn't register engine metrics: " + err.Error())
+	}
+
+	path := metricsConfig.Path
+
+	if path == "" {
+		path = defaultMetricsPath
+	}
+
+	if metricsConfig.Port == "" {
+		router.GET(path, gin.WrapH(promhttp.Handler()))
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.Handler())
+
+	go func() {
+		if err := http.ListenAndServe(":"+metricsConfig.Port, mux); err != nil {
+			s.logger.Errorln("Metrics listener stopped: " + err.Error())
+		}
+	}()
+}
+
+//metricsStorageManager wraps a StorageManager and records how long each call
+//to it takes.
+type metricsStorageManager struct {
+	manager StorageManager
+	backend string
+}
+
+func newMetricsStorageManager(manager StorageManager) *metricsStorageManager {
+	return &metricsStorageManager{
+		manager: manager,
+		backend: manager.Name(),
+	}
+}
+
+//observe records a call to method that started at start. Designed to be
+//deferred.
+func (m *metricsStorageManager) observe(method string, start time.Time) {
+	metricStorageDuration.WithLabelValues(m.backend, method).Observe(time.Since(start).Seconds())
+}
+
+func (m *metricsStorageManager) State(gameID string, version int) (boardgame.StateStorageRecord, error) {
+	defer m.observe("State", time.Now())
+	return m.manager.State(gameID, version)
+}
+
+func (m *metricsStorageManager) Move(gameID string, version int) (*boardgame.MoveStorageRecord, error) {
+	defer m.observe("Move", time.Now())
+	return m.manager.Move(gameID, version)
+}
+
+func (m *metricsStorageManager) Moves(gameID string, fromVersion, toVersion int) ([]*boardgame.MoveStorageRecord, error) {
+	defer m.observe("Moves", time.Now())
+	return m.manager.Moves(gameID, fromVersion, toVersion)
+}
+
+func (m *metricsStorageManager) Game(id string) (*boardgame.GameStorageRecord, error) {
+	defer m.observe("Game", time.Now())
+	return m.manager.Game(id)
+}
+
+func (m *metricsStorageManager) AgentState(gameID string, player boardgame.PlayerIndex) ([]byte, error) {
+	defer m.observe("AgentState", time.Now())
+	return m.manager.AgentState(gameID, player)
+}
+
+func (m *metricsStorageManager) SaveGameAndCurrentState(game *boardgame.GameStorageRecord, state boardgame.StateStorageRecord, move *boardgame.MoveStorageRecord) error {
+	defer m.observe("SaveGameAndCurrentState", time.Now())
+	return m.manager.SaveGameAndCurrentState(game, state, move)
+}
+
+func (m *metricsStorageManager) SaveAgentState(gameID string, player boardgame.PlayerIndex, state []byte) error {
+	defer m.observe("SaveAgentState", time.Now())
+	return m.manager.SaveAgentState(gameID, player, state)
+}
+
+func (m *metricsStorageManager) UpdateGame(game *boardgame.GameStorageRecord) error {
+	updater, ok := m.manager.(boardgame.GameUpdater)
+	if !ok {
+		return errors.New("The StorageManager doesn't support updating games")
+	}
+	defer m.observe("UpdateGame", time.Now())
+	return updater.UpdateGame(game)
+}
+
+func (m *metricsStorageManager) PlayerMoveApplied(game *boardgame.GameStorageRecord) error {
+	defer m.observe("PlayerMoveApplied", time.Now())
+	return m.manager.PlayerMoveApplied(game)
+}
+
+func (m *metricsStorageManager) FetchInjectedDataForGame(gameID string, dataType string) interface{} {
+	defer m.observe("FetchInjectedDataForGame", time.Now())
+	return m.manager.FetchInjectedDataForGame(gameID, dataType)
+}
+
+func (m *metricsStorageManager) Name() string {
+	return m.manager.Name()
+}
+
+func (m *metricsStorageManager) WithManagers(managers []*boardgame.GameManager) {
+	m.manager.WithManagers(managers)
+}
+
+func (m *metricsStorageManager) Connect(config string) error {
+	defer m.observe("Connect", time.Now())
+	return m.manager.Connect(config)
+}
+
+func (m *metricsStorageManager) ExtendedGame(id string) (*extendedgame.StorageRecord, error) {
+	defer m.observe("ExtendedGame", time.Now())
+	return m.manager.ExtendedGame(id)
+}
+
+func (m *metricsStorageManager) CombinedGame(id string) (*extendedgame.CombinedStorageRecord, error) {
+	defer m.observe("CombinedGame", time.Now())
+	return m.manager.CombinedGame(id)
+}
+
+func (m *metricsStorageManager) UpdateExtendedGame(id string, eGame *extendedgame.StorageRecord) error {
+	defer m.observe("UpdateExtendedGame", time.Now())
+	return m.manager.UpdateExtendedGame(id, eGame)
+}
+
+func (m *metricsStorageManager) UpdateInactivityClock(id string, clock *extendedgame.InactivityClock) error {
+	defer m.observe("UpdateInactivityClock", time.Now())
+	return m.manager.UpdateInactivityClock(id, clock)
+}
+
+func (m *metricsStorageManager) Close() {
+	m.manager.Close()
+}
+
+func (m *metricsStorageManager) ListGames(max int, list listing.Type, userID string, gameType string) []*extendedgame.CombinedStorageRecord {
+	defer m.observe("ListGames", time.Now())
+	return m.manager.ListGames(max, list, userID, gameType)
+}
+
+func (m *metricsStorageManager) UserIDsForGame(gameID string) []string {
+	defer m.observe("UserIDsForGame", time.Now())
+	return m.manager.UserIDsForGame(gameID)
+}
+
+func (m *metricsStorageManager) SetPlayerForGame(gameID string, playerIndex boardgame.PlayerIndex, userID string) error {
+	defer m.observe("SetPlayerForGame", time.Now())
+	return m.manager.SetPlayerForGame(gameID, playerIndex, userID)
+}
+
+func (m *metricsStorageManager) UpdateUser(user *users.StorageRecord) error {
+	defer m.observe("UpdateUser", time.Now())
+	return m.manager.UpdateUser(user)
+}
+
+func (m *metricsStorageManager) GetUserByID(uid string) *users.StorageRecord {
+	defer m.observe("GetUserByID", time.Now())
+	return m.manager.GetUserByID(uid)
+}
+
+func (m *metricsStorageManager) GetUserByCookie(cookie string) *users.StorageRecord {
+	defer m.observe("GetUserByCookie", time.Now())
+	return m.manager.GetUserByCookie(cookie)
+}
+
+func (m *metricsStorageManager) ConnectCookieToUser(cookie string, user *users.StorageRecord) error {
+	defer m.observe("ConnectCookieToUser", time.Now())
+	return m.manager.ConnectCookieToUser(cookie, user)
+}
+
+func (m *metricsStorageManager) Rating(userID string, gameName string) (*ratings.StorageRecord, error) {
+	defer m.observe("Rating", time.Now())
+	return m.manager.Rating(userID, gameName)
+}
+
+func (m *metricsStorageManager) UpdateRating(rating *ratings.StorageRecord, history *ratings.HistoryRecord) error {
+	defer m.observe("UpdateRating", time.Now())
+	return m.manager.UpdateRating(rating, history)
+}
+
+func (m *metricsStorageManager) ListRatings(gameName string, max int) []*ratings.StorageRecord {
+	defer m.observe("ListRatings", time.Now())
+	return m.manager.ListRatings(gameName, max)
+}
+
+func (m *metricsStorageManager) RatingHistory(userID string, gameName string, max int) []*ratings.HistoryRecord {
+	defer m.observe("RatingHistory", time.Now())
+	return m.manager.RatingHistory(userID, gameName, max)
+}
+
+func (m *metricsStorageManager) DueInactivityChecks(now int64) []string {
+	defer m.observe("DueInactivityChecks", time.Now())
+	return m.manager.DueInactivityChecks(now)
+}
+
+func (m *metricsStorageManager) Tournament(id string) (*tournaments.StorageRecord, error) {
+	defer m.observe("Tournament", time.Now())
+	return m.manager.Tournament(id)
+}
+
+func (m *metricsStorageManager) UpdateTournament(tournament *tournaments.StorageRecord) error {
+	defer m.observe("UpdateTournament", time.Now())
+	return m.manager.UpdateTournament(tournament)
+}
+
+func (m *metricsStorageManager) ListTournaments(max int, gameName string) []*tournaments.StorageRecord {
+	defer m.observe("ListTournaments", time.Now())
+	return m.manager.ListTournaments(max, gameName)
+}
+
+func (m *metricsStorageManager) ListUsers(max int, query string) []*users.StorageRecord {
+	defer m.observe("ListUsers", time.Now())
+	return m.manager.ListUsers(max, query)
+}
+
+func (m *metricsStorageManager) DeleteGame(id string) error {
+	defer m.observe("DeleteGame", time.Now())
+	return m.manager.DeleteGame(id)
+}
+
+func (m *metricsStorageManager) DeleteUser(uid string) error {
+	defer m.observe("DeleteUser", time.Now())
+	return m.manager.DeleteUser(uid)
+}
+
+func (m *metricsStorageManager) AddAuditRecord(record *audit.StorageRecord) error {
+	defer m.observe("AddAuditRecord", time.Now())
+	return m.manager.AddAuditRecord(record)
+}
+
+func (m *metricsStorageManager) AuditRecords(max int) []*audit.StorageRecord {
+	defer m.observe("AuditRecords", time.Now())
+	return m.manager.AuditRecords(max)
+}
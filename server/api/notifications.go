@@ -0,0 +1,212 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/notify"
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/sirupsen/logrus"
+)
+
+//AddNotificationBackends adds backends that will be offered a notification
+//every time it becomes a user's turn in a game they don't have open. Backends
+//configured in the notifications section of config are added automatically
+//in Start(); this is for adding custom ones. We return a reference to ourself
+//to allow chaining.
+func (s *Server) AddNotificationBackends(backends ...notify.Backend) *Server {
+	s.notificationBackends = append(s.notificationBackends, backends...)
+	return s
+}
+
+//configureNotificationBackends adds the backends described in config. Called
+//by Start() once config is loaded.
+func (s *Server) configureNotificationBackends() {
+	notificationsConfig := s.config.Notifications
+
+	if notificationsConfig == nil {
+		return
+	}
+
+	if notificationsConfig.SMTPAddr != "" {
+		s.notificationBackends = append(s.notificationBackends, notify.NewSMTPBackend(notificationsConfig.SMTPAddr, notificationsConfig.SMTPFrom, notificationsConfig.SMTPUsername, notificationsConfig.SMTPPassword))
+	}
+
+	if notificationsConfig.Webhooks {
+		s.notificationBackends = append(s.notificationBackends, notify.NewWebhookBackend(nil).AllowHosts(notificationsConfig.WebhookAllowHosts...))
+	}
+}
+
+//webhookAllowHosts returns the hosts webhooks may be sent to even though
+//they are local or private addresses.
+func (s *Server) webhookAllowHosts() []string {
+	if s.config == nil || s.config.Notifications == nil {
+		return nil
+	}
+	return s.config.Notifications.WebhookAllowHosts
+}
+
+//gameURL returns a link to the given game in the web client, or "" if we don't
+//know where the web client is hosted.
+func (s *Server) gameURL(gameName, gameID string) string {
+	if s.config == nil || s.config.Notifications == nil || s.config.Notifications.WebHost == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.config.Notifications.WebHost, "/") + "/game/" + gameName + "/" + gameID + "/"
+}
+
+//notifyCurrentPlayer is called after every player move. If the move chain
+//made it a different player's turn, and that player is a real user who
+//doesn't have the game open right now, it offers a notification to each
+//backend.
+func (s *Server) notifyCurrentPlayer(record *boardgame.GameStorageRecord) {
+
+	if len(s.notificationBackends) == 0 {
+		return
+	}
+
+	info := s.managers[record.Name]
+
+	if info == nil {
+		return
+	}
+
+	game := info.manager.Game(record.ID)
+
+	if game == nil {
+		return
+	}
+
+	delegate := game.Manager().Delegate()
+
+	currentState := game.CurrentState()
+
+	currentPlayer := delegate.CurrentPlayerIndex(currentState)
+
+	if !currentPlayer.WithinBounds(currentState) {
+		return
+	}
+
+	//Figure out who the current player was before the chain of moves that
+	//just finished, which started with the move at the initiator version.
+	lastMove, err := game.Move(game.Version())
+
+	if err != nil {
+		return
+	}
+
+	if previousState := game.State(lastMove.Info().Initiator() - 1); previousState != nil {
+		if delegate.CurrentPlayerIndex(previousState) == currentPlayer {
+			return
+		}
+	}
+
+	if agents := game.Agents(); int(currentPlayer) < len(agents) && agents[currentPlayer] != "" {
+		return
+	}
+
+	userIDs := s.storage.UserIDsForGame(game.ID())
+
+	if int(currentPlayer) >= len(userIDs) || userIDs[currentPlayer] == "" {
+		return
+	}
+
+	userID := userIDs[currentPlayer]
+
+	if s.notifier.userHasSocket(game.ID(), userID) {
+		//They're watching the game right now, they'll know.
+		return
+	}
+
+	user := s.storage.GetUserByID(userID)
+
+	if user == nil {
+		return
+	}
+
+	s.deliverNotification(user, &notify.Notification{
+		UserID:          user.ID,
+		GameName:        game.Name(),
+		GameDisplayName: delegate.DisplayName(),
+		GameID:          game.ID(),
+		PlayerIndex:     int(currentPlayer),
+		URL:             s.gameURL(game.Name(), game.ID()),
+		Message:         "It's your turn in " + delegate.DisplayName(),
+	})
+
+}
+
+//deliverNotification offers the notification to each backend the user has
+//opted in to. Delivery happens in the background.
+func (s *Server) deliverNotification(user *users.StorageRecord, notification *notify.Notification) {
+	for _, backend := range s.notificationBackends {
+		if !backend.ShouldNotify(user) {
+			continue
+		}
+		//Delivery does network I/O, and we may be being called from the
+		//game's main loop, so don't block.
+		go func(backend notify.Backend) {
+			if err := backend.Notify(user, notification); err != nil {
+				s.logger.WithFields(logrus.Fields{
+					"Backend": backend.Name(),
+					"UserID":  user.ID,
+					"GameID":  notification.GameID,
+				}).Errorln("Couldn't deliver notification: " + err.Error())
+			}
+		}(backend)
+	}
+}
+
+func (s *Server) notificationPreferencesHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	user := s.getUser(c)
+
+	notifyByEmail := s.getRequestNotifyByEmail(c)
+
+	webhookURL := s.getRequestNotifyWebhook(c)
+
+	s.doNotificationPreferences(r, user, notifyByEmail, webhookURL)
+}
+
+//doNotificationPreferences updates the preferences that were sent; a nil
+//notifyByEmail or webhookURL leaves that preference as it was.
+func (s *Server) doNotificationPreferences(r *renderer, user *users.StorageRecord, notifyByEmail *bool, webhookURL *string) {
+
+	if user == nil {
+		r.Error(errors.NewFriendly("You must be signed in to change notification settings."))
+		return
+	}
+
+	if webhookURL != nil && *webhookURL != "" {
+		if err := notify.ValidateWebhookURL(*webhookURL, s.webhookAllowHosts()...); err != nil {
+			r.Error(errors.NewFriendly(err.Error()))
+			return
+		}
+	}
+
+	if notifyByEmail != nil && *notifyByEmail && user.Email == "" {
+		r.Error(errors.NewFriendly("You don't have an email address to be notified at."))
+		return
+	}
+
+	if notifyByEmail != nil {
+		user.NotifyByEmail = *notifyByEmail
+	}
+
+	if webhookURL != nil {
+		user.NotifyWebhookURL = *webhookURL
+	}
+
+	if err := s.storage.UpdateUser(user); err != nil {
+		r.Error(errors.New("Couldn't save notification preferences: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{
+		"NotifyByEmail":    user.NotifyByEmail,
+		"NotifyWebhookURL": user.NotifyWebhookURL,
+	})
+}
@@ -0,0 +1,47 @@
+/*
+
+Package notify contains the pluggable delivery backends the server uses to tell
+users that it has become their turn in a game: email over SMTP, and a JSON
+POST to a webhook URL of the user's choosing.
+
+A Backend decides for itself whether a given user has opted in to it (for
+example, the SMTP backend only sends to users who have NotifyByEmail set and
+have an email address on file), so the server can simply offer every
+notification to every configured backend.
+
+*/
+package notify
+
+import (
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//Notification describes an event a user should be told about.
+type Notification struct {
+	//UserID is the id of the user being notified.
+	UserID string
+	//GameName is the name of the game type, e.g. "tictactoe"
+	GameName string
+	//GameDisplayName is the human readable name of the game type.
+	GameDisplayName string
+	GameID          string
+	//PlayerIndex is the seat in the game that the user occupies.
+	PlayerIndex int
+	//URL is a link to the game, or "" if the server doesn't know where the
+	//web client is hosted.
+	URL string
+	//Message is a short human readable summary of the notification.
+	Message string
+}
+
+//Backend is a way of delivering notifications to users.
+type Backend interface {
+	//Name returns a short name for the backend, for use in logging.
+	Name() string
+	//ShouldNotify returns true if the given user has opted in to receiving
+	//notifications via this backend.
+	ShouldNotify(user *users.StorageRecord) bool
+	//Notify delivers the notification to the given user. It may block while
+	//doing network I/O, so callers should not call it on a critical path.
+	Notify(user *users.StorageRecord, notification *Notification) error
+}
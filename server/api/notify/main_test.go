@@ -0,0 +1,257 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/workfit/tester/assert"
+)
+
+//fakeSMTPServer is a minimal stand-in for an SMTP server that accepts a
+//single message and records what it was sent.
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	to       []string
+	data     string
+	done     chan bool
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Couldn't listen: " + err.Error())
+	}
+	result := &fakeSMTPServer{
+		listener: listener,
+		done:     make(chan bool),
+	}
+	go result.serve()
+	return result
+}
+
+func (f *fakeSMTPServer) Addr() string {
+	return f.listener.Addr().String()
+}
+
+func (f *fakeSMTPServer) serve() {
+	defer close(f.done)
+	conn, err := f.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	write := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	write("220 localhost fake SMTP")
+
+	inData := false
+	var data []string
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if inData {
+			if line == "." {
+				inData = false
+				f.data = strings.Join(data, "\n")
+				write("250 OK")
+				continue
+			}
+			data = append(data, line)
+			continue
+		}
+
+		command := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			write("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			f.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			write("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			f.to = append(f.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			write("250 OK")
+		case command == "DATA":
+			inData = true
+			write("354 Go ahead")
+		case command == "QUIT":
+			write("221 Bye")
+			return
+		default:
+			write("250 OK")
+		}
+	}
+}
+
+func TestSMTPBackend(t *testing.T) {
+
+	server := newFakeSMTPServer(t)
+	defer server.listener.Close()
+
+	backend := NewSMTPBackend(server.Addr(), "games@example.com", "", "")
+
+	user := &users.StorageRecord{
+		ID:    "Foo",
+		Email: "foo@example.com",
+	}
+
+	assert.For(t).ThatActual(backend.ShouldNotify(user)).IsFalse()
+
+	user.NotifyByEmail = true
+
+	assert.For(t).ThatActual(backend.ShouldNotify(user)).IsTrue()
+
+	err := backend.Notify(user, &Notification{
+		UserID:   user.ID,
+		GameName: "tictactoe",
+		GameID:   "ABC",
+		URL:      "http://localhost:8080/game/tictactoe/ABC",
+		Message:  "It's your turn in Tic Tac Toe",
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	<-server.done
+
+	assert.For(t).ThatActual(server.from).Equals("games@example.com")
+	assert.For(t).ThatActual(server.to).Equals([]string{"foo@example.com"})
+	assert.For(t).ThatActual(strings.Contains(server.data, "Subject: It's your turn in Tic Tac Toe")).IsTrue()
+	assert.For(t).ThatActual(strings.Contains(server.data, "http://localhost:8080/game/tictactoe/ABC")).IsTrue()
+
+}
+
+func TestWebhookBackend(t *testing.T) {
+
+	var received *Notification
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var notification Notification
+		if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = &notification
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}))
+	defer server.Close()
+
+	backend := NewWebhookBackend(nil).AllowHosts("127.0.0.1")
+
+	user := &users.StorageRecord{
+		ID: "Foo",
+	}
+
+	assert.For(t).ThatActual(backend.ShouldNotify(user)).IsFalse()
+
+	user.NotifyWebhookURL = server.URL + "/turn"
+
+	assert.For(t).ThatActual(backend.ShouldNotify(user)).IsTrue()
+
+	notification := &Notification{
+		UserID:      user.ID,
+		GameName:    "tictactoe",
+		GameID:      "ABC",
+		PlayerIndex: 1,
+		Message:     "It's your turn",
+	}
+
+	err := backend.Notify(user, notification)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(received).Equals(notification)
+
+	user.NotifyWebhookURL = server.URL + "/fail"
+
+	err = backend.Notify(user, notification)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
+}
+
+func TestWebhookBackendRefusesLocal(t *testing.T) {
+
+	called := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	backend := NewWebhookBackend(nil)
+
+	user := &users.StorageRecord{
+		ID:               "Foo",
+		NotifyWebhookURL: server.URL + "/turn",
+	}
+
+	err := backend.Notify(user, &Notification{UserID: user.ID})
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(called).IsFalse()
+
+	//A hostname that resolves to a loopback address is refused when dialing.
+	user.NotifyWebhookURL = strings.Replace(server.URL, "127.0.0.1", "localhost.", 1) + "/turn"
+
+	err = backend.Notify(user, &Notification{UserID: user.ID})
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(called).IsFalse()
+
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		description string
+		url         string
+		allowHosts  []string
+		valid       bool
+	}{
+		{"Public https", "https://example.com/hook", nil, true},
+		{"Public http with port", "http://example.com:8080/hook", nil, true},
+		{"Not http", "ftp://example.com/hook", nil, false},
+		{"No host", "https:///hook", nil, false},
+		{"Garbage", "::", nil, false},
+		{"Localhost", "http://localhost/hook", nil, false},
+		{"Localhost subdomain", "http://foo.localhost/hook", nil, false},
+		{"Loopback", "http://127.0.0.1/hook", nil, false},
+		{"IPv6 loopback", "http://[::1]/hook", nil, false},
+		{"Private", "http://10.1.2.3/hook", nil, false},
+		{"Private 192", "http://192.168.1.1/hook", nil, false},
+		{"Link local", "http://169.254.169.254/latest/meta-data", nil, false},
+		{"Unspecified", "http://0.0.0.0/hook", nil, false},
+		{"Allowed loopback", "http://127.0.0.1:8080/hook", []string{"127.0.0.1"}, true},
+		{"Allowed localhost", "http://LocalHost/hook", []string{"localhost"}, true},
+	}
+
+	for i, test := range tests {
+		err := ValidateWebhookURL(test.url, test.allowHosts...)
+		if test.valid {
+			assert.For(t, i, test.description).ThatActual(err).IsNil()
+		} else {
+			assert.For(t, i, test.description).ThatActual(err).IsNotNil()
+		}
+	}
+}
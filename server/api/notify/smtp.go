@@ -0,0 +1,81 @@
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/smtp"
+
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//SMTPBackend sends notifications as email via an SMTP server. Get one from
+//NewSMTPBackend.
+type SMTPBackend struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+//NewSMTPBackend returns a backend that sends mail through the SMTP server at
+//addr (in "host:port" form), from the given address. If username is not "",
+//PLAIN auth will be used. Note that net/smtp refuses to send PLAIN auth
+//credentials over an unencrypted connection to anything other than
+//localhost.
+func NewSMTPBackend(addr, from, username, password string) *SMTPBackend {
+	var auth smtp.Auth
+	if username != "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPBackend{
+		addr: addr,
+		from: from,
+		auth: auth,
+	}
+}
+
+//Name returns "smtp"
+func (s *SMTPBackend) Name() string {
+	return "smtp"
+}
+
+//ShouldNotify returns true if the user has opted in to email and has an
+//email address.
+func (s *SMTPBackend) ShouldNotify(user *users.StorageRecord) bool {
+	if user == nil {
+		return false
+	}
+	return user.NotifyByEmail && user.Email != ""
+}
+
+//Notify sends a short plain text email to the user.
+func (s *SMTPBackend) Notify(user *users.StorageRecord, notification *Notification) error {
+	if user == nil || user.Email == "" {
+		return errors.New("user has no email address")
+	}
+	if notification == nil {
+		return errors.New("no notification provided")
+	}
+
+	subject := notification.Message
+	if subject == "" {
+		subject = "It's your turn"
+	}
+
+	var body bytes.Buffer
+	body.WriteString("From: " + s.from + "\r\n")
+	body.WriteString("To: " + user.Email + "\r\n")
+	body.WriteString("Subject: " + subject + "\r\n")
+	body.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	body.WriteString("\r\n")
+	body.WriteString(notification.Message + "\r\n")
+	if notification.URL != "" {
+		body.WriteString("\r\n" + notification.URL + "\r\n")
+	}
+
+	return smtp.SendMail(s.addr, s.auth, s.from, []string{user.Email}, body.Bytes())
+}
@@ -0,0 +1,176 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+//WebhookBackend delivers notifications by POSTing them as JSON to a URL the
+//user configured. Get one from NewWebhookBackend. Since any user may pick the
+//URL, it refuses to POST to loopback, private or link-local addresses, unless
+//the host was allowed with AllowHosts.
+type WebhookBackend struct {
+	client     *http.Client
+	allowHosts map[string]bool
+}
+
+//NewWebhookBackend returns a new WebhookBackend. If client is nil, a client
+//with a reasonable timeout will be used. Only the default client checks the
+//address it actually connects to, which catches hostnames that resolve to a
+//refused address; if you pass your own, its Transport is responsible for that.
+func NewWebhookBackend(client *http.Client) *WebhookBackend {
+	result := &WebhookBackend{
+		allowHosts: make(map[string]bool),
+	}
+	if client == nil {
+		client = &http.Client{
+			Timeout: defaultWebhookTimeout,
+			Transport: &http.Transport{
+				//A proxy would hide the address we really connect to.
+				Proxy:       nil,
+				DialContext: result.dialContext,
+			},
+		}
+	}
+	result.client = client
+	return result
+}
+
+//AllowHosts allows webhooks to be POSTed to the given hosts (without ports)
+//even if they are loopback, private or link-local addresses. Returns itself
+//to allow chaining.
+func (w *WebhookBackend) AllowHosts(hosts ...string) *WebhookBackend {
+	for _, host := range hosts {
+		w.allowHosts[strings.ToLower(host)] = true
+	}
+	return w
+}
+
+//refusedIP returns true for addresses webhooks may not be sent to: ones that
+//reach the server itself or its private network.
+func refusedIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast()
+}
+
+//ValidateWebhookURL returns an error if rawURL isn't an http or https URL, or
+//if its host is obviously a refused address, like localhost or 10.0.0.1,
+//and isn't one of allowHosts. Hostnames that resolve to a refused address are
+//caught when the webhook is sent.
+func ValidateWebhookURL(rawURL string, allowHosts ...string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Hostname() == "" {
+		return errors.New("The webhook must be a valid http or https URL.")
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	for _, allowed := range allowHosts {
+		if strings.ToLower(allowed) == host {
+			return nil
+		}
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("The webhook may not be sent to localhost.")
+	}
+	if ip := net.ParseIP(host); ip != nil && refusedIP(ip) {
+		return errors.New("The webhook may not be sent to a private or local address.")
+	}
+	return nil
+}
+
+//dialContext dials addr, refusing refused addresses unless its host was
+//allowed with AllowHosts.
+func (w *WebhookBackend) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout: defaultWebhookTimeout,
+	}
+
+	if !w.allowHosts[strings.ToLower(host)] {
+		//Control sees the resolved address, so it catches hostnames that
+		//resolve to a refused address, too.
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			ipStr, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(ipStr)
+			if ip == nil || refusedIP(ip) {
+				return errors.New("refusing to send webhook to " + ipStr)
+			}
+			return nil
+		}
+	}
+
+	return dialer.DialContext(ctx, network, addr)
+}
+
+//Name returns "webhook"
+func (w *WebhookBackend) Name() string {
+	return "webhook"
+}
+
+//ShouldNotify returns true if the user has configured a NotifyWebhookURL.
+func (w *WebhookBackend) ShouldNotify(user *users.StorageRecord) bool {
+	if user == nil {
+		return false
+	}
+	return user.NotifyWebhookURL != ""
+}
+
+//Notify POSTs the JSON serialization of notification to the user's
+//NotifyWebhookURL. Any non-2xx response is treated as an error.
+func (w *WebhookBackend) Notify(user *users.StorageRecord, notification *Notification) error {
+	if user == nil || user.NotifyWebhookURL == "" {
+		return errors.New("user has no webhook configured")
+	}
+	if notification == nil {
+		return errors.New("no notification provided")
+	}
+
+	var allowHosts []string
+	for host := range w.allowHosts {
+		allowHosts = append(allowHosts, host)
+	}
+
+	if err := ValidateWebhookURL(user.NotifyWebhookURL, allowHosts...); err != nil {
+		return err
+	}
+
+	blob, err := json.Marshal(notification)
+
+	if err != nil {
+		return errors.New("couldn't marshal notification: " + err.Error())
+	}
+
+	resp, err := w.client.Post(user.NotifyWebhookURL, "application/json", bytes.NewReader(blob))
+
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.New("webhook returned status " + strconv.Itoa(resp.StatusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,496 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/openapi"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//apiVersion is the version of the REST API described by the OpenAPI
+//document. Bump it whenever an endpoint changes incompatibly.
+const apiVersion = "1.0.0"
+
+//apiPathPrefix is the prefix every route in the OpenAPI document is relative
+//to.
+const apiPathPrefix = "/api/"
+
+const (
+	formContentType = "application/x-www-form-urlencoded"
+	jsonContentType = "application/json"
+)
+
+var (
+	apiSpec     *openapi.Document
+	apiSpecOnce sync.Once
+)
+
+//openAPISpec returns the OpenAPI document describing every route
+//registerRoutes adds. It's only built once.
+func openAPISpec() *openapi.Document {
+	apiSpecOnce.Do(func() {
+		apiSpec = newAPISpec()
+	})
+	return apiSpec
+}
+
+func (s *Server) openAPIHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, openAPISpec())
+}
+
+//specBuilder has conveniences for describing routes in an OpenAPI document.
+type specBuilder struct {
+	doc *openapi.Document
+}
+
+//route adds an operation for the given method and path (relative to
+///api/), with a success payload that has the given properties.
+func (b *specBuilder) route(method, path, operationID, tag, summary string, params []*openapi.Parameter, body *openapi.RequestBody, success *openapi.Schema) *openapi.Operation {
+
+	if success == nil {
+		success = openapi.Object(nil)
+	}
+
+	op := &openapi.Operation{
+		OperationID: operationID,
+		Summary:     summary,
+		Tags:        []string{tag},
+		Parameters:  append(pathParams(path), params...),
+		RequestBody: body,
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Either a Success payload or a Failure. Errors are always reported with a 200 status code.",
+				Content: map[string]*openapi.MediaType{
+					jsonContentType: {
+						Schema: &openapi.Schema{
+							OneOf: []*openapi.Schema{
+								{
+									AllOf: []*openapi.Schema{
+										openapi.Ref("Success"),
+										success,
+									},
+								},
+								openapi.Ref("Failure"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	b.doc.AddOperation(method, path, op)
+
+	return op
+}
+
+//protected marks op as requiring a signed in user.
+func protected(op *openapi.Operation) {
+	op.Security = []map[string][]string{
+		{"cookieAuth": {}},
+	}
+}
+
+//pathParams returns the required path parameters in the gin style path.
+func pathParams(path string) []*openapi.Parameter {
+	var result []*openapi.Parameter
+	for _, part := range strings.Split(path, "/") {
+		if len(part) > 1 && part[0] == ':' {
+			result = append(result, &openapi.Parameter{
+				Name:     part[1:],
+				In:       "path",
+				Required: true,
+				Schema:   openapi.String(),
+			})
+		}
+	}
+	return result
+}
+
+func query(name, description string, schema *openapi.Schema) *openapi.Parameter {
+	return &openapi.Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Schema:      schema,
+	}
+}
+
+//anything returns a schema that allows any value.
+func anything() *openapi.Schema {
+	return &openapi.Schema{}
+}
+
+//flag returns a schema for the "1" or "0" values the server uses for
+//booleans in forms and query strings.
+func flag() *openapi.Schema {
+	return &openapi.Schema{
+		Type: "string",
+		Enum: []string{"0", "1"},
+	}
+}
+
+//form returns a form encoded request body with the given fields, none of
+//which are required.
+func form(fields map[string]*openapi.Schema) *openapi.RequestBody {
+	schema := openapi.Object(fields)
+	schema.Required = nil
+	return &openapi.RequestBody{
+		Content: map[string]*openapi.MediaType{
+			formContentType: {
+				Schema: schema,
+			},
+		},
+	}
+}
+
+//adminParam is the query parameter that asks for admin privileges, for users
+//who are allowed them.
+func adminParam() *openapi.Parameter {
+	return query(qryAdminKey, "1 to act as an admin, if the signed in user is allowed to.", flag())
+}
+
+//viewingParams are the query parameters of endpoints that render a game from
+//a player's point of view.
+func viewingParams() []*openapi.Parameter {
+	return []*openapi.Parameter{
+		adminParam(),
+		query(qryPlayerKey, "For admins, the player index to view the game as.", openapi.Integer()),
+		query(qryAutoCurrentPlayerKey, "For admins, 1 to view each state as its current player.", flag()),
+		query(qryFromVersion, "The version the client already has.", openapi.Integer()),
+	}
+}
+
+func newAPISpec() *openapi.Document {
+
+	doc := openapi.NewDocument("boardgame", "The REST API of a boardgame server. Every path is relative to /api/. Every response has a Status of either \"Success\" or \"Failure\"; failures also have an Error and a FriendlyError suitable to show to users. Websocket endpoints send the new version number of the game or tournament whenever it changes.", apiVersion)
+
+	doc.Components.Schemas["Success"] = openapi.Object(map[string]*openapi.Schema{
+		"Status": {Type: "string", Enum: []string{"Success"}},
+	})
+
+	doc.Components.Schemas["Failure"] = openapi.Object(map[string]*openapi.Schema{
+		"Status":        {Type: "string", Enum: []string{"Failure"}},
+		"Error":         openapi.String().Describe("The full error, for debugging."),
+		"FriendlyError": openapi.String().Describe("A version of the error that is appropriate to show to users."),
+	})
+
+	doc.Components.SecuritySchemes["cookieAuth"] = &openapi.SecurityScheme{
+		Type:        "apiKey",
+		In:          "cookie",
+		Name:        cookieName,
+		Description: "Set by the auth endpoint.",
+	}
+
+	b := &specBuilder{doc}
+
+	reason := openapi.String().Describe("Recorded in the audit log.")
+
+	gameListing := openapi.Array(doc.SchemaFor(&GameListing{}))
+
+	b.route("GET", "openapi.json", "getOpenAPI", "meta", "This document.", nil, nil, nil)
+
+	//Listings
+
+	b.route("GET", "list/game", "listGames", "games", "Lists games relevant to the signed in user.",
+		[]*openapi.Parameter{
+			query(qryGameNameKey, "Only list games of this type.", openapi.String()),
+			adminParam(),
+		}, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"ParticipatingActiveGames":   gameListing,
+			"ParticipatingFinishedGames": gameListing,
+			"VisibleJoinableActiveGames": gameListing,
+			"VisibleActiveGames":         gameListing,
+			"AllGames":                   openapi.Array(doc.SchemaFor(&extendedgame.CombinedStorageRecord{})).Describe("Only for admins."),
+		}).Optional("AllGames"))
+
+	b.route("GET", "list/manager", "listManagers", "games", "Lists the types of games the server can create.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Managers": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"Name":              openapi.String(),
+				"DisplayName":       openapi.String(),
+				"Description":       openapi.String(),
+				"DefaultNumPlayers": openapi.Integer(),
+				"MinNumPlayers":     openapi.Integer(),
+				"MaxNumPlayers":     openapi.Integer(),
+				"Agents": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+					"Name":        openapi.String(),
+					"DisplayName": openapi.String(),
+				})),
+				"Variant": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+					"Name":        openapi.String(),
+					"DisplayName": openapi.String(),
+					"Description": openapi.String(),
+					"Values": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+						"Value":       openapi.String(),
+						"DisplayName": openapi.String(),
+						"Description": openapi.String(),
+					})),
+				})),
+			})),
+		}))
+
+	b.route("GET", "leaderboard/:name", "leaderboard", "ratings", "Lists the highest rated players of a game type.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"GameName": openapi.String(),
+			"Ratings":  openapi.Array(doc.SchemaFor(&ratingInfo{})),
+		}))
+
+	b.route("GET", "user/:userid/ratings", "userRatings", "ratings", "Lists a user's ratings and rating history.",
+		[]*openapi.Parameter{
+			query(qryGameNameKey, "Only include this game type.", openapi.String()),
+		}, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"UserID":      openapi.String(),
+			"DisplayName": openapi.String(),
+			"PhotoURL":    openapi.String(),
+			"Ratings":     openapi.Array(doc.SchemaFor(&ratings.StorageRecord{})),
+			"History":     openapi.Array(doc.SchemaFor(&ratings.HistoryRecord{})),
+		}))
+
+	b.route("GET", "list/tournament", "listTournaments", "tournaments", "Lists tournaments, most recent first.",
+		[]*openapi.Parameter{
+			query(qryGameNameKey, "Only list tournaments of this game type.", openapi.String()),
+		}, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Tournaments": openapi.Array(doc.SchemaFor(&tournaments.StorageRecord{})),
+		}))
+
+	//Users
+
+	b.route("POST", "auth", "auth", "users", "Signs in (setting the auth cookie) or, with no uid, signs out.", nil,
+		form(map[string]*openapi.Schema{
+			"uid":         openapi.String().Describe("The signed in user's ID. Omit to sign out."),
+			"token":       openapi.String().Describe("A Firebase ID token for uid."),
+			"email":       openapi.String(),
+			"photo":       openapi.String(),
+			"displayname": openapi.String(),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"User":         doc.SchemaFor(&users.StorageRecord{}),
+			"AdminAllowed": openapi.Boolean(),
+			"Message":      openapi.String(),
+		}).Optional("User", "AdminAllowed"))
+
+	protected(b.route("POST", "settings/notifications", "notificationPreferences", "users", "Sets how the signed in user is told it's their turn. Preferences that aren't sent are left as they were.", nil,
+		form(map[string]*openapi.Schema{
+			qryNotifyByEmail: flag(),
+			qryNotifyWebhook: openapi.String(),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"NotifyByEmail":    openapi.Boolean(),
+			"NotifyWebhookURL": openapi.String(),
+		})))
+
+	protected(b.route("GET", "settings/export", "exportUserData", "users", "Returns everything stored about the signed in user.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Export": doc.SchemaFor(&userDataExport{}),
+		})))
+
+	protected(b.route("POST", "settings/delete", "deleteUser", "users", "Deletes the signed in user's account and signs them out. Games and tournaments they played in are kept, but no longer identify them.", nil,
+		form(map[string]*openapi.Schema{
+			qryConfirm: flag(),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"Message": openapi.String(),
+		})))
+
+	//Creating things
+
+	protected(b.route("POST", "new/game", "newGame", "games", "Creates a game owned by the signed in user.", nil,
+		form(map[string]*openapi.Schema{
+			qryManagerKey:     openapi.String().Describe("The game type."),
+			qryNumPlayersKey:  openapi.Integer().Describe("Defaults to the game type's default."),
+			qryAgentKey + "0": openapi.String().Describe("The agent to play as player 0, if any. Likewise for agent-player-1 and so on."),
+			"variant_{name}":  openapi.String().Describe("The value for the variant key named name, if not the default."),
+			qryOpen:           flag(),
+			qryVisible:        flag(),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"GameID":   openapi.String(),
+			"GameName": openapi.String(),
+		})))
+
+	protected(b.route("POST", "new/tournament", "newTournament", "tournaments", "Creates a tournament owned by the signed in user.", nil,
+		form(map[string]*openapi.Schema{
+			qryManagerKey:     openapi.String().Describe("The game type."),
+			qryTournamentName: openapi.String(),
+			qryFormat: {
+				Type: "string",
+				Enum: []string{string(tournaments.Swiss), string(tournaments.RoundRobin), string(tournaments.SingleElimination)},
+			},
+			qryRounds: openapi.Integer().Describe("For swiss tournaments, the number of rounds."),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"TournamentID": openapi.String(),
+		})))
+
+	//Tournaments
+
+	b.route("GET", "tournament/:tournament/info", "tournamentInfo", "tournaments", "Returns a tournament and its standings.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Tournament": doc.SchemaFor(&tournaments.StorageRecord{}),
+			"Standings":  openapi.Array(doc.SchemaFor(&standingInfo{})),
+		}))
+
+	b.route("GET", "tournament/:tournament/socket", "tournamentSocket", "tournaments", "Upgrades to a websocket that is sent the tournament's version whenever it changes.", nil, nil, nil)
+
+	protected(b.route("POST", "tournament/:tournament/register", "registerTournament", "tournaments", "Registers the signed in user for a tournament that hasn't started.", nil, nil, nil))
+
+	protected(b.route("POST", "tournament/:tournament/start", "startTournament", "tournaments", "Starts a tournament and creates the first round's games. If it has already started, creates any of the current round's games that are missing. Only for its owner or admins.",
+		[]*openapi.Parameter{adminParam()}, nil, nil))
+
+	//Admin console
+
+	protected(b.route("GET", "admin/users", "adminListUsers", "admin", "Searches users.",
+		[]*openapi.Parameter{
+			query(qrySearch, "Matches against ID, display name and email.", openapi.String()),
+		}, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Users": openapi.Array(doc.SchemaFor(&users.StorageRecord{})),
+		})))
+
+	protected(b.route("POST", "admin/user/:userid/ban", "adminBanUser", "admin", "Bans or unbans a user.", nil,
+		form(map[string]*openapi.Schema{
+			qryBanned: flag(),
+			qryReason: reason,
+		}), nil))
+
+	protected(b.route("GET", "admin/audit", "adminAudit", "admin", "Lists the most recent admin actions.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Records": openapi.Array(doc.SchemaFor(&audit.StorageRecord{})),
+		})))
+
+	protected(b.route("GET", "admin/game/:name/:id/history", "adminGameHistory", "admin", "Returns every state and move of a game, unsanitized.", nil, nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Game":    doc.SchemaFor(&boardgame.GameStorageRecord{}),
+			"States":  openapi.Array(anything()),
+			"Moves":   openapi.Array(doc.SchemaFor(&boardgame.MoveStorageRecord{})),
+			"UserIDs": openapi.Array(openapi.String()),
+		})))
+
+	protected(b.route("POST", "admin/game/:name/:id/delete", "adminDeleteGame", "admin", "Deletes a game and its history. Games in a tournament can't be deleted.", nil,
+		form(map[string]*openapi.Schema{
+			qryReason: reason,
+		}), nil))
+
+	protected(b.route("POST", "admin/game/:name/:id/finish", "adminFinishGame", "admin", "Ends a game with the given winners.", nil,
+		form(map[string]*openapi.Schema{
+			qryWinners: openapi.String().Describe("Comma separated player indexes. Empty for a draw."),
+			qryReason:  reason,
+		}), nil))
+
+	protected(b.route("POST", "admin/game/:name/:id/seat", "adminSetSeat", "admin", "Puts a user in a seat, or empties it.", nil,
+		form(map[string]*openapi.Schema{
+			qrySeat:      openapi.Integer(),
+			qryUserIDKey: openapi.String().Describe("Omit to empty the seat."),
+			qryReason:    reason,
+		}), nil))
+
+	protected(b.route("POST", "admin/game/:name/:id/configure", "adminConfigureGame", "admin", "Changes whichever of a game's settings are provided.", nil,
+		form(map[string]*openapi.Schema{
+			qryOpen:    flag(),
+			qryVisible: flag(),
+			qryOwner:   openapi.String(),
+			qryReason:  reason,
+		}), nil))
+
+	//Games
+
+	b.route("GET", "game/:name/:id/socket", "gameSocket", "games", "Upgrades to a websocket that is sent the game's version whenever it changes.", nil, nil, nil)
+
+	b.route("GET", "game/:name/:id/info", "gameInfo", "games", "Returns everything a client needs to render a game.", viewingParams(), nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Chest":           anything().Describe("The game's component chest."),
+			"Forms":           openapi.Array(doc.SchemaFor(&MoveForm{})),
+			"Game":            anything().Describe("The game, sanitized for ViewingAsPlayer."),
+			"Error":           openapi.String(),
+			"Players":         openapi.Array(doc.SchemaFor(&PlayerInfo{})),
+			"ViewingAsPlayer": openapi.Integer(),
+			"HasEmptySlots":   openapi.Boolean(),
+			"GameOpen":        openapi.Boolean(),
+			"GameVisible":     openapi.Boolean(),
+			"GameHasPassword": openapi.Boolean(),
+			"IsOwner":         openapi.Boolean(),
+			"StateVersion":    openapi.Integer(),
+			"Invites":         openapi.Array(doc.SchemaFor(&extendedgame.Invite{})).Describe("Only for the owner."),
+		}).Optional("Invites"))
+
+	b.route("GET", "game/:name/:id/version/:version", "gameVersion", "games", "Returns a bundle for each move between from and version.", viewingParams(), nil,
+		openapi.Object(map[string]*openapi.Schema{
+			"Bundles": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"Game":            anything(),
+				"Move":            doc.SchemaFor(&boardgame.MoveStorageRecord{}),
+				"ViewingAsPlayer": openapi.Integer(),
+				"Forms":           openapi.Array(doc.SchemaFor(&MoveForm{})),
+			})),
+		}))
+
+	protected(b.route("POST", "game/:name/:id/move", "proposeMove", "games", "Proposes a move as the signed in user's player.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			"MoveType":   openapi.String().Describe("The name of the move."),
+			"{field}":    openapi.String().Describe("A value for each of the move's fields, as listed in its form. Bools are 1 or 0, and int slices are comma separated."),
+			qryPlayerKey: openapi.Integer().Describe("For admins, the player to propose the move as."),
+		}), nil))
+
+	protected(b.route("POST", "game/:name/:id/join", "joinGame", "games", "Seats the signed in user in an empty seat.", nil,
+		form(map[string]*openapi.Schema{
+			qryInvite:   openapi.String(),
+			qryPassword: openapi.String(),
+		}), nil))
+
+	protected(b.route("POST", "game/:name/:id/configure", "configureGame", "games", "Sets whether a game is open and visible. Only for its owner or admins.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			qryOpen:    flag(),
+			qryVisible: flag(),
+		}), nil))
+
+	protected(b.route("POST", "game/:name/:id/password", "gamePassword", "games", "Sets or, if empty, clears a game's join password.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			qryPassword: openapi.String(),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"GameHasPassword": openapi.Boolean(),
+		})))
+
+	protected(b.route("POST", "game/:name/:id/invite", "createInvite", "games", "Creates an invite link to a game.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			qrySeat: openapi.Integer().Describe("The seat the invite is for. Omit for any seat."),
+		}),
+		openapi.Object(map[string]*openapi.Schema{
+			"Invite": doc.SchemaFor(&extendedgame.Invite{}),
+		})))
+
+	protected(b.route("POST", "game/:name/:id/invite/revoke", "revokeInvite", "games", "Revokes an invite link.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			qryInvite: openapi.String(),
+		}), nil))
+
+	protected(b.route("POST", "game/:name/:id/leave", "leaveGame", "games", "Removes the signed in user from a game.", nil,
+		form(map[string]*openapi.Schema{
+			qryAgentName: openapi.String().Describe("An agent to take over the seat, if any."),
+		}), nil))
+
+	protected(b.route("POST", "game/:name/:id/kick", "kickPlayer", "games", "Removes a player from a game. Only for its owner or admins.",
+		[]*openapi.Parameter{adminParam()},
+		form(map[string]*openapi.Schema{
+			qrySeat:      openapi.Integer(),
+			qryAgentName: openapi.String().Describe("An agent to take over the seat, if any."),
+		}), nil))
+
+	return doc
+}
@@ -0,0 +1,432 @@
+/*
+
+Package openapi is a minimal model of an OpenAPI 3 document, just enough of
+the specification for the api package to describe its REST endpoints. Schemas
+for response payloads are generated by reflecting over the Go types that are
+actually serialized, so they stay in sync with the storage records as fields
+are added.
+
+*/
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+//Version is the version of the OpenAPI specification documents conform to.
+const Version = "3.0.3"
+
+//Document is the root of an OpenAPI document.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       *Info                `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components"`
+}
+
+//Info describes the API as a whole.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+//PathItem is the operations available on a single path.
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+//Operation is a single endpoint.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+//Parameter is a path, query or cookie parameter of an Operation.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+//RequestBody is the body of an Operation.
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+//Response is a possible response of an Operation.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+//MediaType is the schema of a body in a given content type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+//SecurityScheme describes how requests are authenticated.
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+//Components holds the schemas and security schemes referenced elsewhere in
+//the Document.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+//Schema describes the shape of a value. An empty Schema allows any value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+//NewDocument returns an empty Document with the given title and version.
+func NewDocument(title, description, version string) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info: &Info{
+			Title:       title,
+			Description: description,
+			Version:     version,
+		},
+		Paths: make(map[string]*PathItem),
+		Components: &Components{
+			Schemas:         make(map[string]*Schema),
+			SecuritySchemes: make(map[string]*SecurityScheme),
+		},
+	}
+}
+
+//Path converts a gin style path, like "game/:name/:id", to an OpenAPI one,
+//like "game/{name}/{id}".
+func Path(ginPath string) string {
+	parts := strings.Split(ginPath, "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, ":") || strings.HasPrefix(part, "*") {
+			parts[i] = "{" + part[1:] + "}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+//AddOperation adds op to the document at the given method ("GET" or "POST")
+//and gin style path.
+func (d *Document) AddOperation(method, ginPath string, op *Operation) {
+	path := Path(ginPath)
+
+	item := d.Paths[path]
+
+	if item == nil {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+
+	switch method {
+	case "GET":
+		item.Get = op
+	case "POST":
+		item.Post = op
+	default:
+		panic("Unsupported method " + method)
+	}
+}
+
+//Operation returns the operation at the given method and gin style path, or
+//nil if there isn't one.
+func (d *Document) Operation(method, ginPath string) *Operation {
+	item := d.Paths[Path(ginPath)]
+
+	if item == nil {
+		return nil
+	}
+
+	switch method {
+	case "GET":
+		return item.Get
+	case "POST":
+		return item.Post
+	}
+
+	return nil
+}
+
+//Ref returns a schema that refers to the component schema with the given
+//name.
+func Ref(name string) *Schema {
+	return &Schema{
+		Ref: "#/components/schemas/" + name,
+	}
+}
+
+//String returns a schema for a string.
+func String() *Schema {
+	return &Schema{Type: "string"}
+}
+
+//Integer returns a schema for an integer.
+func Integer() *Schema {
+	return &Schema{Type: "integer"}
+}
+
+//Boolean returns a schema for a boolean.
+func Boolean() *Schema {
+	return &Schema{Type: "boolean"}
+}
+
+//Array returns a schema for an array of items.
+func Array(items *Schema) *Schema {
+	return &Schema{
+		Type:  "array",
+		Items: items,
+	}
+}
+
+//Object returns a schema for an object with the given properties, all of
+//which are required.
+func Object(properties map[string]*Schema) *Schema {
+	result := &Schema{
+		Type:       "object",
+		Properties: properties,
+	}
+	for name := range properties {
+		result.Required = append(result.Required, name)
+	}
+	sort.Strings(result.Required)
+	return result
+}
+
+//Optional removes the given properties from the ones s requires and returns
+//s, for chaining.
+func (s *Schema) Optional(names ...string) *Schema {
+	var required []string
+	for _, name := range s.Required {
+		keep := true
+		for _, optional := range names {
+			if name == optional {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			required = append(required, name)
+		}
+	}
+	s.Required = required
+	return s
+}
+
+//Describe sets the description of s and returns it, for chaining.
+func (s *Schema) Describe(description string) *Schema {
+	s.Description = description
+	return s
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	marshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+//SchemaFor returns a schema for values shaped like example, as
+//encoding/json would serialize them. Named struct types are added to the
+//document's components (named like "users.StorageRecord") and referred to.
+//Types with their own MarshalJSON can serialize to anything, so their
+//schemas allow any value.
+func (d *Document) SchemaFor(example interface{}) *Schema {
+	return d.schemaForType(reflect.TypeOf(example))
+}
+
+func (d *Document) schemaForType(t reflect.Type) *Schema {
+
+	if t == nil {
+		return &Schema{}
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return d.schemaForType(t.Elem())
+	}
+
+	if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Boolean()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result := Integer()
+		if t.Kind() == reflect.Int64 || t.Kind() == reflect.Uint64 {
+			result.Format = "int64"
+		}
+		return result
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return String()
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			//encoding/json base64 encodes []byte.
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return Array(d.schemaForType(t.Elem()))
+	case reflect.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: d.schemaForType(t.Elem()),
+		}
+	case reflect.Struct:
+		return d.schemaForStruct(t)
+	}
+
+	//Interfaces, and anything else we don't know about.
+	return &Schema{}
+}
+
+//componentName returns the name a named struct type is stored under in
+//Components, like "users.StorageRecord".
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if index := strings.LastIndex(pkg, "/"); index >= 0 {
+		pkg = pkg[index+1:]
+	}
+	if pkg == "" {
+		return t.Name()
+	}
+	return pkg + "." + t.Name()
+}
+
+func (d *Document) schemaForStruct(t reflect.Type) *Schema {
+
+	name := ""
+
+	if t.Name() != "" {
+		name = componentName(t)
+		if _, ok := d.Components.Schemas[name]; ok {
+			return Ref(name)
+		}
+		//Reserve the name before recursing, in case the type refers to
+		//itself.
+		d.Components.Schemas[name] = &Schema{}
+	}
+
+	result := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+
+	d.addStructFields(result, t)
+
+	sort.Strings(result.Required)
+
+	if name == "" {
+		return result
+	}
+
+	d.Components.Schemas[name] = result
+
+	return Ref(name)
+}
+
+//addStructFields adds the properties of struct type t to schema, flattening
+//embedded structs the way encoding/json does.
+func (d *Document) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+
+		if tag == "-" {
+			continue
+		}
+
+		tagName := tag
+		omitEmpty := false
+
+		if index := strings.Index(tag, ","); index >= 0 {
+			tagName = tag[:index]
+			omitEmpty = strings.Contains(tag[index:], "omitempty")
+		}
+
+		fieldType := field.Type
+
+		if field.Anonymous && tagName == "" {
+			embedded := fieldType
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct && !embedded.Implements(marshalerType) {
+				d.addStructFields(schema, embedded)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			//Unexported
+			continue
+		}
+
+		name := field.Name
+
+		if tagName != "" {
+			name = tagName
+		}
+
+		propSchema := d.schemaForType(fieldType)
+
+		if fieldType.Kind() == reflect.Ptr || fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map {
+			if propSchema.Ref == "" {
+				propSchema.Nullable = true
+			} else {
+				//Siblings of $ref are ignored, so wrap it.
+				propSchema = &Schema{
+					AllOf:    []*Schema{propSchema},
+					Nullable: true,
+				}
+			}
+		}
+
+		schema.Properties[name] = propSchema
+
+		if !omitEmpty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/workfit/tester/assert"
+)
+
+type testEmbedded struct {
+	Embedded string
+}
+
+type testRecord struct {
+	*testEmbedded
+	Name     string
+	Count    int64
+	Optional string `json:",omitempty"`
+	Renamed  bool   `json:"renamed"`
+	Skipped  string `json:"-"`
+	When     time.Time
+	Blob     json.RawMessage
+	Children []*testRecord
+	Tags     map[string]string
+	private  int
+}
+
+func TestPath(t *testing.T) {
+	assert.For(t).ThatActual(Path("game/:name/:id/version/:version")).Equals("game/{name}/{id}/version/{version}")
+	assert.For(t).ThatActual(Path("list/game")).Equals("list/game")
+}
+
+func TestSchemaFor(t *testing.T) {
+
+	doc := NewDocument("Test", "", "1")
+
+	schema := doc.SchemaFor(&testRecord{})
+
+	assert.For(t).ThatActual(schema.Ref).Equals("#/components/schemas/openapi.testRecord")
+
+	record := doc.Components.Schemas["openapi.testRecord"]
+
+	assert.For(t).ThatActual(record).IsNotNil()
+
+	var names []string
+	for name := range record.Properties {
+		names = append(names, name)
+	}
+
+	assert.For(t).ThatActual(len(names)).Equals(9)
+	assert.For(t).ThatActual(record.Properties["Embedded"].Type).Equals("string")
+	assert.For(t).ThatActual(record.Properties["Count"].Format).Equals("int64")
+	assert.For(t).ThatActual(record.Properties["renamed"].Type).Equals("boolean")
+	assert.For(t).ThatActual(record.Properties["When"].Format).Equals("date-time")
+	assert.For(t).ThatActual(record.Properties["Blob"].Type).Equals("")
+	assert.For(t).ThatActual(record.Properties["Children"].Items.Ref).Equals("#/components/schemas/openapi.testRecord")
+	assert.For(t).ThatActual(record.Properties["Tags"].AdditionalProperties.Type).Equals("string")
+
+	assert.For(t).ThatActual(record.Required).Equals([]string{"Blob", "Children", "Count", "Embedded", "Name", "Tags", "When", "renamed"})
+
+	assert.For(t).ThatActual(Object(map[string]*Schema{"A": String(), "B": String()}).Optional("A").Required).Equals([]string{"B"})
+}
+
+func TestAddOperation(t *testing.T) {
+	doc := NewDocument("Test", "", "1")
+
+	op := &Operation{OperationID: "info"}
+
+	doc.AddOperation("GET", "game/:name/:id/info", op)
+
+	assert.For(t).ThatActual(doc.Operation("GET", "game/:name/:id/info")).Equals(op)
+	assert.For(t).ThatActual(doc.Operation("POST", "game/:name/:id/info") == nil).IsTrue()
+}
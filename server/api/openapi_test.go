@@ -0,0 +1,60 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/workfit/tester/assert"
+)
+
+func TestOpenAPISpecCoversRoutes(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+
+	s := &Server{}
+
+	s.registerRoutes(router)
+
+	spec := openAPISpec()
+
+	numOperations := 0
+
+	for _, item := range spec.Paths {
+		if item.Get != nil {
+			numOperations++
+		}
+		if item.Post != nil {
+			numOperations++
+		}
+	}
+
+	routes := router.Routes()
+
+	for _, route := range routes {
+		path := strings.TrimPrefix(route.Path, apiPathPrefix)
+		assert.For(t, route.Method+" "+route.Path).ThatActual(spec.Operation(route.Method, path) != nil).IsTrue()
+	}
+
+	assert.For(t).ThatActual(numOperations).Equals(len(routes))
+}
+
+func TestOpenAPISpecRefsResolve(t *testing.T) {
+
+	spec := openAPISpec()
+
+	blob, err := json.Marshal(spec)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	for _, part := range strings.Split(string(blob), `"$ref":"#/components/schemas/`)[1:] {
+		name := part[:strings.Index(part, `"`)]
+		_, ok := spec.Components.Schemas[name]
+		assert.For(t, name).ThatActual(ok).IsTrue()
+	}
+
+	assert.For(t).ThatActual(spec.Components.Schemas["users.StorageRecord"].Properties["Banned"]).IsNotNil()
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/ratelimit"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//configureRateLimits creates the limiters described in config. Called by
+//Start() once config is loaded. Limits that aren't configured are nil
+//limiters, which allow everything.
+func (s *Server) configureRateLimits() {
+	rateLimitConfig := s.config.RateLimit
+
+	if rateLimitConfig == nil {
+		return
+	}
+
+	s.ipLimiter = ratelimit.NewLimiter(rateLimitConfig.RequestsPerMinute, time.Minute)
+	s.userLimiter = ratelimit.NewLimiter(rateLimitConfig.UserRequestsPerMinute, time.Minute)
+	s.newGameLimiter = ratelimit.NewLimiter(rateLimitConfig.GamesPerHour, time.Hour)
+}
+
+//maxSocketsPerUser returns the configured limit on concurrent sockets per
+//user, or 0 if there is no limit.
+func (s *Server) maxSocketsPerUser() int {
+	if s.config == nil || s.config.RateLimit == nil {
+		return 0
+	}
+	return s.config.RateLimit.MaxSocketsPerUser
+}
+
+//behindProxy returns whether the server is configured to trust the client IP
+//headers set by a reverse proxy.
+func (s *Server) behindProxy() bool {
+	if s.config == nil || s.config.RateLimit == nil {
+		return false
+	}
+	return s.config.RateLimit.BehindProxy
+}
+
+//rateLimit is middleware that rejects requests from IPs or users who have
+//made too many requests recently. It must run after userSetup.
+func (s *Server) rateLimit(c *gin.Context) {
+
+	if err := s.calcRateLimit(c.ClientIP(), s.getUser(c)); err != nil {
+		s.newRenderer(c).Error(err)
+		c.Abort()
+		return
+	}
+
+	//All good!
+}
+
+//calcRateLimit returns an error if the client at ip, or user (who may be
+//nil), has made too many requests recently. Otherwise it counts the request
+//against their limits.
+func (s *Server) calcRateLimit(ip string, user *users.StorageRecord) *errors.Friendly {
+
+	if !s.ipLimiter.Allow(ip) {
+		return errors.NewFriendly("You're making requests too quickly. Please wait a moment and try again.").WithError("IP " + ip + " exceeded its rate limit")
+	}
+
+	if user == nil {
+		return nil
+	}
+
+	if !s.userLimiter.Allow(user.ID) {
+		return errors.NewFriendly("You're making requests too quickly. Please wait a moment and try again.").WithError("User " + user.ID + " exceeded their rate limit")
+	}
+
+	return nil
+}
+
+//calcSocketAllowed returns a non-nil error if the given user already has as
+//many sockets open as they're allowed.
+func (s *Server) calcSocketAllowed(userID string) *errors.Friendly {
+	max := s.maxSocketsPerUser()
+
+	if max <= 0 || userID == "" {
+		return nil
+	}
+
+	if count := s.notifier.userSocketCount(userID); count >= max {
+		return errors.NewFriendly("You have too many games open at once. Close some and try again.").WithError("User " + userID + " already has " + strconv.Itoa(count) + " sockets open")
+	}
+
+	return nil
+}
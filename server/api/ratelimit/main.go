@@ -0,0 +1,109 @@
+/*
+
+Package ratelimit provides a simple keyed token bucket rate limiter, used by
+the api package to throttle requests per IP and per user.
+
+*/
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+//sweepThreshold is how many keys a Limiter tracks before it will go through
+//and forget about keys whose buckets have completely refilled.
+const sweepThreshold = 10000
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+//Limiter allows up to a configured number of events per key within a given
+//period. It's a token bucket: each key starts with limit tokens, each event
+//consumes one, and tokens refill continuously at limit per period. A nil
+//*Limiter allows everything, which makes it convenient to represent a limit
+//that isn't configured. Safe for concurrent use.
+type Limiter struct {
+	limit   float64
+	period  time.Duration
+	buckets map[string]*bucket
+	lock    sync.Mutex
+	//now is overridable in tests.
+	now func() time.Time
+}
+
+//NewLimiter returns a Limiter that allows limit events per key every period.
+//If limit or period is not positive, returns nil, which allows everything.
+func NewLimiter(limit int, period time.Duration) *Limiter {
+	if limit <= 0 || period <= 0 {
+		return nil
+	}
+	return &Limiter{
+		limit:   float64(limit),
+		period:  period,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+//Allow returns true if an event for key is allowed right now, consuming one
+//token if so.
+func (l *Limiter) Allow(key string) bool {
+	if l == nil {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+
+	b, ok := l.buckets[key]
+
+	if !ok {
+		if len(l.buckets) >= sweepThreshold {
+			l.sweep(now)
+		}
+		b = &bucket{
+			tokens:     l.limit,
+			lastRefill: now,
+		}
+		l.buckets[key] = b
+	}
+
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+//refill adds the tokens that have accrued to b since it was last refilled.
+//Should only be called with the lock held.
+func (l *Limiter) refill(b *bucket, now time.Time) {
+	elapsed := now.Sub(b.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += l.limit * float64(elapsed) / float64(l.period)
+	if b.tokens > l.limit {
+		b.tokens = l.limit
+	}
+	b.lastRefill = now
+}
+
+//sweep forgets keys whose buckets are full, since they're indistinguishable
+//from keys we've never seen. Should only be called with the lock held.
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.limit {
+			delete(l.buckets, key)
+		}
+	}
+}
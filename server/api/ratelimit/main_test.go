@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/workfit/tester/assert"
+)
+
+func TestNilLimiter(t *testing.T) {
+	l := NewLimiter(0, time.Minute)
+	assert.For(t).ThatActual(l == nil).IsTrue()
+	for i := 0; i < 100; i++ {
+		assert.For(t).ThatActual(l.Allow("foo")).IsTrue()
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	current := time.Unix(1000, 0)
+
+	l := NewLimiter(3, time.Minute)
+	l.now = func() time.Time {
+		return current
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.For(t, i).ThatActual(l.Allow("foo")).IsTrue()
+	}
+
+	assert.For(t).ThatActual(l.Allow("foo")).IsFalse()
+
+	//Other keys have their own bucket.
+	assert.For(t).ThatActual(l.Allow("bar")).IsTrue()
+
+	//A third of the period refills one token.
+	current = current.Add(20 * time.Second)
+
+	assert.For(t).ThatActual(l.Allow("foo")).IsTrue()
+	assert.For(t).ThatActual(l.Allow("foo")).IsFalse()
+
+	//Waiting a long time never refills more than limit.
+	current = current.Add(time.Hour)
+
+	for i := 0; i < 3; i++ {
+		assert.For(t, i).ThatActual(l.Allow("foo")).IsTrue()
+	}
+
+	assert.For(t).ThatActual(l.Allow("foo")).IsFalse()
+}
+
+func TestSweep(t *testing.T) {
+	current := time.Unix(1000, 0)
+
+	l := NewLimiter(1, time.Minute)
+	l.now = func() time.Time {
+		return current
+	}
+
+	l.Allow("foo")
+	l.Allow("bar")
+
+	current = current.Add(time.Minute)
+
+	l.sweep(current)
+
+	assert.For(t).ThatActual(len(l.buckets)).Equals(0)
+}
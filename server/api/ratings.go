@@ -0,0 +1,188 @@
+package api
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+const maxRatingsToList = 100
+
+type ratingInfo struct {
+	*ratings.StorageRecord
+	DisplayName string
+	PhotoURL    string
+}
+
+//updateRatings is called when a game becomes finished. It updates the ratings
+//of every seat that is occupied by a real user, based on who won. Seats that
+//are empty or controlled by an agent are skipped; if fewer than two users are
+//left there's nothing to rate.
+func (s *Server) updateRatings(game *boardgame.GameStorageRecord) error {
+
+	userIDs := s.storage.UserIDsForGame(game.ID)
+
+	if userIDs == nil {
+		return errors.New("couldn't fetch users for game")
+	}
+
+	winners := make(map[boardgame.PlayerIndex]bool, len(game.Winners))
+	for _, winner := range game.Winners {
+		winners[winner] = true
+	}
+
+	var records []*ratings.StorageRecord
+	var oldRatings []float64
+	var won []bool
+
+	for i, userID := range userIDs {
+		if userID == "" {
+			continue
+		}
+		if i < len(game.Agents) && game.Agents[i] != "" {
+			continue
+		}
+		record, err := s.storage.Rating(userID, game.Name)
+		if err != nil {
+			return errors.New("couldn't fetch rating for " + userID + ": " + err.Error())
+		}
+		records = append(records, record)
+		oldRatings = append(oldRatings, record.Rating)
+		won = append(won, winners[boardgame.PlayerIndex(i)])
+	}
+
+	if len(records) < 2 {
+		return nil
+	}
+
+	newRatings := ratings.Calculate(oldRatings, won, ratings.DefaultKFactor)
+
+	timestamp := time.Now().UnixNano()
+
+	for i, record := range records {
+		record.Rating = newRatings[i]
+		record.NumGames++
+		if won[i] {
+			record.NumWins++
+		}
+		record.LastUpdated = timestamp
+
+		history := &ratings.HistoryRecord{
+			UserID:    record.UserID,
+			GameName:  game.Name,
+			GameID:    game.ID,
+			Rating:    newRatings[i],
+			Delta:     newRatings[i] - oldRatings[i],
+			Won:       won[i],
+			Timestamp: timestamp,
+		}
+
+		if err := s.storage.UpdateRating(record, history); err != nil {
+			return errors.New("couldn't save rating for " + record.UserID + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+//ratingInfos decorates the given ratings with display information about the
+//user they belong to.
+func (s *Server) ratingInfos(records []*ratings.StorageRecord) []*ratingInfo {
+	result := make([]*ratingInfo, len(records))
+	for i, record := range records {
+		info := &ratingInfo{
+			StorageRecord: record,
+		}
+		if user := s.storage.GetUserByID(record.UserID); user != nil {
+			info.DisplayName = user.EffectiveDisplayName()
+			info.PhotoURL = user.PhotoURL
+		}
+		result[i] = info
+	}
+	return result
+}
+
+func (s *Server) leaderboardHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	gameName := s.getRequestGameName(c)
+
+	s.doLeaderboard(r, gameName)
+}
+
+func (s *Server) doLeaderboard(r *renderer, gameName string) {
+
+	if s.managers[gameName] == nil {
+		r.Error(errors.NewFriendly("That is not a legal type of game"))
+		return
+	}
+
+	r.Success(gin.H{
+		"GameName": gameName,
+		"Ratings":  s.ratingInfos(s.storage.ListRatings(gameName, maxRatingsToList)),
+	})
+}
+
+func (s *Server) userRatingsHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	userID := s.getRequestUserID(c)
+
+	gameName := c.Query(qryGameNameKey)
+
+	s.doUserRatings(r, userID, gameName)
+}
+
+func (s *Server) doUserRatings(r *renderer, userID string, gameName string) {
+
+	var user *users.StorageRecord
+
+	if userID != "" {
+		user = s.storage.GetUserByID(userID)
+	}
+
+	if user == nil {
+		r.Error(errors.NewFriendly("No such user"))
+		return
+	}
+
+	if gameName != "" && s.managers[gameName] == nil {
+		r.Error(errors.NewFriendly("That is not a legal type of game"))
+		return
+	}
+
+	var gameNames []string
+
+	if gameName != "" {
+		gameNames = []string{gameName}
+	} else {
+		for name := range s.managers {
+			gameNames = append(gameNames, name)
+		}
+		sort.Strings(gameNames)
+	}
+
+	var records []*ratings.StorageRecord
+
+	for _, name := range gameNames {
+		record, err := s.storage.Rating(user.ID, name)
+		if err != nil {
+			r.Error(errors.New("Couldn't fetch rating: " + err.Error()))
+			return
+		}
+		records = append(records, record)
+	}
+
+	r.Success(gin.H{
+		"UserID":      user.ID,
+		"DisplayName": user.EffectiveDisplayName(),
+		"PhotoURL":    user.PhotoURL,
+		"Ratings":     records,
+		"History":     s.storage.RatingHistory(user.ID, gameName, maxRatingsToList),
+	})
+}
@@ -0,0 +1,110 @@
+/*
+
+Package ratings is the definition of the StorageRecords for player skill
+ratings, as well as the math to update them when a game finishes. In a separate
+package to avoid dependency cycles.
+
+Ratings are tracked per user per game type. Two-player games use classic Elo.
+Games with more players use a pairwise generalization of Elo: each player is
+compared against every other rated player in the game, scoring a win against
+those who lost, a loss against those who won, and a draw against everyone else.
+The per-pair adjustments are scaled down by the number of opponents so that a
+single game moves a rating by roughly the same amount regardless of player
+count.
+
+*/
+package ratings
+
+import (
+	"math"
+)
+
+//DefaultRating is the rating every user starts with in a game type before they
+//have finished any games of it.
+const DefaultRating = 1500.0
+
+//DefaultKFactor is the maximum amount a rating can change as the result of a
+//single game.
+const DefaultKFactor = 32.0
+
+//StorageRecord is the current rating for a single user in a single game type.
+type StorageRecord struct {
+	UserID   string
+	GameName string
+	Rating   float64
+	//NumGames is the number of finished, rated games this user has played of
+	//this game type.
+	NumGames int
+	//NumWins is the number of those games that the user was one of the
+	//winners of.
+	NumWins int
+	//LastUpdated is the unix nano timestamp of the last time this rating
+	//changed.
+	LastUpdated int64
+}
+
+//HistoryRecord is a single change to a user's rating, stored every time a
+//rated game finishes.
+type HistoryRecord struct {
+	UserID   string
+	GameName string
+	//GameID is the id of the game whose outcome produced this change.
+	GameID string
+	//Rating is the user's rating after the change was applied.
+	Rating float64
+	//Delta is how much the rating changed because of this game.
+	Delta     float64
+	Won       bool
+	Timestamp int64
+}
+
+//DefaultStorageRecord returns a StorageRecord for a user who has not yet
+//played any rated games of the given type.
+func DefaultStorageRecord(userID, gameName string) *StorageRecord {
+	return &StorageRecord{
+		UserID:   userID,
+		GameName: gameName,
+		Rating:   DefaultRating,
+	}
+}
+
+//ExpectedScore returns the expected score (between 0.0 and 1.0) of a player
+//with the given rating against an opponent with opponentRating.
+func ExpectedScore(rating, opponentRating float64) float64 {
+	return 1.0 / (1.0 + math.Pow(10, (opponentRating-rating)/400.0))
+}
+
+//Calculate returns the new ratings for each player, given their ratings
+//before the game and whether each of them was one of the winners. ratings and
+//won must be the same length. If there are fewer than two players, or kFactor
+//is not positive, the ratings are returned unchanged.
+func Calculate(ratings []float64, won []bool, kFactor float64) []float64 {
+
+	result := make([]float64, len(ratings))
+	copy(result, ratings)
+
+	if len(ratings) < 2 || len(won) != len(ratings) || kFactor <= 0 {
+		return result
+	}
+
+	scale := kFactor / float64(len(ratings)-1)
+
+	for i := range ratings {
+		delta := 0.0
+		for j := range ratings {
+			if i == j {
+				continue
+			}
+			actual := 0.5
+			if won[i] && !won[j] {
+				actual = 1.0
+			} else if !won[i] && won[j] {
+				actual = 0.0
+			}
+			delta += actual - ExpectedScore(ratings[i], ratings[j])
+		}
+		result[i] = ratings[i] + scale*delta
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+
+	"github.com/workfit/tester/assert"
+)
+
+func roundTo(val float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(val*factor) / factor
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		description string
+		ratings     []float64
+		won         []bool
+		kFactor     float64
+		expected    []float64
+	}{
+		{
+			"Two equal players, first wins",
+			[]float64{1500, 1500},
+			[]bool{true, false},
+			32,
+			[]float64{1516, 1484},
+		},
+		{
+			"Two equal players, draw",
+			[]float64{1500, 1500},
+			[]bool{false, false},
+			32,
+			[]float64{1500, 1500},
+		},
+		{
+			"Underdog wins",
+			[]float64{1400, 1600},
+			[]bool{true, false},
+			32,
+			[]float64{1424.31, 1575.69},
+		},
+		{
+			"Three equal players, one winner",
+			[]float64{1500, 1500, 1500},
+			[]bool{true, false, false},
+			32,
+			[]float64{1516, 1492, 1492},
+		},
+		{
+			"Single player is unchanged",
+			[]float64{1500},
+			[]bool{true},
+			32,
+			[]float64{1500},
+		},
+		{
+			"Mismatched lengths are unchanged",
+			[]float64{1500, 1600},
+			[]bool{true},
+			32,
+			[]float64{1500, 1600},
+		},
+	}
+
+	for i, test := range tests {
+		result := Calculate(test.ratings, test.won, test.kFactor)
+		for j := range result {
+			result[j] = roundTo(result[j], 2)
+		}
+		assert.For(t, i, test.description).ThatActual(result).Equals(test.expected)
+	}
+}
+
+func TestExpectedScore(t *testing.T) {
+	assert.For(t).ThatActual(ExpectedScore(1500, 1500)).Equals(0.5)
+	assert.For(t).ThatActual(roundTo(ExpectedScore(1600, 1400), 3)).Equals(0.760)
+	assert.For(t).ThatActual(roundTo(ExpectedScore(1400, 1600), 3)).Equals(0.240)
+}
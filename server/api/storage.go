@@ -4,14 +4,18 @@ import (
 	"errors"
 
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
 	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 )
 
 //Note: these are also duplicated in moves/seat_player.go
 const playerToSeatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToSeat"
 const willSeatPlayerRendevousDataType = "github.com/jkomoros/boardgame/server/api.WillSeatPlayer"
+const playerToUnseatRendevousDataType = "github.com/jkomoros/boardgame/server/api.PlayerToUnseat"
 
 //StorageManager extends the base boardgame.StorageManager with a few more
 //methods necessary to make server work. When creating a new Server, you need
@@ -43,9 +47,14 @@ type StorageManager interface {
 
 	CombinedGame(id string) (*extendedgame.CombinedStorageRecord, error)
 
-	//UpdateExtendedGame updates the extended game with the given Id.
+	//UpdateExtendedGame updates the extended game with the given Id. It
+	//leaves the stored InactivityClock as it was.
 	UpdateExtendedGame(id string, eGame *extendedgame.StorageRecord) error
 
+	//UpdateInactivityClock updates just the InactivityClock of the extended
+	//game with the given Id.
+	UpdateInactivityClock(id string, clock *extendedgame.InactivityClock) error
+
 	//Close should be called before the server is shut down.
 	Close()
 
@@ -59,6 +68,9 @@ type StorageManager interface {
 	//uid representing the user.
 	UserIDsForGame(gameID string) []string
 
+	//SetPlayerForGame sets the user in the given slot. It is an error if the
+	//slot is already taken. A userID of "" clears the slot, marking it empty
+	//again.
 	SetPlayerForGame(gameID string, playerIndex boardgame.PlayerIndex, userID string) error
 
 	//Store or update all fields
@@ -72,7 +84,68 @@ type StorageManager interface {
 	//does not yet exist, it should be added to the database.
 	ConnectCookieToUser(cookie string, user *users.StorageRecord) error
 
+	//Rating returns the current rating for the given user in the given game
+	//type. If the user has not yet played any rated games of that type, it
+	//should return ratings.DefaultStorageRecord.
+	Rating(userID string, gameName string) (*ratings.StorageRecord, error)
+
+	//UpdateRating stores (or overwrites) the given rating, keyed by its
+	//UserID and GameName, and appends history to the rating history for that
+	//user.
+	UpdateRating(rating *ratings.StorageRecord, history *ratings.HistoryRecord) error
+
+	//ListRatings returns up to max ratings for the given game type, in
+	//descending order by Rating.
+	ListRatings(gameName string, max int) []*ratings.StorageRecord
+
+	//RatingHistory returns up to max history records for the given user, most
+	//recent first. If gameName is "", history for all game types is returned.
+	RatingHistory(userID string, gameName string, max int) []*ratings.HistoryRecord
+
+	//DueInactivityChecks returns the IDs of games whose extended record has a
+	//non-zero InactivityDeadline at or before now (in UnixNano).
+	DueInactivityChecks(now int64) []string
+
+	//Tournament returns the tournament with the given ID, or an error if
+	//there is none.
+	Tournament(id string) (*tournaments.StorageRecord, error)
+
+	//UpdateTournament stores the given tournament, keyed by its ID,
+	//overwriting any existing record.
+	UpdateTournament(tournament *tournaments.StorageRecord) error
+
+	//ListTournaments returns up to max tournaments, most recently created
+	//first. If gameName is not "", only tournaments of that game type are
+	//returned.
+	ListTournaments(max int, gameName string) []*tournaments.StorageRecord
+
+	//ListUsers returns up to max users whose ID, DisplayName or Email
+	//contains query (ignoring case), most recently seen first. If query is
+	//"", every user matches.
+	ListUsers(max int, query string) []*users.StorageRecord
+
+	//DeleteGame removes the game with the given ID and everything stored
+	//about it: its states, moves, agent states, extended record, and which
+	//users are in which seats.
+	DeleteGame(id string) error
+
+	//DeleteUser removes the user with the given ID, every cookie that signs
+	//in as them, and their ratings and rating history. The seats they were
+	//in and the games they own are handed to users.DeletedUserID rather than
+	//emptied, so those games stay as they were. Tournaments they own or play
+	//in are updated with tournaments.StorageRecord.RemoveUser. It is an error
+	//if there is no such user.
+	DeleteUser(uid string) error
+
+	//AddAuditRecord appends the given record to the audit log.
+	AddAuditRecord(record *audit.StorageRecord) error
+
+	//AuditRecords returns up to max records from the audit log, most recent
+	//first.
+	AuditRecords(max int) []*audit.StorageRecord
+
 	//Note: whenever you add methods here, also add them to boardgame/storage/test/StorageManager
+	//and metricsStorageManager
 }
 
 //ServerStorageManager implements the ServerStorage interface by wrapping an
@@ -83,16 +156,19 @@ type ServerStorageManager struct {
 }
 
 //NewServerStorageManager takes an object that implements StorageManager and
-//wraps it.
+//wraps it. Calls to the wrapped manager are timed for metrics.
 func NewServerStorageManager(manager StorageManager) *ServerStorageManager {
 	return &ServerStorageManager{
-		manager,
+		newMetricsStorageManager(manager),
 		nil,
 	}
 }
 
 //PlayerMoveApplied notifies all clients connected vie an active WebSocket for
-//that game that the game has been modified.
+//that game that the game has been modified, and sends lifecycle webhooks. It
+//restarts the inactivity clock, and also updates ratings and any tournament
+//the game is part of if the game just finished, and otherwise notifies the
+//current player if it just became their turn.
 func (s *ServerStorageManager) PlayerMoveApplied(game *boardgame.GameStorageRecord) error {
 
 	//Do the wrapped manager's PlayerMoveApplied in case it has one.
@@ -109,12 +185,44 @@ func (s *ServerStorageManager) PlayerMoveApplied(game *boardgame.GameStorageReco
 	//Notify the web sockets that the game was changed
 	server.notifier.gameChanged(game)
 
+	server.dispatchMoveApplied(game)
+
+	server.inactivityMoveApplied(game)
+
+	//No more moves can be applied to a finished game, so this is the only
+	//time we'll see it become finished.
+	if game.Finished {
+		if err := server.updateRatings(game); err != nil {
+			server.logger.Errorln("Couldn't update ratings for game " + game.ID + ": " + err.Error())
+		}
+		if err := server.tournamentGameFinished(game); err != nil {
+			server.logger.Errorln("Couldn't record tournament result for game " + game.ID + ": " + err.Error())
+		}
+	} else {
+		server.notifyCurrentPlayer(game)
+	}
+
 	return nil
 
 }
 
+//initiatingMove returns the player move that kicked off the most recent chain
+//of moves in game. The chain may have ended with fix up moves; the player move
+//is the initiator of the last move.
+func (s *ServerStorageManager) initiatingMove(game *boardgame.GameStorageRecord) (*boardgame.MoveStorageRecord, error) {
+	lastMove, err := s.Move(game.ID, game.Version)
+	if err != nil {
+		return nil, errors.New("Couldn't fetch last move: " + err.Error())
+	}
+	if lastMove.Initiator == lastMove.Version {
+		return lastMove, nil
+	}
+	return s.Move(game.ID, lastMove.Initiator)
+}
+
 //FetchInjectedDataForGame is where the server signals to SeatPlayer that
-//there's a player to be seated.
+//there's a player to be seated, and to UnseatPlayer that there's a player who
+//left.
 func (s *ServerStorageManager) FetchInjectedDataForGame(gameID string, dataType string) interface{} {
 	if dataType == willSeatPlayerRendevousDataType {
 		//This data type should return anything non-nil to signal, yes, I am a
@@ -128,5 +236,12 @@ func (s *ServerStorageManager) FetchInjectedDataForGame(gameID string, dataType
 			return slice[0]
 		}
 	}
+	if dataType == playerToUnseatRendevousDataType {
+		slice := s.server.playersToUnseat[gameID]
+		if len(slice) > 0 {
+			//The item's Committed() will remove itself from the list.
+			return slice[0]
+		}
+	}
 	return s.StorageManager.FetchInjectedDataForGame(gameID, dataType)
 }
@@ -0,0 +1,484 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+const maxTournamentsToList = 100
+
+//tournamentPlayers is the number of players in every tournament game.
+const tournamentPlayers = 2
+
+type standingInfo struct {
+	*tournaments.Standing
+	DisplayName string
+	PhotoURL    string
+}
+
+//tournamentSocketKey is the key sockets watching the given tournament are
+//registered under. Game IDs never contain a "/", so it can't collide with a
+//game's.
+func tournamentSocketKey(id string) string {
+	return "tournament/" + id
+}
+
+//standingInfos decorates the given standings with display information about
+//the user they belong to.
+func (s *Server) standingInfos(standings []*tournaments.Standing) []*standingInfo {
+	result := make([]*standingInfo, len(standings))
+	for i, standing := range standings {
+		info := &standingInfo{
+			Standing: standing,
+		}
+		if user := s.storage.GetUserByID(standing.UserID); user != nil {
+			info.DisplayName = user.EffectiveDisplayName()
+			info.PhotoURL = user.PhotoURL
+		}
+		result[i] = info
+	}
+	return result
+}
+
+//calcCanManageTournament returns a non-nil error if user is not allowed to
+//start the given tournament.
+func (s *Server) calcCanManageTournament(user *users.StorageRecord, isAdmin bool, tournament *tournaments.StorageRecord) *errors.Friendly {
+	if user == nil {
+		return errors.New("No user provided")
+	}
+
+	if tournament == nil {
+		return errors.New("Invalid tournament")
+	}
+
+	if !isAdmin && user.ID != tournament.Owner {
+		return errors.NewFriendly("You are neither the owner nor an admin.")
+	}
+
+	return nil
+}
+
+//updateTournament loads the tournament with the given id, passes it to
+//modify, and, if modify doesn't return an error, saves it with an
+//incremented Version and tells sockets watching it. The tournament is locked
+//for the duration, so modify sees the latest copy.
+func (s *Server) updateTournament(id string, modify func(tournament *tournaments.StorageRecord) *errors.Friendly) (*tournaments.StorageRecord, *errors.Friendly) {
+	s.tournamentsLock.Lock()
+	defer s.tournamentsLock.Unlock()
+
+	tournament, err := s.storage.Tournament(id)
+
+	if err != nil {
+		return nil, errors.NewFriendly("No such tournament").WithError(err.Error())
+	}
+
+	if err := modify(tournament); err != nil {
+		return nil, err
+	}
+
+	tournament.Version++
+
+	if err := s.storage.UpdateTournament(tournament); err != nil {
+		return nil, errors.New("Couldn't save tournament: " + err.Error())
+	}
+
+	s.notifier.tournamentChanged(tournament)
+
+	return tournament, nil
+}
+
+//createTournamentGames creates and seats a game for each match in the
+//tournament's current round that isn't a bye. Each game's ID is saved to the
+//tournament as soon as the game is created, and matches that already have a
+//game only have their missing players seated, so if it fails partway through
+//calling it again finishes the job rather than orphaning games.
+func (s *Server) createTournamentGames(id string) *errors.Friendly {
+
+	s.tournamentGamesLock.Lock()
+	defer s.tournamentGamesLock.Unlock()
+
+	tournament, err := s.storage.Tournament(id)
+
+	if err != nil {
+		return errors.New("Couldn't fetch tournament: " + err.Error())
+	}
+
+	info := s.managers[tournament.GameName]
+
+	if info == nil {
+		return errors.New("Unknown game type " + tournament.GameName)
+	}
+
+	for i, match := range tournament.RoundMatches(tournament.Round) {
+		if match.IsBye() || match.Finished {
+			continue
+		}
+
+		var game *boardgame.Game
+
+		if match.GameID == "" {
+			newGame, err := info.manager.NewGame(tournamentPlayers, nil, nil)
+
+			if err != nil {
+				return errors.New("Couldn't create game: " + err.Error())
+			}
+
+			if _, err := s.updateTournament(id, func(tournament *tournaments.StorageRecord) *errors.Friendly {
+				matches := tournament.RoundMatches(match.Round)
+				if i >= len(matches) || matches[i].GameID != "" {
+					return errors.New("The tournament's matches changed unexpectedly")
+				}
+				matches[i].GameID = newGame.ID()
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			s.dispatchGameCreated(newGame, tournament.Owner)
+
+			game = newGame
+		} else {
+			game = info.manager.Game(match.GameID)
+
+			if game == nil {
+				return errors.New("Couldn't load game " + match.GameID)
+			}
+		}
+
+		eGame, err := s.storage.ExtendedGame(game.ID())
+
+		if err != nil {
+			return errors.New("Couldn't retrieve saved game: " + err.Error())
+		}
+
+		if eGame.TournamentID != tournament.ID {
+			eGame.Owner = tournament.Owner
+			eGame.Open = false
+			eGame.Visible = true
+			eGame.TournamentID = tournament.ID
+
+			if err := s.storage.UpdateExtendedGame(game.ID(), eGame); err != nil {
+				return errors.New("Couldn't save extended game metadata: " + err.Error())
+			}
+		}
+
+		seated := s.storage.UserIDsForGame(game.ID())
+
+		for seat, userID := range match.Players {
+			if seat < len(seated) && seated[seat] == userID {
+				continue
+			}
+			//A deleted player is seated as users.DeletedUserID, so the
+			//game's inactivity policy deals with their turns like any other
+			//absent player's.
+			if tournaments.IsTombstone(userID) {
+				if err := s.storage.SetPlayerForGame(game.ID(), boardgame.PlayerIndex(seat), users.DeletedUserID); err != nil {
+					return errors.New("Couldn't seat deleted player: " + err.Error())
+				}
+				continue
+			}
+			user := s.storage.GetUserByID(userID)
+			if user == nil {
+				return errors.New("Couldn't find registered user " + userID)
+			}
+			if err := s.doSeatPlayer(game, boardgame.PlayerIndex(seat), user); err != nil {
+				return errors.New("Couldn't seat " + userID + ": " + err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+//tournamentGameFinished is called when a game becomes finished. If it's part
+//of a tournament, it records the result and starts the next round's games if
+//that finished the round.
+func (s *Server) tournamentGameFinished(game *boardgame.GameStorageRecord) error {
+
+	eGame, err := s.storage.ExtendedGame(game.ID)
+
+	if err != nil {
+		return errors.New("couldn't fetch extended game: " + err.Error())
+	}
+
+	if eGame.TournamentID == "" {
+		return nil
+	}
+
+	//Record the result before creating the next round's games, so a failure
+	//creating them can't lose it.
+	_, friendlyErr := s.updateTournament(eGame.TournamentID, func(tournament *tournaments.StorageRecord) *errors.Friendly {
+		match := tournament.MatchForGame(game.ID)
+
+		if match == nil {
+			return errors.New("No match for game " + game.ID)
+		}
+
+		//Players are seated in match order. Look the winners up in the
+		//match rather than the game's seats, which don't tell deleted
+		//players apart.
+		var winners []string
+
+		for _, winner := range game.Winners {
+			if int(winner) < len(match.Players) {
+				winners = append(winners, match.Players[winner])
+			}
+		}
+
+		if _, err := tournament.RecordResult(game.ID, winners); err != nil {
+			return errors.New("Couldn't record result: " + err.Error())
+		}
+		return nil
+	})
+
+	if friendlyErr != nil {
+		return friendlyErr
+	}
+
+	if friendlyErr := s.createTournamentGames(eGame.TournamentID); friendlyErr != nil {
+		return friendlyErr
+	}
+
+	return nil
+}
+
+//tournamentAPISetup fetches the tournament configured in the URL and puts it
+//in context.
+func (s *Server) tournamentAPISetup(c *gin.Context) {
+
+	id := s.getRequestTournamentID(c)
+
+	tournament, err := s.storage.Tournament(id)
+
+	if err != nil {
+		s.logger.Debugln("Couldn't find tournament " + id + ": " + err.Error())
+		return
+	}
+
+	s.setTournament(c, tournament)
+}
+
+func (s *Server) listTournamentsHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	gameName := s.getRequestGameName(c)
+
+	s.doListTournaments(r, gameName)
+}
+
+func (s *Server) doListTournaments(r *renderer, gameName string) {
+
+	if gameName != "" && s.managers[gameName] == nil {
+		r.Error(errors.NewFriendly("That is not a legal type of game"))
+		return
+	}
+
+	r.Success(gin.H{
+		"Tournaments": s.storage.ListTournaments(maxTournamentsToList, gameName),
+	})
+}
+
+func (s *Server) newTournamentHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	managerID := s.getRequestManager(c)
+
+	owner := s.getUser(c)
+
+	name := s.getRequestTournamentName(c)
+
+	format := s.getRequestFormat(c)
+
+	rounds := s.getRequestRounds(c)
+
+	s.doNewTournament(r, owner, managerID, name, format, rounds)
+}
+
+func (s *Server) doNewTournament(r *renderer, owner *users.StorageRecord, gameName string, name string, format tournaments.Format, rounds int) {
+
+	if owner == nil {
+		r.Error(errors.NewFriendly("You must be signed in to create a tournament."))
+		return
+	}
+
+	info := s.managers[gameName]
+
+	if info == nil {
+		r.Error(errors.NewFriendly("That is not a legal type of game").WithError(gameName + " is not a legal manager for this server"))
+		return
+	}
+
+	if !info.manager.Delegate().LegalNumPlayers(tournamentPlayers) {
+		r.Error(errors.NewFriendly("Tournaments are only supported for two player games"))
+		return
+	}
+
+	if name == "" {
+		name = info.manager.Delegate().DisplayName() + " tournament"
+	}
+
+	if !s.newGameLimiter.Allow(owner.ID) {
+		r.Error(errors.NewFriendly("You've created too many games recently. Please wait a while before creating a tournament.").WithError("User " + owner.ID + " exceeded their new game rate limit"))
+		return
+	}
+
+	tournament, err := tournaments.New(name, gameName, format, owner.ID)
+
+	if err != nil {
+		r.Error(errors.NewFriendly(err.Error()))
+		return
+	}
+
+	if format == tournaments.Swiss && rounds > 0 {
+		tournament.NumRounds = rounds
+	}
+
+	if err := s.storage.UpdateTournament(tournament); err != nil {
+		r.Error(errors.New("Couldn't save tournament: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{
+		"TournamentID": tournament.ID,
+	})
+}
+
+func (s *Server) tournamentInfoHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	tournament := s.getTournament(c)
+
+	s.doTournamentInfo(r, tournament)
+}
+
+func (s *Server) doTournamentInfo(r *renderer, tournament *tournaments.StorageRecord) {
+
+	if tournament == nil {
+		r.Error(errors.NewFriendly("No such tournament"))
+		return
+	}
+
+	r.Success(gin.H{
+		"Tournament": tournament,
+		"Standings":  s.standingInfos(tournament.Standings()),
+	})
+}
+
+func (s *Server) tournamentSocketHandler(c *gin.Context) {
+
+	tournament := s.getTournament(c)
+
+	renderer := s.newRenderer(c)
+
+	if tournament == nil {
+		renderer.Error(errors.New("No such tournament"))
+		return
+	}
+
+	var userID string
+
+	if user := s.getUser(c); user != nil {
+		userID = user.ID
+	}
+
+	if err := s.calcSocketAllowed(userID); err != nil {
+		renderer.Error(err)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(c.Writer, c.Request, nil)
+
+	if err != nil {
+		renderer.Error(errors.New("Couldn't upgrade socket: " + err.Error()))
+		return
+	}
+
+	socket := newSocket(tournamentSocketKey(tournament.ID), tournament.Version, userID, conn, s.notifier)
+	s.notifier.register <- socket
+}
+
+func (s *Server) registerTournamentHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	tournament := s.getTournament(c)
+
+	user := s.getUser(c)
+
+	s.doRegisterTournament(r, user, tournament)
+}
+
+func (s *Server) doRegisterTournament(r *renderer, user *users.StorageRecord, tournament *tournaments.StorageRecord) {
+
+	if user == nil {
+		r.Error(errors.NewFriendly("You must be signed in to register for a tournament."))
+		return
+	}
+
+	if tournament == nil {
+		r.Error(errors.NewFriendly("No such tournament"))
+		return
+	}
+
+	_, err := s.updateTournament(tournament.ID, func(tournament *tournaments.StorageRecord) *errors.Friendly {
+		if err := tournament.Register(user.ID); err != nil {
+			return errors.NewFriendly("Couldn't register: " + err.Error())
+		}
+		return nil
+	})
+
+	if err != nil {
+		r.Error(err)
+		return
+	}
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) startTournamentHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	tournament := s.getTournament(c)
+
+	user := s.getUser(c)
+
+	adminAllowed := s.getAdminAllowed(c)
+	requestAdmin := s.getRequestAdmin(c)
+
+	isAdmin := s.calcIsAdmin(adminAllowed, requestAdmin)
+
+	s.doStartTournament(r, user, isAdmin, tournament)
+}
+
+func (s *Server) doStartTournament(r *renderer, user *users.StorageRecord, isAdmin bool, tournament *tournaments.StorageRecord) {
+
+	if err := s.calcCanManageTournament(user, isAdmin, tournament); err != nil {
+		r.Error(err)
+		return
+	}
+
+	//Starting a tournament that already started retries creating the first
+	//round's games, in case that failed partway through.
+	if !tournament.Started() {
+		_, err := s.updateTournament(tournament.ID, func(tournament *tournaments.StorageRecord) *errors.Friendly {
+			if _, err := tournament.Start(); err != nil {
+				return errors.NewFriendly("Couldn't start tournament: " + err.Error())
+			}
+			return nil
+		})
+
+		if err != nil {
+			r.Error(err)
+			return
+		}
+	}
+
+	if err := s.createTournamentGames(tournament.ID); err != nil {
+		r.Error(err)
+		return
+	}
+
+	r.Success(gin.H{})
+}
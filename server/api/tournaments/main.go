@@ -0,0 +1,644 @@
+/*
+
+Package tournaments is the definition of the StorageRecord for tournaments, as
+well as the logic to pair players each round and compute standings. In a
+separate package to avoid dependency cycles.
+
+A tournament is a series of two-player games (matches) of a single game type
+between registered users. Three formats are supported:
+
+Swiss: a fixed number of rounds. Each round players are paired with someone
+with a similar score whom they haven't played yet.
+
+RoundRobin: everyone plays everyone else exactly once.
+
+SingleElimination: a seeded bracket. The winner of each match advances; the
+loser is out.
+
+When there is an odd number of players in a round, one of them gets a bye,
+which counts as a win.
+
+*/
+package tournaments
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//Format is the way players are paired in a tournament.
+type Format string
+
+const (
+	//Swiss pairs players with similar scores for a fixed number of rounds.
+	Swiss Format = "swiss"
+	//RoundRobin has every player play every other player once.
+	RoundRobin Format = "roundrobin"
+	//SingleElimination has players advance through a bracket until one is
+	//left.
+	SingleElimination Format = "elimination"
+)
+
+//Points awarded for the result of each match, used for standings.
+const (
+	WinPoints  = 1.0
+	DrawPoints = 0.5
+	ByePoints  = 1.0
+)
+
+const idLength = 8
+
+//StorageRecord is a single tournament.
+type StorageRecord struct {
+	ID string
+	//Name is the human readable name of the tournament.
+	Name string
+	//GameName is the type of game every match is played in.
+	GameName string
+	Format   Format
+	//Owner is the id of the user who created the tournament, who may start
+	//it.
+	Owner   string
+	Created int64
+	//Version is incremented every time the tournament changes.
+	Version int
+	//Players are the user IDs of the registered players, in seed order.
+	Players []string
+	//NumRounds is how many rounds the tournament will have. For Swiss
+	//tournaments it may be set before the tournament starts; otherwise it is
+	//set based on the number of players when the tournament starts.
+	NumRounds int
+	//Round is the current round, starting from 1. 0 means the tournament
+	//hasn't started yet.
+	Round    int
+	Finished bool
+	Matches  []*Match
+}
+
+//Match is a single game between two players in a round, or a bye.
+type Match struct {
+	Round int
+	//Players are the user IDs of the players in the match, with the higher
+	//seed first. A bye has only one player.
+	Players []string
+	//GameID is the id of the game being played for the match, or "" for a
+	//bye.
+	GameID   string
+	Finished bool
+	//Winners are the user IDs of the players who won. A finished match with
+	//no winners (or with every player winning) was a draw.
+	Winners []string
+}
+
+//Standing is how a single player is doing in the tournament.
+type Standing struct {
+	UserID string
+	Points float64
+	Wins   int
+	Losses int
+	Draws  int
+	Byes   int
+}
+
+//New returns a new tournament that is open for registration.
+func New(name string, gameName string, format Format, owner string) (*StorageRecord, error) {
+	switch format {
+	case Swiss, RoundRobin, SingleElimination:
+	default:
+		return nil, errors.New("unknown format: " + string(format))
+	}
+
+	if gameName == "" {
+		return nil, errors.New("no game type provided")
+	}
+
+	id, err := randomID()
+
+	if err != nil {
+		return nil, errors.New("couldn't generate id: " + err.Error())
+	}
+
+	return &StorageRecord{
+		ID:       id,
+		Name:     name,
+		GameName: gameName,
+		Format:   format,
+		Owner:    owner,
+		Created:  time.Now().UnixNano(),
+	}, nil
+}
+
+func randomID() (string, error) {
+	bytes := make([]byte, idLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+//Copy returns a deep copy of the tournament, so storage layers can hand out
+//records without callers modifying their copy.
+func (t *StorageRecord) Copy() *StorageRecord {
+	result := *t
+	result.Players = append([]string(nil), t.Players...)
+	result.Matches = nil
+	for _, match := range t.Matches {
+		matchCopy := *match
+		matchCopy.Players = append([]string(nil), match.Players...)
+		matchCopy.Winners = append([]string(nil), match.Winners...)
+		result.Matches = append(result.Matches, &matchCopy)
+	}
+	return &result
+}
+
+//RemoveUser replaces every mention of the given user in the tournament with a
+//tombstone, for when the user is deleted. The owner becomes
+//users.DeletedUserID. As a player (and winner) the user becomes a tombstone
+//that starts with users.DeletedUserID but is unique within the tournament, so
+//pairings and standings can still tell two deleted players apart. Version is
+//incremented if anything changed. Returns true if the user was in the
+//tournament.
+func (t *StorageRecord) RemoveUser(userID string) bool {
+	changed := false
+
+	if t.Owner == userID {
+		t.Owner = users.DeletedUserID
+		changed = true
+	}
+
+	if t.IsRegistered(userID) {
+		tombstone := t.tombstone()
+		replace := func(ids []string) {
+			for i, id := range ids {
+				if id == userID {
+					ids[i] = tombstone
+				}
+			}
+		}
+		replace(t.Players)
+		for _, match := range t.Matches {
+			replace(match.Players)
+			replace(match.Winners)
+		}
+		changed = true
+	}
+
+	if changed {
+		t.Version++
+	}
+
+	return changed
+}
+
+//tombstone returns an ID for a deleted player that isn't registered yet.
+func (t *StorageRecord) tombstone() string {
+	result := users.DeletedUserID
+	for i := 2; t.IsRegistered(result); i++ {
+		result = users.DeletedUserID + "-" + strconv.Itoa(i)
+	}
+	return result
+}
+
+//IsTombstone returns true if the given user ID is one RemoveUser put in place
+//of a deleted user.
+func IsTombstone(userID string) bool {
+	return strings.HasPrefix(userID, users.DeletedUserID)
+}
+
+//IsBye returns true if the match is a bye.
+func (m *Match) IsBye() bool {
+	return len(m.Players) == 1
+}
+
+//IsDraw returns true if the match is finished but no single player won.
+func (m *Match) IsDraw() bool {
+	return m.Finished && !m.IsBye() && len(m.Winners) != 1
+}
+
+//advancer returns the player who goes on to the next round of an elimination
+//tournament. Draws go to the higher seed.
+func (m *Match) advancer() string {
+	if len(m.Winners) == 1 {
+		return m.Winners[0]
+	}
+	return m.Players[0]
+}
+
+func (m *Match) hasPlayer(userID string) bool {
+	for _, player := range m.Players {
+		if player == userID {
+			return true
+		}
+	}
+	return false
+}
+
+//Started returns true if the tournament has started.
+func (t *StorageRecord) Started() bool {
+	return t.Round > 0
+}
+
+//IsRegistered returns true if the given user is registered for the
+//tournament.
+func (t *StorageRecord) IsRegistered(userID string) bool {
+	for _, player := range t.Players {
+		if player == userID {
+			return true
+		}
+	}
+	return false
+}
+
+//Register adds the given user to the tournament. Players may only register
+//before the tournament starts.
+func (t *StorageRecord) Register(userID string) error {
+	if userID == "" {
+		return errors.New("no user provided")
+	}
+	if t.Started() {
+		return errors.New("the tournament has already started")
+	}
+	if t.IsRegistered(userID) {
+		return errors.New("already registered")
+	}
+	t.Players = append(t.Players, userID)
+	return nil
+}
+
+//RoundMatches returns the matches in the given round.
+func (t *StorageRecord) RoundMatches(round int) []*Match {
+	var result []*Match
+	for _, match := range t.Matches {
+		if match.Round == round {
+			result = append(result, match)
+		}
+	}
+	return result
+}
+
+//MatchForGame returns the match being played in the given game, or nil if
+//there isn't one.
+func (t *StorageRecord) MatchForGame(gameID string) *Match {
+	if gameID == "" {
+		return nil
+	}
+	for _, match := range t.Matches {
+		if match.GameID == gameID {
+			return match
+		}
+	}
+	return nil
+}
+
+//Start closes registration and pairs the first round. It returns the new
+//matches; the caller should create a game for each that isn't a bye and set
+//its GameID.
+func (t *StorageRecord) Start() ([]*Match, error) {
+	if t.Started() {
+		return nil, errors.New("the tournament has already started")
+	}
+
+	numPlayers := len(t.Players)
+
+	if numPlayers < 2 {
+		return nil, errors.New("at least two players must be registered")
+	}
+
+	switch t.Format {
+	case RoundRobin:
+		t.NumRounds = numPlayers - 1
+		if numPlayers%2 == 1 {
+			t.NumRounds = numPlayers
+		}
+	case SingleElimination:
+		t.NumRounds = bits.Len(uint(numPlayers - 1))
+	case Swiss:
+		if t.NumRounds < 1 {
+			t.NumRounds = bits.Len(uint(numPlayers - 1))
+		}
+	}
+
+	return t.nextRound(), nil
+}
+
+//RecordResult records that the game with the given id finished, with the
+//given user IDs as winners. If that completes the round, the next round is
+//paired (or the tournament finishes). It returns any new matches; the caller
+//should create a game for each that isn't a bye and set its GameID.
+func (t *StorageRecord) RecordResult(gameID string, winners []string) ([]*Match, error) {
+	match := t.MatchForGame(gameID)
+
+	if match == nil {
+		return nil, errors.New("no match for that game")
+	}
+
+	if match.Finished {
+		return nil, errors.New("that match has already finished")
+	}
+
+	match.Finished = true
+
+	for _, winner := range winners {
+		if match.hasPlayer(winner) {
+			match.Winners = append(match.Winners, winner)
+		}
+	}
+
+	for _, match := range t.RoundMatches(t.Round) {
+		if !match.Finished {
+			return nil, nil
+		}
+	}
+
+	if t.Round >= t.NumRounds {
+		t.Finished = true
+		return nil, nil
+	}
+
+	return t.nextRound(), nil
+}
+
+//nextRound increments Round and pairs it.
+func (t *StorageRecord) nextRound() []*Match {
+
+	t.Round++
+
+	var pairs [][]string
+
+	switch t.Format {
+	case RoundRobin:
+		pairs = t.roundRobinPairs()
+	case SingleElimination:
+		pairs = t.eliminationPairs()
+	default:
+		pairs = t.swissPairs()
+	}
+
+	var result []*Match
+
+	for _, players := range pairs {
+		match := &Match{
+			Round:   t.Round,
+			Players: players,
+		}
+		if match.IsBye() {
+			match.Finished = true
+			match.Winners = []string{players[0]}
+		}
+		result = append(result, match)
+	}
+
+	t.Matches = append(t.Matches, result...)
+
+	return result
+}
+
+//roundRobinPairs pairs the current round using the circle method: the first
+//player stays put while everyone else rotates one place each round.
+func (t *StorageRecord) roundRobinPairs() [][]string {
+
+	players := append([]string{}, t.Players...)
+
+	if len(players)%2 == 1 {
+		//"" is the bye.
+		players = append(players, "")
+	}
+
+	n := len(players)
+	rotation := t.Round - 1
+
+	position := func(i int) string {
+		if i == 0 {
+			return players[0]
+		}
+		return players[(i-1+rotation)%(n-1)+1]
+	}
+
+	var result [][]string
+
+	for i := 0; i < n/2; i++ {
+		result = append(result, t.pair(position(i), position(n-1-i)))
+	}
+
+	return result
+}
+
+//eliminationPairs pairs the current round of a seeded bracket. In the first
+//round the top seeds get byes if the number of players isn't a power of two.
+//In later rounds, the players who advanced from adjacent matches play each
+//other.
+func (t *StorageRecord) eliminationPairs() [][]string {
+
+	var result [][]string
+
+	if t.Round == 1 {
+		order := bracketOrder(1 << uint(t.NumRounds))
+		for i := 0; i < len(order); i += 2 {
+			var first, second string
+			if order[i] < len(t.Players) {
+				first = t.Players[order[i]]
+			}
+			if order[i+1] < len(t.Players) {
+				second = t.Players[order[i+1]]
+			}
+			result = append(result, t.pair(first, second))
+		}
+		return result
+	}
+
+	previous := t.RoundMatches(t.Round - 1)
+
+	for i := 0; i+1 < len(previous); i += 2 {
+		result = append(result, t.pair(previous[i].advancer(), previous[i+1].advancer()))
+	}
+
+	return result
+}
+
+//bracketOrder returns the zero-indexed seeds in bracket order for a bracket
+//of the given size (a power of two), such that adjacent pairs play in the
+//first round and the top two seeds can only meet in the final.
+func bracketOrder(size int) []int {
+	order := []int{0}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, len(order)*2-1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+//swissPairs pairs the current round by standings. If there's an odd number
+//of players, the lowest ranked player who hasn't had a bye gets one. Then,
+//from the top of the standings down, each player is paired with the highest
+//ranked remaining player they haven't played yet, as long as that still lets
+//everyone else avoid a rematch. If there's no way to avoid rematches,
+//players are simply paired with the next player down in the standings.
+func (t *StorageRecord) swissPairs() [][]string {
+
+	standings := t.Standings()
+
+	var remaining []string
+
+	for _, standing := range standings {
+		remaining = append(remaining, standing.UserID)
+	}
+
+	var result [][]string
+
+	if len(remaining)%2 == 1 {
+		byeIndex := len(remaining) - 1
+		for i := len(remaining) - 1; i >= 0; i-- {
+			if !t.hadBye(remaining[i]) {
+				byeIndex = i
+				break
+			}
+		}
+		result = append(result, []string{remaining[byeIndex]})
+		remaining = append(remaining[:byeIndex], remaining[byeIndex+1:]...)
+	}
+
+	pairs, ok := t.pairWithoutRematches(remaining)
+
+	if !ok {
+		pairs = nil
+		for i := 0; i+1 < len(remaining); i += 2 {
+			pairs = append(pairs, t.pair(remaining[i], remaining[i+1]))
+		}
+	}
+
+	//Byes go last, like in the other formats.
+	return append(pairs, result...)
+}
+
+//pairWithoutRematches pairs remaining (which must have an even length) such
+//that no one plays someone they've already played, preferring to pair each
+//player with the highest ranked opponent possible. Returns false if that
+//isn't possible.
+func (t *StorageRecord) pairWithoutRematches(remaining []string) ([][]string, bool) {
+	if len(remaining) == 0 {
+		return nil, true
+	}
+	player := remaining[0]
+	for i := 1; i < len(remaining); i++ {
+		if t.played(player, remaining[i]) {
+			continue
+		}
+		rest := make([]string, 0, len(remaining)-2)
+		rest = append(rest, remaining[1:i]...)
+		rest = append(rest, remaining[i+1:]...)
+		if pairs, ok := t.pairWithoutRematches(rest); ok {
+			return append([][]string{t.pair(player, remaining[i])}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+//pair returns a match's players from two user IDs, with the higher seed
+//first. Either may be "", in which case the match is a bye.
+func (t *StorageRecord) pair(first, second string) []string {
+	if first == "" {
+		return []string{second}
+	}
+	if second == "" {
+		return []string{first}
+	}
+	if t.seed(second) < t.seed(first) {
+		return []string{second, first}
+	}
+	return []string{first, second}
+}
+
+func (t *StorageRecord) seed(userID string) int {
+	for i, player := range t.Players {
+		if player == userID {
+			return i
+		}
+	}
+	return len(t.Players)
+}
+
+func (t *StorageRecord) played(first, second string) bool {
+	for _, match := range t.Matches {
+		if match.hasPlayer(first) && match.hasPlayer(second) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *StorageRecord) hadBye(userID string) bool {
+	for _, match := range t.Matches {
+		if match.IsBye() && match.Players[0] == userID {
+			return true
+		}
+	}
+	return false
+}
+
+//Standings returns how each registered player is doing, ordered by points,
+//then wins, then seed.
+func (t *StorageRecord) Standings() []*Standing {
+
+	standings := make(map[string]*Standing, len(t.Players))
+
+	result := make([]*Standing, len(t.Players))
+
+	for i, player := range t.Players {
+		standing := &Standing{
+			UserID: player,
+		}
+		standings[player] = standing
+		result[i] = standing
+	}
+
+	for _, match := range t.Matches {
+		if !match.Finished {
+			continue
+		}
+		if match.IsBye() {
+			if standing := standings[match.Players[0]]; standing != nil {
+				standing.Byes++
+				standing.Points += ByePoints
+			}
+			continue
+		}
+		for _, player := range match.Players {
+			standing := standings[player]
+			if standing == nil {
+				continue
+			}
+			switch {
+			case match.IsDraw():
+				standing.Draws++
+				standing.Points += DrawPoints
+			case match.Winners[0] == player:
+				standing.Wins++
+				standing.Points += WinPoints
+			default:
+				standing.Losses++
+			}
+		}
+	}
+
+	//result starts in seed order, so a stable sort breaks remaining ties by
+	//seed.
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Points != result[j].Points {
+			return result[i].Points > result[j].Points
+		}
+		return result[i].Wins > result[j].Wins
+	})
+
+	return result
+}
@@ -0,0 +1,223 @@
+package tournaments
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/workfit/tester/assert"
+)
+
+func newTestTournament(t *testing.T, format Format, numPlayers int) *StorageRecord {
+	tournament, err := New("Test", "tictactoe", format, "owner")
+	assert.For(t).ThatActual(err).IsNil()
+	for i := 0; i < numPlayers; i++ {
+		assert.For(t).ThatActual(tournament.Register("p" + strconv.Itoa(i))).IsNil()
+	}
+	return tournament
+}
+
+//playRound gives every unfinished match in the current round a game and has
+//the higher seed win.
+func playRound(t *testing.T, tournament *StorageRecord, matches []*Match) []*Match {
+	var next []*Match
+	for i, match := range matches {
+		if match.IsBye() {
+			continue
+		}
+		match.GameID = "game-" + strconv.Itoa(tournament.Round) + "-" + strconv.Itoa(i)
+	}
+	for _, match := range matches {
+		if match.IsBye() {
+			continue
+		}
+		newMatches, err := tournament.RecordResult(match.GameID, []string{match.Players[0]})
+		assert.For(t).ThatActual(err).IsNil()
+		next = append(next, newMatches...)
+	}
+	return next
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("Test", "tictactoe", "bogus", "owner")
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	tournament, err := New("Test", "tictactoe", Swiss, "owner")
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(len(tournament.ID)).Equals(idLength * 2)
+
+	assert.For(t).ThatActual(tournament.Register("a")).IsNil()
+	assert.For(t).ThatActual(tournament.Register("a")).IsNotNil()
+
+	_, err = tournament.Start()
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	assert.For(t).ThatActual(tournament.Register("b")).IsNil()
+
+	_, err = tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(tournament.Register("c")).IsNotNil()
+}
+
+func TestRoundRobin(t *testing.T) {
+	tournament := newTestTournament(t, RoundRobin, 5)
+
+	matches, err := tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.NumRounds).Equals(5)
+
+	for !tournament.Finished {
+		matches = playRound(t, tournament, matches)
+	}
+
+	played := make(map[string]int)
+	byes := make(map[string]int)
+
+	for _, match := range tournament.Matches {
+		if match.IsBye() {
+			byes[match.Players[0]]++
+			continue
+		}
+		played[match.Players[0]+"-"+match.Players[1]]++
+	}
+
+	assert.For(t).ThatActual(len(played)).Equals(10)
+	for key, count := range played {
+		assert.For(t, key).ThatActual(count).Equals(1)
+	}
+	assert.For(t).ThatActual(len(byes)).Equals(5)
+
+	standings := tournament.Standings()
+
+	assert.For(t).ThatActual(standings[0].UserID).Equals("p0")
+	assert.For(t).ThatActual(standings[0].Wins).Equals(4)
+	assert.For(t).ThatActual(standings[0].Byes).Equals(1)
+	assert.For(t).ThatActual(standings[len(standings)-1].UserID).Equals("p4")
+	assert.For(t).ThatActual(standings[len(standings)-1].Losses).Equals(4)
+}
+
+func TestSingleElimination(t *testing.T) {
+	tournament := newTestTournament(t, SingleElimination, 5)
+
+	matches, err := tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.NumRounds).Equals(3)
+
+	//A bracket of 8, so the top three seeds get byes.
+	assert.For(t).ThatActual(len(matches)).Equals(4)
+
+	var byes []string
+	for _, match := range matches {
+		if match.IsBye() {
+			byes = append(byes, match.Players[0])
+		}
+	}
+	assert.For(t).ThatActual(byes).Equals([]string{"p0", "p1", "p2"})
+
+	matches = playRound(t, tournament, matches)
+
+	assert.For(t).ThatActual(len(matches)).Equals(2)
+	assert.For(t).ThatActual(matches[0].Players).Equals([]string{"p0", "p3"})
+	assert.For(t).ThatActual(matches[1].Players).Equals([]string{"p1", "p2"})
+
+	matches = playRound(t, tournament, matches)
+
+	assert.For(t).ThatActual(len(matches)).Equals(1)
+	assert.For(t).ThatActual(matches[0].Players).Equals([]string{"p0", "p1"})
+
+	//A draw in the final goes to the higher seed.
+	matches[0].GameID = "final"
+	_, err = tournament.RecordResult("final", nil)
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.Finished).IsTrue()
+	assert.For(t).ThatActual(matches[0].IsDraw()).IsTrue()
+	assert.For(t).ThatActual(matches[0].advancer()).Equals("p0")
+
+	_, err = tournament.RecordResult("final", nil)
+	assert.For(t).ThatActual(err).IsNotNil()
+}
+
+func TestSwiss(t *testing.T) {
+	tournament := newTestTournament(t, Swiss, 5)
+
+	matches, err := tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.NumRounds).Equals(3)
+
+	for !tournament.Finished {
+		matches = playRound(t, tournament, matches)
+	}
+
+	assert.For(t).ThatActual(tournament.Round).Equals(3)
+
+	played := make(map[string]bool)
+	byes := make(map[string]bool)
+
+	for _, match := range tournament.Matches {
+		if match.IsBye() {
+			assert.For(t, match.Players[0]).ThatActual(byes[match.Players[0]]).IsFalse()
+			byes[match.Players[0]] = true
+			continue
+		}
+		key := match.Players[0] + "-" + match.Players[1]
+		assert.For(t, key).ThatActual(played[key]).IsFalse()
+		played[key] = true
+	}
+
+	assert.For(t).ThatActual(len(byes)).Equals(3)
+
+	standings := tournament.Standings()
+
+	assert.For(t).ThatActual(standings[0].UserID).Equals("p0")
+	assert.For(t).ThatActual(standings[0].Points).Equals(3.0)
+}
+
+func TestRecordResultIgnoresStrangers(t *testing.T) {
+	tournament := newTestTournament(t, Swiss, 2)
+
+	matches, err := tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+
+	matches[0].GameID = "game"
+
+	_, err = tournament.RecordResult("other", []string{"p0"})
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	_, err = tournament.RecordResult("game", []string{"stranger", "p1"})
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(matches[0].Winners).Equals([]string{"p1"})
+	assert.For(t).ThatActual(tournament.Finished).IsTrue()
+}
+
+func TestRemoveUser(t *testing.T) {
+	tournament := newTestTournament(t, RoundRobin, 4)
+
+	matches, err := tournament.Start()
+	assert.For(t).ThatActual(err).IsNil()
+
+	playRound(t, tournament, matches)
+
+	version := tournament.Version
+
+	assert.For(t).ThatActual(tournament.RemoveUser("stranger")).IsFalse()
+	assert.For(t).ThatActual(tournament.Version).Equals(version)
+
+	assert.For(t).ThatActual(tournament.RemoveUser("p0")).IsTrue()
+	assert.For(t).ThatActual(tournament.RemoveUser("p1")).IsTrue()
+	assert.For(t).ThatActual(tournament.RemoveUser("owner")).IsTrue()
+	assert.For(t).ThatActual(tournament.Version).Equals(version + 3)
+
+	assert.For(t).ThatActual(tournament.Owner).Equals("deleted-user")
+	assert.For(t).ThatActual(tournament.Players).Equals([]string{"deleted-user", "deleted-user-2", "p2", "p3"})
+
+	for _, match := range tournament.Matches {
+		for _, id := range append(append([]string{}, match.Players...), match.Winners...) {
+			assert.For(t, id).ThatActual(id == "p0" || id == "p1").IsFalse()
+		}
+	}
+
+	//The two deleted players are still told apart.
+	assert.For(t).ThatActual(len(tournament.Standings())).Equals(4)
+	assert.For(t).ThatActual(IsTombstone("deleted-user-2")).IsTrue()
+	assert.For(t).ThatActual(IsTombstone("p2")).IsFalse()
+}
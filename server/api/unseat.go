@@ -0,0 +1,190 @@
+package api
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/moves/interfaces"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+type playerToUnseat struct {
+	s         *Server
+	gameID    string
+	seatIndex boardgame.PlayerIndex
+}
+
+//by defining the variable type, we verify we actually do implement the
+//interface. Since it flows via FetchInejctedData, there's no type
+//checking otherwise.
+var testPlayerUnseat interfaces.UnseatPlayerSignaler = &playerToUnseat{}
+
+func (p *playerToUnseat) SeatIndex() boardgame.PlayerIndex {
+	return p.seatIndex
+}
+
+func (p *playerToUnseat) Committed() {
+	slice := p.s.playersToUnseat[p.gameID]
+	for i, player := range slice {
+		if player == p {
+			p.s.playersToUnseat[p.gameID] = append(slice[:i], slice[i+1:]...)
+			return
+		}
+	}
+}
+
+//managerUnseatPlayerMoves is like managerSeatPlayerMoves, but for moves that
+//are an UnseatPlayer move.
+func managerUnseatPlayerMoves(manager *boardgame.GameManager) []string {
+	var result []string
+	for _, move := range manager.ExampleMoves() {
+		if unseatPlayer, ok := move.(interfaces.UnseatPlayerMover); ok {
+			if unseatPlayer.IsUnseatPlayerMove() {
+				result = append(result, move.Info().Name())
+			}
+		}
+	}
+	return result
+}
+
+func (s *Server) leaveGameHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	game := s.getGame(c)
+
+	if game == nil {
+		r.Error(errors.NewFriendly("No such game"))
+		return
+	}
+
+	user := s.getUser(c)
+
+	userIds := s.storage.UserIDsForGame(game.ID())
+
+	closedSeats := s.closedSeatsForGame(game)
+
+	viewingAsPlayer, _ := s.calcViewingAsPlayerAndEmptySlots(userIds, user, game.Agents(), closedSeats)
+
+	agentName := s.getRequestAgentName(c)
+
+	s.doLeaveGame(r, game, viewingAsPlayer, agentName)
+}
+
+func (s *Server) doLeaveGame(r *renderer, game *boardgame.Game, viewingAsPlayer boardgame.PlayerIndex, agentName string) {
+
+	if viewingAsPlayer == boardgame.ObserverPlayerIndex {
+		r.Error(errors.NewFriendly("You aren't seated in this game."))
+		return
+	}
+
+	if err := s.doUnseatPlayer(game, viewingAsPlayer, agentName); err != nil {
+		r.Error(errors.New("Couldn't leave game: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{})
+}
+
+func (s *Server) kickPlayerHandler(c *gin.Context) {
+	game := s.getGame(c)
+
+	var gameID string
+
+	if game != nil {
+		gameID = game.ID()
+	}
+
+	gameInfo, _ := s.storage.ExtendedGame(gameID)
+
+	adminAllowed := s.getAdminAllowed(c)
+	requestAdmin := s.getRequestAdmin(c)
+
+	isAdmin := s.calcIsAdmin(adminAllowed, requestAdmin)
+
+	user := s.getUser(c)
+
+	seat := s.getRequestSeat(c)
+
+	agentName := s.getRequestAgentName(c)
+
+	r := s.newRenderer(c)
+
+	s.doKickPlayer(r, user, isAdmin, game, gameInfo, seat, agentName)
+}
+
+func (s *Server) doKickPlayer(r *renderer, user *users.StorageRecord, isAdmin bool, game *boardgame.Game, gameInfo *extendedgame.StorageRecord, seat int, agentName string) {
+
+	if err := s.calcCanManageGame(user, isAdmin, game, gameInfo); err != nil {
+		r.Error(err)
+		return
+	}
+
+	if seat < 0 || seat >= game.NumPlayers() {
+		r.Error(errors.NewFriendly("Seat " + strconv.Itoa(seat) + " is not a valid seat in this game."))
+		return
+	}
+
+	userIds := s.storage.UserIDsForGame(game.ID())
+
+	if seat >= len(userIds) || userIds[seat] == "" {
+		r.Error(errors.NewFriendly("There is no player in seat " + strconv.Itoa(seat) + "."))
+		return
+	}
+
+	if err := s.doUnseatPlayer(game, boardgame.PlayerIndex(seat), agentName); err != nil {
+		r.Error(errors.New("Couldn't kick player: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{})
+}
+
+//doUnseatPlayer removes whoever is sitting in slot from the game. If
+//agentName is not "", the named agent takes over the seat from now on, and the
+//game logic is not told anything changed. Otherwise, if the game has an
+//UnseatPlayer move, it is told the player left the next time that move is
+//legal.
+func (s *Server) doUnseatPlayer(game *boardgame.Game, slot boardgame.PlayerIndex, agentName string) error {
+
+	if agentName != "" {
+		if game.Finished() {
+			return errors.NewFriendly("The game is already finished.")
+		}
+		if game.Manager().AgentByName(agentName) == nil {
+			return errors.NewFriendly("There is no agent named " + agentName + " for this game.")
+		}
+		if err := <-game.Manager().Internals().SetAgent(game, slot, agentName); err != nil {
+			return errors.New("Agent couldn't take over the seat: " + err.Error())
+		}
+	} else if len(s.managers[game.Name()].unseatPlayerMoves) > 0 {
+		//Just like in doSeatPlayer, queue up the player for the UnseatPlayer
+		//move and have the engine check whether it's legal now.
+
+		gameID := game.ID()
+
+		player := &playerToUnseat{
+			s,
+			gameID,
+			slot,
+		}
+
+		s.playersToUnseat[gameID] = append(s.playersToUnseat[gameID], player)
+
+		delayed := game.Manager().Internals().ForceFixUp(game)
+
+		go func() {
+			if err := <-delayed; err != nil {
+				log.Println("Forced Fix Up failed: " + err.Error())
+			}
+		}()
+
+		//The seat will stay closed until UnseatPlayer actually applies, so
+		//it's safe to mark it empty in storage now.
+	}
+
+	return s.storage.SetPlayerForGame(game.ID(), slot, "")
+}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/users"
+)
+
+//maxExportedRecords is how many of each kind of record (games, rating
+//history, tournaments) are included in a user's data export.
+const maxExportedRecords = 10000
+
+//userDataExport is everything stored about a user, as returned by the export
+//endpoint.
+type userDataExport struct {
+	User          *users.StorageRecord
+	Games         []*exportedGame
+	Ratings       []*ratings.StorageRecord
+	RatingHistory []*ratings.HistoryRecord
+	Tournaments   []*exportedTournament
+}
+
+//exportedGame is a game the user is seated in. It only includes what the user
+//could already see about the game, and the moves they made in it.
+type exportedGame struct {
+	Name     string
+	ID       string
+	Created  int64
+	Modified int64
+	Finished bool
+	Winners  []boardgame.PlayerIndex
+	//Seats are the seats in the game the user is in.
+	Seats []boardgame.PlayerIndex
+	//Owner is whether the user created the game.
+	Owner bool
+	//Moves are the moves proposed from the user's seats.
+	Moves []*boardgame.MoveStorageRecord
+}
+
+//exportedTournament is a tournament the user created or registered for.
+type exportedTournament struct {
+	ID         string
+	Name       string
+	GameName   string
+	Owner      bool
+	Registered bool
+}
+
+func (s *Server) exportUserDataHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	user := s.getUser(c)
+
+	s.doExportUserData(r, user)
+}
+
+func (s *Server) doExportUserData(r *renderer, user *users.StorageRecord) {
+
+	if user == nil {
+		r.Error(errors.NewFriendly("You must be signed in to export your data."))
+		return
+	}
+
+	export, err := s.calcUserDataExport(user)
+
+	if err != nil {
+		r.Error(errors.New("Couldn't export user data: " + err.Error()))
+		return
+	}
+
+	r.Success(gin.H{
+		"Export": export,
+	})
+}
+
+//calcUserDataExport gathers everything stored about user.
+func (s *Server) calcUserDataExport(user *users.StorageRecord) (*userDataExport, error) {
+
+	result := &userDataExport{
+		User:          user,
+		RatingHistory: s.storage.RatingHistory(user.ID, "", maxExportedRecords),
+	}
+
+	var games []*extendedgame.CombinedStorageRecord
+
+	for _, list := range []listing.Type{listing.ParticipatingActive, listing.ParticipatingFinished} {
+		games = append(games, s.storage.ListGames(maxExportedRecords, list, user.ID, "")...)
+	}
+
+	for _, game := range games {
+		exported, err := s.calcExportedGame(user, game)
+		if err != nil {
+			return nil, err
+		}
+		result.Games = append(result.Games, exported)
+	}
+
+	var gameNames []string
+
+	for name := range s.managers {
+		gameNames = append(gameNames, name)
+	}
+
+	sort.Strings(gameNames)
+
+	for _, name := range gameNames {
+		record, err := s.storage.Rating(user.ID, name)
+		if err != nil {
+			return nil, errors.New("Couldn't fetch rating: " + err.Error())
+		}
+		if record.NumGames == 0 {
+			//They've never played a rated game of this type, so this is just
+			//the default.
+			continue
+		}
+		result.Ratings = append(result.Ratings, record)
+	}
+
+	for _, tournament := range s.storage.ListTournaments(maxExportedRecords, "") {
+		exported := &exportedTournament{
+			ID:       tournament.ID,
+			Name:     tournament.Name,
+			GameName: tournament.GameName,
+			Owner:    tournament.Owner == user.ID,
+		}
+		for _, player := range tournament.Players {
+			if player == user.ID {
+				exported.Registered = true
+			}
+		}
+		if !exported.Owner && !exported.Registered {
+			continue
+		}
+		result.Tournaments = append(result.Tournaments, exported)
+	}
+
+	return result, nil
+}
+
+//calcExportedGame returns what user's export includes about game.
+func (s *Server) calcExportedGame(user *users.StorageRecord, game *extendedgame.CombinedStorageRecord) (*exportedGame, error) {
+
+	result := &exportedGame{
+		Name:     game.Name,
+		ID:       game.ID,
+		Created:  game.Created.UnixNano(),
+		Modified: game.Modified.UnixNano(),
+		Finished: game.Finished,
+		Winners:  game.Winners,
+		Owner:    game.Owner == user.ID,
+	}
+
+	for i, userID := range s.storage.UserIDsForGame(game.ID) {
+		if userID == user.ID {
+			result.Seats = append(result.Seats, boardgame.PlayerIndex(i))
+		}
+	}
+
+	if len(result.Seats) == 0 {
+		return result, nil
+	}
+
+	moves, err := s.storage.Moves(game.ID, 0, game.Version)
+
+	if err != nil {
+		return nil, errors.New("Couldn't fetch moves for game " + game.ID + ": " + err.Error())
+	}
+
+	for _, move := range moves {
+		for _, seat := range result.Seats {
+			if move.Proposer == seat {
+				result.Moves = append(result.Moves, move)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Server) deleteUserHandler(c *gin.Context) {
+	r := s.newRenderer(c)
+
+	user := s.getUser(c)
+
+	confirm, _ := s.getRequestOptionalFlag(c, qryConfirm)
+
+	s.doDeleteUser(r, user, confirm)
+}
+
+//doDeleteUser erases user and signs them out. Games and tournaments they
+//played in stay, but are no longer tied to them.
+func (s *Server) doDeleteUser(r *renderer, user *users.StorageRecord, confirm bool) {
+
+	if user == nil {
+		r.Error(errors.NewFriendly("You must be signed in to delete your account."))
+		return
+	}
+
+	if !confirm {
+		r.Error(errors.NewFriendly("Deleting your account can't be undone. Confirm that you want to delete it."))
+		return
+	}
+
+	//DeleteUser rewrites tournaments, so hold the lock updateTournament
+	//uses to keep it from saving over the change.
+	s.tournamentsLock.Lock()
+	err := s.storage.DeleteUser(user.ID)
+	s.tournamentsLock.Unlock()
+
+	if err != nil {
+		r.Error(errors.New("Couldn't delete user: " + err.Error()))
+		return
+	}
+
+	//DeleteUser removed every cookie for the user already.
+	r.SetAuthCookie("")
+
+	r.Success(gin.H{
+		"Message": "Your account was deleted.",
+	})
+}
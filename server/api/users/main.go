@@ -3,6 +3,11 @@ package users
 //Factored into a sub-package so we don't get a cycle of dependencies between
 //bolt and user db.
 
+//DeletedUserID is the user ID that takes the place of a deleted user in the
+//seats they were in and the games they owned, so those games stay intact
+//without identifying them.
+const DeletedUserID = "deleted-user"
+
 //StorageRecord denotes the storage record with info about a user.
 type StorageRecord struct {
 	//The Firebase user id
@@ -12,6 +17,14 @@ type StorageRecord struct {
 	DisplayName string
 	PhotoURL    string
 	Email       string
+	//NotifyByEmail is whether the user wants to be emailed when it becomes
+	//their turn in a game they aren't currently watching.
+	NotifyByEmail bool
+	//NotifyWebhookURL, if not "", is a URL that will be POSTed to when it
+	//becomes the user's turn in a game they aren't currently watching.
+	NotifyWebhookURL string
+	//Banned users are treated as though they aren't signed in.
+	Banned bool
 }
 
 //EffectiveDisplayName returns a display name based on values in the
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/webhooks"
+)
+
+//AddWebhook registers an endpoint that will be sent game lifecycle events for
+//games of type gameName (or every game type, if gameName is
+//webhooks.AllGames). Webhooks configured in the webhooks section of config
+//are added automatically in Start(); this is for adding custom ones. We
+//return a reference to ourself to allow chaining.
+func (s *Server) AddWebhook(gameName string, endpoint *webhooks.Endpoint) *Server {
+	s.webhooks.AddEndpoint(gameName, endpoint)
+	return s
+}
+
+//configureWebhooks adds the webhooks described in config. Called by Start()
+//once config is loaded.
+func (s *Server) configureWebhooks() {
+	for gameName, endpoints := range s.config.Webhooks {
+		for _, endpoint := range endpoints {
+			if endpoint == nil {
+				continue
+			}
+			var events []webhooks.EventType
+			for _, event := range endpoint.Events {
+				events = append(events, webhooks.EventType(event))
+			}
+			s.webhooks.AddEndpoint(gameName, &webhooks.Endpoint{
+				URL:    endpoint.URL,
+				Secret: endpoint.Secret,
+				Events: events,
+			})
+		}
+	}
+}
+
+//dispatchGameCreated sends the GameCreated event for a game that was just
+//created by owner.
+func (s *Server) dispatchGameCreated(game *boardgame.Game, ownerID string) {
+	s.webhooks.Dispatch(&webhooks.Event{
+		Type:     webhooks.GameCreated,
+		GameName: game.Name(),
+		GameID:   game.ID(),
+		Version:  game.Version(),
+		UserID:   ownerID,
+	})
+}
+
+//dispatchPlayerSeated sends the PlayerSeated event for a user who was just
+//assigned the given seat.
+func (s *Server) dispatchPlayerSeated(game *boardgame.Game, slot boardgame.PlayerIndex, userID string) {
+	s.webhooks.Dispatch(&webhooks.Event{
+		Type:        webhooks.PlayerSeated,
+		GameName:    game.Name(),
+		GameID:      game.ID(),
+		Version:     game.Version(),
+		PlayerIndex: int(slot),
+		UserID:      userID,
+	})
+}
+
+//dispatchMoveApplied is called after every player move. It sends the
+//MoveApplied event and, if the game just finished, the GameFinished event.
+func (s *Server) dispatchMoveApplied(game *boardgame.GameStorageRecord) {
+
+	if s.webhooks.HasEndpoints(game.Name, webhooks.MoveApplied) {
+		playerMove, err := s.storage.initiatingMove(game)
+		if err != nil {
+			s.logger.Errorln("Couldn't fetch initiating move for webhook: " + err.Error())
+		} else {
+			s.webhooks.Dispatch(&webhooks.Event{
+				Type:        webhooks.MoveApplied,
+				GameName:    game.Name,
+				GameID:      game.ID,
+				Version:     game.Version,
+				PlayerIndex: int(playerMove.Proposer),
+				MoveName:    playerMove.Name,
+			})
+		}
+	}
+
+	if !game.Finished {
+		return
+	}
+
+	s.dispatchGameFinished(game)
+}
+
+//dispatchGameFinished sends the GameFinished event for a game that just
+//finished, whether by a move or because an admin ended it.
+func (s *Server) dispatchGameFinished(game *boardgame.GameStorageRecord) {
+
+	winners := make([]int, len(game.Winners))
+	for i, winner := range game.Winners {
+		winners[i] = int(winner)
+	}
+
+	s.webhooks.Dispatch(&webhooks.Event{
+		Type:     webhooks.GameFinished,
+		GameName: game.Name,
+		GameID:   game.ID,
+		Version:  game.Version,
+		Winners:  winners,
+	})
+}
@@ -0,0 +1,280 @@
+/*
+
+Package webhooks delivers signed notifications about game lifecycle events (a
+game being created, a player being seated, a move being applied, a game
+finishing) to external HTTP endpoints. It's separate from the api package so
+that it can be tested without a running Server.
+
+Every delivery is a POST of the JSON-serialized Event. The body is signed with
+HMAC-SHA256 using the endpoint's secret, and the hex-encoded signature is sent
+in the SignatureHeader header prefixed with "sha256=". Receivers should
+recompute the signature over the raw body and compare it with
+hmac.Equal before trusting the payload.
+
+Failed deliveries (network errors or non-2xx responses) are retried with
+exponential backoff.
+
+*/
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//SignatureHeader is the HTTP header the body's signature is sent in.
+const SignatureHeader = "X-Boardgame-Signature"
+
+//EventHeader is the HTTP header the event type is sent in, so receivers can
+//route without parsing the body.
+const EventHeader = "X-Boardgame-Event"
+
+//AllGames is the game name to register an endpoint under to receive events
+//for every game type.
+const AllGames = "*"
+
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultTimeout        = 10 * time.Second
+)
+
+//EventType is the kind of lifecycle event.
+type EventType string
+
+const (
+	//GameCreated fires when a new game is created via the server.
+	GameCreated EventType = "game_created"
+	//PlayerSeated fires when a user is assigned a seat in a game.
+	PlayerSeated EventType = "player_seated"
+	//MoveApplied fires after each player move (and its fix up moves) is
+	//applied.
+	MoveApplied EventType = "move_applied"
+	//GameFinished fires when a game becomes finished.
+	GameFinished EventType = "game_finished"
+)
+
+//Event is the payload that is delivered to endpoints.
+type Event struct {
+	Type     EventType
+	GameName string
+	GameID   string
+	//Version is the version of the game after the event.
+	Version int
+	//PlayerIndex is the seat involved in the event, for PlayerSeated and
+	//MoveApplied. Always sent, since 0 is a seat.
+	PlayerIndex int
+	//UserID is the user involved in the event, for GameCreated (the owner)
+	//and PlayerSeated.
+	UserID string `json:",omitempty"`
+	//MoveName is the name of the player move that was applied, for
+	//MoveApplied.
+	MoveName string `json:",omitempty"`
+	//Winners is the list of winning seats, for GameFinished. It's empty for
+	//a draw, and null for other events.
+	Winners   []int
+	Timestamp int64
+}
+
+//Endpoint is a single URL that wants to receive events.
+type Endpoint struct {
+	URL string
+	//Secret is the key used to sign the payload. If "", deliveries are not
+	//signed.
+	Secret string
+	//Events is the list of event types to deliver. If empty, all events are
+	//delivered.
+	Events []EventType
+}
+
+//Dispatcher keeps track of which endpoints want which events, and delivers
+//them. Get one from NewDispatcher.
+type Dispatcher struct {
+	//MaxAttempts is how many times a delivery will be tried before giving
+	//up.
+	MaxAttempts int
+	//InitialBackoff is how long to wait before the first retry. Each
+	//subsequent retry waits twice as long as the one before.
+	InitialBackoff time.Duration
+
+	client    *http.Client
+	logger    *logrus.Logger
+	endpoints map[string][]*Endpoint
+	lock      sync.RWMutex
+}
+
+//NewDispatcher returns a new Dispatcher with no endpoints. If client is nil a
+//client with a reasonable timeout is used. If logger is nil failed
+//deliveries are not logged.
+func NewDispatcher(client *http.Client, logger *logrus.Logger) *Dispatcher {
+	if client == nil {
+		client = &http.Client{
+			Timeout: defaultTimeout,
+		}
+	}
+	return &Dispatcher{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		client:         client,
+		logger:         logger,
+		endpoints:      make(map[string][]*Endpoint),
+	}
+}
+
+//Sign returns the hex-encoded HMAC-SHA256 of body using secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+//Wants returns true if the endpoint is configured to receive the given type
+//of event.
+func (e *Endpoint) Wants(eventType EventType) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, wanted := range e.Events {
+		if wanted == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+//AddEndpoint registers endpoint to receive events for games of type gameName
+//(or every game type, if gameName is AllGames).
+func (d *Dispatcher) AddEndpoint(gameName string, endpoint *Endpoint) {
+	if endpoint == nil || endpoint.URL == "" {
+		return
+	}
+	d.lock.Lock()
+	d.endpoints[gameName] = append(d.endpoints[gameName], endpoint)
+	d.lock.Unlock()
+}
+
+//Endpoints returns the endpoints that want the given event type for the given
+//game type.
+func (d *Dispatcher) Endpoints(gameName string, eventType EventType) []*Endpoint {
+	var result []*Endpoint
+
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	for _, key := range []string{gameName, AllGames} {
+		for _, endpoint := range d.endpoints[key] {
+			if endpoint.Wants(eventType) {
+				result = append(result, endpoint)
+			}
+		}
+		if gameName == AllGames {
+			break
+		}
+	}
+
+	return result
+}
+
+//HasEndpoints returns true if any endpoint wants the given event for the
+//given game type. Useful to skip expensive work constructing an Event that no
+//one will receive.
+func (d *Dispatcher) HasEndpoints(gameName string, eventType EventType) bool {
+	return len(d.Endpoints(gameName, eventType)) > 0
+}
+
+//Dispatch delivers event to every endpoint that wants it. Delivery happens
+//in the background; Dispatch never blocks on the network.
+func (d *Dispatcher) Dispatch(event *Event) {
+	if event == nil {
+		return
+	}
+
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixNano()
+	}
+
+	for _, endpoint := range d.Endpoints(event.GameName, event.Type) {
+		go func(endpoint *Endpoint) {
+			if err := d.Send(endpoint, event); err != nil && d.logger != nil {
+				d.logger.WithFields(logrus.Fields{
+					"URL":    endpoint.URL,
+					"Event":  event.Type,
+					"GameID": event.GameID,
+				}).Errorln("Webhook delivery failed: " + err.Error())
+			}
+		}(endpoint)
+	}
+}
+
+//Send synchronously delivers event to endpoint, retrying with exponential
+//backoff up to MaxAttempts times. Returns the last error if every attempt
+//failed.
+func (d *Dispatcher) Send(endpoint *Endpoint, event *Event) error {
+	if endpoint == nil {
+		return errors.New("no endpoint provided")
+	}
+
+	blob, err := json.Marshal(event)
+
+	if err != nil {
+		return errors.New("couldn't marshal event: " + err.Error())
+	}
+
+	attempts := d.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	backoff := d.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err = d.post(endpoint, event.Type, blob)
+		if err == nil {
+			return nil
+		}
+		if attempt >= attempts {
+			return errors.New("gave up after " + strconv.Itoa(attempt) + " attempts: " + err.Error())
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (d *Dispatcher) post(endpoint *Endpoint, eventType EventType, blob []byte) error {
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewReader(blob))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(eventType))
+
+	if endpoint.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(endpoint.Secret, blob))
+	}
+
+	resp, err := d.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.New("endpoint returned status " + strconv.Itoa(resp.StatusCode))
+	}
+
+	return nil
+}
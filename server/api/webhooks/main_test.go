@@ -0,0 +1,165 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/workfit/tester/assert"
+)
+
+func TestSign(t *testing.T) {
+	//Known HMAC-SHA256 test vector from RFC 4231, test case 2.
+	assert.For(t).ThatActual(Sign("Jefe", []byte("what do ya want for nothing?"))).Equals("5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843")
+}
+
+func TestEndpoints(t *testing.T) {
+	d := NewDispatcher(nil, nil)
+
+	all := &Endpoint{URL: "http://all"}
+	finished := &Endpoint{URL: "http://finished", Events: []EventType{GameFinished}}
+	other := &Endpoint{URL: "http://other"}
+
+	d.AddEndpoint(AllGames, all)
+	d.AddEndpoint("tictactoe", finished)
+	d.AddEndpoint("blackjack", other)
+	d.AddEndpoint("tictactoe", nil)
+
+	assert.For(t).ThatActual(d.Endpoints("tictactoe", GameFinished)).Equals([]*Endpoint{finished, all})
+	assert.For(t).ThatActual(d.Endpoints("tictactoe", MoveApplied)).Equals([]*Endpoint{all})
+	assert.For(t).ThatActual(d.Endpoints("blackjack", MoveApplied)).Equals([]*Endpoint{other, all})
+	assert.For(t).ThatActual(d.HasEndpoints("memory", GameCreated)).IsTrue()
+}
+
+func TestSendRetries(t *testing.T) {
+
+	var lock sync.Mutex
+	attempts := 0
+	var signature string
+	var eventHeader string
+	var received Event
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+		eventHeader = r.Header.Get(EventHeader)
+		json.Unmarshal(body, &received)
+		if signature != "sha256="+Sign("secret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil)
+	d.InitialBackoff = time.Millisecond
+
+	event := &Event{
+		Type:     GameFinished,
+		GameName: "tictactoe",
+		GameID:   "ABC",
+		Version:  7,
+		Winners:  []int{1},
+	}
+
+	err := d.Send(&Endpoint{URL: server.URL, Secret: "secret"}, event)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(attempts).Equals(3)
+	assert.For(t).ThatActual(eventHeader).Equals(string(GameFinished))
+	assert.For(t).ThatActual(received).Equals(*event)
+
+	d.MaxAttempts = 2
+	attempts = -10
+
+	err = d.Send(&Endpoint{URL: server.URL, Secret: "secret"}, event)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+	assert.For(t).ThatActual(attempts).Equals(-8)
+}
+
+func TestDispatch(t *testing.T) {
+
+	delivered := make(chan EventType, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- EventType(r.Header.Get(EventHeader))
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil)
+	d.AddEndpoint("tictactoe", &Endpoint{URL: server.URL, Events: []EventType{GameCreated}})
+
+	d.Dispatch(&Event{Type: MoveApplied, GameName: "tictactoe"})
+	d.Dispatch(&Event{Type: GameCreated, GameName: "blackjack"})
+	d.Dispatch(&Event{Type: GameCreated, GameName: "tictactoe"})
+
+	select {
+	case eventType := <-delivered:
+		assert.For(t).ThatActual(eventType).Equals(GameCreated)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Event was never delivered")
+	}
+
+	select {
+	case eventType := <-delivered:
+		t.Error("Unexpected extra delivery", eventType)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSeatZeroPayload(t *testing.T) {
+
+	bodies := make(chan []byte, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil)
+
+	err := d.Send(&Endpoint{URL: server.URL}, &Event{
+		Type:        PlayerSeated,
+		GameName:    "tictactoe",
+		GameID:      "ABC",
+		PlayerIndex: 0,
+		UserID:      "Foo",
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	var payload map[string]interface{}
+	assert.For(t).ThatActual(json.Unmarshal(<-bodies, &payload)).IsNil()
+
+	playerIndex, ok := payload["PlayerIndex"]
+	assert.For(t).ThatActual(ok).IsTrue()
+	assert.For(t).ThatActual(playerIndex).Equals(float64(0))
+
+	err = d.Send(&Endpoint{URL: server.URL}, &Event{
+		Type:     GameFinished,
+		GameName: "tictactoe",
+		GameID:   "ABC",
+		Winners:  []int{},
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	payload = nil
+	assert.For(t).ThatActual(json.Unmarshal(<-bodies, &payload)).IsNil()
+
+	winners, ok := payload["Winners"]
+	assert.For(t).ThatActual(ok).IsTrue()
+	assert.For(t).ThatActual(winners).Equals([]interface{}{})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/jkomoros/boardgame"
 	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 )
 
 const (
@@ -24,17 +25,52 @@ type gameVersionChanged struct {
 	Version int
 }
 
+//userConnectedQuery is how other goroutines ask the workLoop whether a given
+//user has a socket open for a given game. The answer is sent on result.
+type userConnectedQuery struct {
+	gameID string
+	userID string
+	result chan bool
+}
+
+//userSocketCountQuery is how other goroutines ask the workLoop how many
+//sockets a given user has open across all games. The answer is sent on
+//result.
+type userSocketCountQuery struct {
+	userID string
+	result chan int
+}
+
+//gameWatcher is a non-socket listener for a game's version changes, like a
+//subscription in a Frontend. See Server.WatchGame.
+type gameWatcher struct {
+	gameID string
+	//versions has a buffer of one; if the watcher hasn't received the last
+	//version yet it's replaced with the newer one.
+	versions chan int
+}
+
 type versionNotifier struct {
 	sockets       map[string]map[*socket]bool
+	watchers      map[string]map[*gameWatcher]bool
 	register      chan *socket
 	unregister    chan *socket
+	watch         chan *gameWatcher
+	unwatch       chan *gameWatcher
 	notifyVersion chan gameVersionChanged
+	userConnected chan userConnectedQuery
+	socketCount   chan userSocketCountQuery
 	doneChan      chan bool
 	server        *Server
 }
 
 type socket struct {
-	gameID   string
+	//gameID is the ID of the game the socket is watching, or the
+	//tournamentSocketKey of the tournament it's watching.
+	gameID string
+	//userID is the user who opened the socket, or "" if they weren't signed
+	//in.
+	userID   string
 	notifier *versionNotifier
 	conn     *websocket.Conn
 	send     chan []byte
@@ -62,6 +98,17 @@ func (s *Server) socketHandler(c *gin.Context) {
 		return
 	}
 
+	var userID string
+
+	if user := s.getUser(c); user != nil {
+		userID = user.ID
+	}
+
+	if err := s.calcSocketAllowed(userID); err != nil {
+		renderer.Error(err)
+		return
+	}
+
 	conn, err := s.upgrader.Upgrade(c.Writer, c.Request, nil)
 
 	if err != nil {
@@ -69,17 +116,20 @@ func (s *Server) socketHandler(c *gin.Context) {
 		return
 	}
 
-	socket := newSocket(game, conn, s.notifier)
+	socket := newSocket(game.ID(), game.Version(), userID, conn, s.notifier)
 	s.notifier.register <- socket
 
 }
 
-func newSocket(game *boardgame.Game, conn *websocket.Conn, notifier *versionNotifier) *socket {
+//newSocket returns a socket that will be sent the version of whatever id
+//refers to every time it changes, starting with version.
+func newSocket(id string, version int, userID string, conn *websocket.Conn, notifier *versionNotifier) *socket {
 	result := &socket{
 		notifier: notifier,
 		conn:     conn,
 		send:     make(chan []byte, 256),
-		gameID:   game.ID(),
+		gameID:   id,
+		userID:   userID,
 	}
 	go result.readPump()
 	go result.writePump()
@@ -87,8 +137,8 @@ func newSocket(game *boardgame.Game, conn *websocket.Conn, notifier *versionNoti
 	//As soon as the socke tis opened, send the current version. That way if
 	//the connection broke right when the version changed, we'll still catch up.
 	result.SendMessage(gameVersionChanged{
-		ID:      game.ID(),
-		Version: game.Version(),
+		ID:      id,
+		Version: version,
 	})
 
 	return result
@@ -155,6 +205,22 @@ func (s *socket) writePump() {
 
 }
 
+//send delivers version to the watcher without blocking, replacing the
+//previous version if it hasn't been received yet. Only called from the
+//notifier's workLoop.
+func (w *gameWatcher) send(version int) {
+	select {
+	case w.versions <- version:
+		return
+	default:
+	}
+	select {
+	case <-w.versions:
+	default:
+	}
+	w.versions <- version
+}
+
 func (s *socket) SendMessage(message gameVersionChanged) {
 	s.send <- []byte(strconv.Itoa(message.Version))
 }
@@ -162,9 +228,14 @@ func (s *socket) SendMessage(message gameVersionChanged) {
 func newVersionNotifier(s *Server) *versionNotifier {
 	result := &versionNotifier{
 		sockets:       make(map[string]map[*socket]bool),
+		watchers:      make(map[string]map[*gameWatcher]bool),
 		register:      make(chan *socket),
 		unregister:    make(chan *socket),
+		watch:         make(chan *gameWatcher),
+		unwatch:       make(chan *gameWatcher),
 		notifyVersion: make(chan gameVersionChanged),
+		userConnected: make(chan userConnectedQuery),
+		socketCount:   make(chan userSocketCountQuery),
 		doneChan:      make(chan bool),
 		server:        s,
 	}
@@ -179,6 +250,38 @@ func (v *versionNotifier) gameChanged(game *boardgame.GameStorageRecord) {
 	}
 }
 
+//tournamentChanged notifies sockets watching the given tournament that it
+//has changed.
+func (v *versionNotifier) tournamentChanged(tournament *tournaments.StorageRecord) {
+	v.notifyVersion <- gameVersionChanged{
+		ID:      tournamentSocketKey(tournament.ID),
+		Version: tournament.Version,
+	}
+}
+
+//userHasSocket returns true if the given user currently has a socket open for
+//the given game. Safe to call from any goroutine.
+func (v *versionNotifier) userHasSocket(gameID string, userID string) bool {
+	query := userConnectedQuery{
+		gameID: gameID,
+		userID: userID,
+		result: make(chan bool, 1),
+	}
+	v.userConnected <- query
+	return <-query.result
+}
+
+//userSocketCount returns how many sockets the given user currently has open,
+//across all games. Safe to call from any goroutine.
+func (v *versionNotifier) userSocketCount(userID string) int {
+	query := userSocketCountQuery{
+		userID: userID,
+		result: make(chan int, 1),
+	}
+	v.socketCount <- query
+	return <-query.result
+}
+
 func (v *versionNotifier) done() {
 	close(v.doneChan)
 }
@@ -190,6 +293,18 @@ func (v *versionNotifier) workLoop() {
 			v.registerSocket(s)
 		case s := <-v.unregister:
 			v.unregisterSocket(s)
+		case w := <-v.watch:
+			bucket, ok := v.watchers[w.gameID]
+			if !ok {
+				bucket = make(map[*gameWatcher]bool)
+				v.watchers[w.gameID] = bucket
+			}
+			bucket[w] = true
+		case w := <-v.unwatch:
+			delete(v.watchers[w.gameID], w)
+			if len(v.watchers[w.gameID]) == 0 {
+				delete(v.watchers, w.gameID)
+			}
 		case rec := <-v.notifyVersion:
 			v.server.logger.Debugln("Sending socket message", logrus.Fields{
 				"ID":      rec.ID,
@@ -203,6 +318,28 @@ func (v *versionNotifier) workLoop() {
 					socket.SendMessage(rec)
 				}
 			}
+			for watcher := range v.watchers[rec.ID] {
+				watcher.send(rec.Version)
+			}
+		case query := <-v.userConnected:
+			connected := false
+			for socket := range v.sockets[query.gameID] {
+				if socket.userID != "" && socket.userID == query.userID {
+					connected = true
+					break
+				}
+			}
+			query.result <- connected
+		case query := <-v.socketCount:
+			count := 0
+			for _, bucket := range v.sockets {
+				for socket := range bucket {
+					if socket.userID == query.userID {
+						count++
+					}
+				}
+			}
+			query.result <- count
 		case <-v.doneChan:
 			break
 		}
@@ -223,6 +360,10 @@ func (v *versionNotifier) registerSocket(s *socket) {
 		v.sockets[s.gameID] = bucket
 	}
 
+	if !bucket[s] {
+		metricOpenSockets.Inc()
+	}
+
 	bucket[s] = true
 }
 
@@ -235,9 +376,11 @@ func (v *versionNotifier) unregisterSocket(s *socket) {
 
 	bucket, ok := v.sockets[s.gameID]
 
-	if !ok {
+	if !ok || !bucket[s] {
 		return
 	}
 
 	delete(bucket, s)
+
+	metricOpenSockets.Dec()
 }
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/jkomoros/boardgame/server/api"
+	"github.com/jkomoros/boardgame/server/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func gameListings(games []*api.GameListing) []*pb.GameListing {
+	result := make([]*pb.GameListing, len(games))
+
+	for i, game := range games {
+
+		listing := &pb.GameListing{
+			Name:        game.Name,
+			Id:          game.ID,
+			Version:     int64(game.Version),
+			NumPlayers:  int32(game.NumPlayers),
+			Finished:    game.Finished,
+			Open:        game.Open,
+			Visible:     game.Visible,
+			Owner:       game.Owner,
+			HasPassword: game.HasPassword,
+			Modified:    game.Modified.UnixNano(),
+		}
+
+		for _, winner := range game.Winners {
+			listing.Winners = append(listing.Winners, int32(winner))
+		}
+
+		for _, player := range game.Players {
+			listing.Players = append(listing.Players, &pb.Player{
+				DisplayName: player.DisplayName,
+				IsAgent:     player.IsAgent,
+				IsEmpty:     player.IsEmpty,
+				PhotoUrl:    player.PhotoURL,
+			})
+		}
+
+		result[i] = listing
+	}
+
+	return result
+}
+
+func moveForms(forms []*api.MoveForm) []*pb.MoveForm {
+	result := make([]*pb.MoveForm, len(forms))
+
+	for i, form := range forms {
+
+		pbForm := &pb.MoveForm{
+			Name:                form.Name,
+			HelpText:            form.HelpText,
+			LegalForPlayer:      form.LegalForPlayer,
+			LegalForPlayerError: form.LegalForPlayerError,
+			LegalForAnyone:      form.LegalForAnyone,
+		}
+
+		for _, field := range form.Fields {
+			//If the default value can't be serialized, leave it out; it's
+			//only a hint.
+			defaultValue, _ := json.Marshal(field.DefaultValue)
+			pbForm.Fields = append(pbForm.Fields, &pb.MoveFormField{
+				Name:             field.Name,
+				Type:             field.Type.String(),
+				EnumName:         field.EnumName,
+				DefaultValueJson: defaultValue,
+			})
+		}
+
+		result[i] = pbForm
+	}
+
+	return result
+}
+
+//fieldValues converts typed field values to the strings the move endpoint
+//expects in its form.
+func fieldValues(fields []*pb.MoveFieldValue) (map[string]string, error) {
+	result := make(map[string]string, len(fields))
+
+	for _, field := range fields {
+		switch value := field.Value.(type) {
+		case *pb.MoveFieldValue_IntValue:
+			result[field.Name] = strconv.FormatInt(value.IntValue, 10)
+		case *pb.MoveFieldValue_BoolValue:
+			if value.BoolValue {
+				result[field.Name] = "1"
+			} else {
+				result[field.Name] = "0"
+			}
+		case *pb.MoveFieldValue_EnumValue:
+			result[field.Name] = value.EnumValue
+		case *pb.MoveFieldValue_PlayerIndexValue:
+			result[field.Name] = strconv.Itoa(int(value.PlayerIndexValue))
+		case *pb.MoveFieldValue_IntSliceValue:
+			nums := make([]string, len(value.IntSliceValue.GetValues()))
+			for i, num := range value.IntSliceValue.GetValues() {
+				nums[i] = strconv.FormatInt(num, 10)
+			}
+			result[field.Name] = strings.Join(nums, ",")
+		default:
+			return nil, status.Error(codes.InvalidArgument, "Field "+field.Name+" has no value")
+		}
+	}
+
+	return result, nil
+}
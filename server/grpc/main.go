@@ -0,0 +1,407 @@
+/*
+
+Package grpc is an optional gRPC service that can be served alongside the REST
+endpoints of server/api, for clients that would rather have a typed API and
+streaming updates than poll JSON endpoints. It shares its auth, storage and
+game managers with the api.Server it's added to.
+
+The service is described in pb/boardgame.proto, which can be used to generate
+clients in other languages. Calls are authenticated with the same auth cookie
+the REST endpoints use, passed in the CookieMetadataKey metadata key, and
+unary calls count against the same per-IP and per-user rate limits.
+
+To serve it, add a Frontend to your server and configure the port in the grpc
+section of your config:
+
+	func main() {
+		storage := api.NewServerStorageManager(bolt.NewStorageManager(".database"))
+		defer storage.Close()
+		api.NewServer(storage, mygame.NewDelegate()).AddFrontend(grpc.NewFrontend()).Start()
+	}
+
+*/
+package grpc
+
+//go:generate protoc -I pb --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative boardgame.proto
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/server/api"
+	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/jkomoros/boardgame/server/grpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+//CookieMetadataKey is the metadata key calls pass the auth cookie in.
+const CookieMetadataKey = "boardgame-cookie"
+
+//maxListedGames is how many games are returned in each list by ListGames.
+const maxListedGames = 100
+
+//forwardedForMetadataKey is the metadata key a reverse proxy passes the
+//client's address in.
+const forwardedForMetadataKey = "x-forwarded-for"
+
+//userContextKey is the context key UnaryInterceptor stores the signed in
+//user under, so each call only looks them up once.
+type userContextKey struct{}
+
+//Backend is what the Service needs from the server it's serving alongside.
+//*api.Server implements it.
+type Backend interface {
+	UserForCookie(cookie string) *users.StorageRecord
+	AllowRequest(ip string, user *users.StorageRecord) *errors.Friendly
+	ListGames(max int, list listing.Type, user *users.StorageRecord, gameName string) []*api.GameListing
+	NewGame(owner *users.StorageRecord, gameName string, numPlayers int, agents []string, open, visible bool, variant map[string]string) (*boardgame.Game, *errors.Friendly)
+	Game(gameName, gameID string) *boardgame.Game
+	ViewingAsPlayer(user *users.StorageRecord, game *boardgame.Game) boardgame.PlayerIndex
+	JoinGame(user *users.StorageRecord, game *boardgame.Game, inviteToken string, password string) (boardgame.PlayerIndex, *errors.Friendly)
+	MoveForms(game *boardgame.Game, state boardgame.ImmutableState, player boardgame.PlayerIndex) []*api.MoveForm
+	ProposeMove(user *users.StorageRecord, game *boardgame.Game, moveName string, values map[string]string) *errors.Friendly
+	WatchGame(gameID string) (<-chan int, func())
+}
+
+//Frontend is an api.Frontend that serves the Service on the port configured
+//in the grpc section of the server's config.
+type Frontend struct {
+	options []grpc.ServerOption
+}
+
+//NewFrontend returns a Frontend whose gRPC server is created with the given
+//options.
+func NewFrontend(options ...grpc.ServerOption) *Frontend {
+	return &Frontend{
+		options: options,
+	}
+}
+
+//Start serves the Service until the listener fails. Does nothing if the
+//server's config has no grpc port.
+func (f *Frontend) Start(s *api.Server) error {
+	config := s.Config().GRPC
+
+	if config == nil || config.Port == "" {
+		s.Logger().Infoln("No grpc port configured; not serving gRPC")
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", ":"+config.Port)
+
+	if err != nil {
+		return errors.New("Couldn't listen for gRPC: " + err.Error())
+	}
+
+	service := NewService(s)
+
+	if rateLimit := s.Config().RateLimit; rateLimit != nil {
+		service.behindProxy = rateLimit.BehindProxy
+	}
+
+	options := append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(service.UnaryInterceptor)}, f.options...)
+
+	server := grpc.NewServer(options...)
+	pb.RegisterBoardgameServer(server, service)
+
+	s.Logger().Infoln("Serving gRPC on port " + config.Port)
+
+	return server.Serve(listener)
+}
+
+//Service implements pb.BoardgameServer on top of a Backend. If you serve it
+//yourself instead of via Frontend, install UnaryInterceptor so that calls
+//are rate limited.
+type Service struct {
+	pb.UnimplementedBoardgameServer
+	backend Backend
+	//behindProxy is whether to trust the client address a reverse proxy
+	//passes in forwardedForMetadataKey.
+	behindProxy bool
+}
+
+//NewService returns a Service backed by backend.
+func NewService(backend Backend) *Service {
+	return &Service{
+		backend: backend,
+	}
+}
+
+//UnaryInterceptor is a grpc.UnaryServerInterceptor that rejects calls from
+//clients or users who have made too many requests recently, like the REST
+//endpoints' rate limits, before the call reaches the Backend.
+func (s *Service) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	user := s.user(ctx)
+
+	if fErr := s.backend.AllowRequest(s.clientIP(ctx), user); fErr != nil {
+		return nil, status.Error(codes.ResourceExhausted, fErr.FriendlyError())
+	}
+
+	return handler(context.WithValue(ctx, userContextKey{}, user), req)
+}
+
+//clientIP returns the address of the client that made the call in ctx.
+func (s *Service) clientIP(ctx context.Context) string {
+	if s.behindProxy {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md.Get(forwardedForMetadataKey); len(forwarded) > 0 && forwarded[0] != "" {
+				return strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	addr := p.Addr.String()
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
+//user returns the user whose cookie is in ctx's metadata, or nil if there
+//isn't one.
+func (s *Service) user(ctx context.Context) *users.StorageRecord {
+	if user, ok := ctx.Value(userContextKey{}).(*users.StorageRecord); ok {
+		return user
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	cookies := md.Get(CookieMetadataKey)
+	if len(cookies) == 0 || cookies[0] == "" {
+		return nil
+	}
+	return s.backend.UserForCookie(cookies[0])
+}
+
+//requireUser is like user, but returns an Unauthenticated error if there's
+//no user.
+func (s *Service) requireUser(ctx context.Context) (*users.StorageRecord, error) {
+	user := s.user(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "Not logged in")
+	}
+	return user, nil
+}
+
+func (s *Service) game(gameName, gameID string) (*boardgame.Game, error) {
+	game := s.backend.Game(gameName, gameID)
+	if game == nil {
+		return nil, status.Error(codes.NotFound, "No such game")
+	}
+	return game, nil
+}
+
+//statusError converts an error from the Backend to a gRPC status error.
+//Only the friendly message is shared with the client.
+func statusError(err *errors.Friendly) error {
+	return status.Error(codes.FailedPrecondition, err.FriendlyError())
+}
+
+//ListGames lists the games the signed in user can see.
+func (s *Service) ListGames(ctx context.Context, req *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	user := s.user(ctx)
+
+	list := func(list listing.Type) []*pb.GameListing {
+		return gameListings(s.backend.ListGames(maxListedGames, list, user, req.GameName))
+	}
+
+	return &pb.ListGamesResponse{
+		ParticipatingActive:   list(listing.ParticipatingActive),
+		ParticipatingFinished: list(listing.ParticipatingFinished),
+		VisibleJoinableActive: list(listing.VisibleJoinableActive),
+		VisibleActive:         list(listing.VisibleActive),
+	}, nil
+}
+
+//NewGame creates a new game owned by the signed in user.
+func (s *Service) NewGame(ctx context.Context, req *pb.NewGameRequest) (*pb.NewGameResponse, error) {
+	user, err := s.requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	game, fErr := s.backend.NewGame(user, req.GameName, int(req.NumPlayers), req.Agents, req.Open, req.Visible, req.Variant)
+
+	if fErr != nil {
+		return nil, statusError(fErr)
+	}
+
+	return &pb.NewGameResponse{
+		GameName: game.Name(),
+		GameId:   game.ID(),
+	}, nil
+}
+
+//JoinGame seats the signed in user in an empty seat of a game.
+func (s *Service) JoinGame(ctx context.Context, req *pb.JoinGameRequest) (*pb.JoinGameResponse, error) {
+	user, err := s.requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := s.game(req.GameName, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	seat, fErr := s.backend.JoinGame(user, game, req.Invite, req.Password)
+
+	if fErr != nil {
+		return nil, statusError(fErr)
+	}
+
+	return &pb.JoinGameResponse{
+		PlayerIndex: int32(seat),
+	}, nil
+}
+
+//ProposeMove proposes a move on behalf of the signed in user's seat.
+func (s *Service) ProposeMove(ctx context.Context, req *pb.ProposeMoveRequest) (*pb.ProposeMoveResponse, error) {
+	user, err := s.requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := s.game(req.GameName, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := fieldValues(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if fErr := s.backend.ProposeMove(user, game, req.MoveName, values); fErr != nil {
+		return nil, statusError(fErr)
+	}
+
+	return &pb.ProposeMoveResponse{}, nil
+}
+
+//Subscribe streams an update every time the game changes, until the client
+//goes away.
+func (s *Service) Subscribe(req *pb.SubscribeRequest, stream pb.Boardgame_SubscribeServer) error {
+	ctx := stream.Context()
+
+	user := s.user(ctx)
+
+	game, err := s.game(req.GameName, req.GameId)
+	if err != nil {
+		return err
+	}
+
+	//Start watching before looking at the current version, so we can't miss
+	//a change in between.
+	versions, stop := s.backend.WatchGame(game.ID())
+	defer stop()
+
+	lastSent := int(req.FromVersion)
+
+	if lastSent <= 0 {
+		lastSent = game.Version() - 1
+	}
+
+	sendUpTo := func(version int) error {
+		for ; lastSent < version; lastSent++ {
+			update, err := s.update(user, game, lastSent+1)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := sendUpTo(game.Version()); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case version := <-versions:
+			//game might be a read-only copy that doesn't know about the new
+			//version yet.
+			game.Refresh()
+			if err := sendUpTo(version); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+//update returns the update for the given version of game, sanitized for
+//whichever seat user is in now.
+func (s *Service) update(user *users.StorageRecord, game *boardgame.Game, version int) (*pb.GameUpdate, error) {
+
+	player := s.backend.ViewingAsPlayer(user, game)
+
+	state := game.State(version)
+
+	if state == nil {
+		return nil, status.Error(codes.Internal, "Couldn't load version "+strconv.Itoa(version))
+	}
+
+	gameJSON, err := game.JSONForPlayer(player, state)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Couldn't sanitize state: "+err.Error())
+	}
+
+	blob, err := json.Marshal(gameJSON)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Couldn't serialize state: "+err.Error())
+	}
+
+	result := &pb.GameUpdate{
+		Version:         int64(version),
+		ViewingAsPlayer: int32(player),
+		GameJson:        blob,
+		Forms:           moveForms(s.backend.MoveForms(game, state, player)),
+	}
+
+	if version > 0 {
+		move, err := game.Manager().Storage().Move(game.ID(), version)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Couldn't load move "+strconv.Itoa(version)+": "+err.Error())
+		}
+		move, err = game.MoveRecordForPlayer(player, move)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Couldn't sanitize move "+strconv.Itoa(version)+": "+err.Error())
+		}
+		result.Move = &pb.Move{
+			Name:      move.Name,
+			Version:   int64(move.Version),
+			Initiator: int64(move.Initiator),
+			Proposer:  int32(move.Proposer),
+			Timestamp: move.Timestamp.UnixNano(),
+			Blob:      move.Blob,
+		}
+	}
+
+	return result, nil
+}
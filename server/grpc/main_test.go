@@ -0,0 +1,366 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/errors"
+	"github.com/jkomoros/boardgame/examples/tictactoe"
+	"github.com/jkomoros/boardgame/server/api"
+	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratelimit"
+	"github.com/jkomoros/boardgame/server/api/users"
+	"github.com/jkomoros/boardgame/server/grpc/pb"
+	"github.com/jkomoros/boardgame/storage/memory"
+	"github.com/workfit/tester/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testCookie = "MYCOOKIE"
+
+//fakeBackend is a Backend over a bare tictactoe manager, where the user with
+//testCookie is always in seat 0.
+type fakeBackend struct {
+	manager  *boardgame.GameManager
+	user     *users.StorageRecord
+	versions chan int
+	//lastValues are the values passed to the last ProposeMove.
+	lastValues map[string]string
+	//limiter, if not nil, limits requests per user, or per ip if signed out.
+	limiter *ratelimit.Limiter
+}
+
+func newFakeBackend(t *testing.T) *fakeBackend {
+	manager, err := boardgame.NewGameManager(tictactoe.NewDelegate(), memory.NewStorageManager())
+	assert.For(t).ThatActual(err).IsNil()
+	return &fakeBackend{
+		manager:  manager,
+		user:     &users.StorageRecord{ID: "USER"},
+		versions: make(chan int, 1),
+	}
+}
+
+func (f *fakeBackend) UserForCookie(cookie string) *users.StorageRecord {
+	if cookie == testCookie {
+		return f.user
+	}
+	return nil
+}
+
+func (f *fakeBackend) AllowRequest(ip string, user *users.StorageRecord) *errors.Friendly {
+	key := ip
+	if user != nil {
+		key = user.ID
+	}
+	if !f.limiter.Allow(key) {
+		return errors.NewFriendly("Too many requests")
+	}
+	return nil
+}
+
+func (f *fakeBackend) ListGames(max int, list listing.Type, user *users.StorageRecord, gameName string) []*api.GameListing {
+	if list != listing.VisibleActive {
+		return nil
+	}
+	record := &extendedgame.CombinedStorageRecord{}
+	record.Name = "tictactoe"
+	record.ID = "GAMEID"
+	record.NumPlayers = 2
+	record.Winners = []boardgame.PlayerIndex{1}
+	record.Owner = "USER"
+	return []*api.GameListing{
+		{
+			CombinedStorageRecord: record,
+			Players: []*api.PlayerInfo{
+				{DisplayName: "Alice"},
+				{IsEmpty: true},
+			},
+			HasPassword: true,
+		},
+	}
+}
+
+func (f *fakeBackend) NewGame(owner *users.StorageRecord, gameName string, numPlayers int, agents []string, open, visible bool, variant map[string]string) (*boardgame.Game, *errors.Friendly) {
+	game, err := f.manager.NewDefaultGame()
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
+	return game, nil
+}
+
+func (f *fakeBackend) Game(gameName, gameID string) *boardgame.Game {
+	if gameName != f.manager.Delegate().Name() {
+		return nil
+	}
+	return f.manager.Game(gameID)
+}
+
+func (f *fakeBackend) ViewingAsPlayer(user *users.StorageRecord, game *boardgame.Game) boardgame.PlayerIndex {
+	if user == nil {
+		return boardgame.ObserverPlayerIndex
+	}
+	return 0
+}
+
+func (f *fakeBackend) JoinGame(user *users.StorageRecord, game *boardgame.Game, inviteToken string, password string) (boardgame.PlayerIndex, *errors.Friendly) {
+	if password != "secret" {
+		return 0, errors.NewFriendly("Wrong password")
+	}
+	return 1, nil
+}
+
+func (f *fakeBackend) MoveForms(game *boardgame.Game, state boardgame.ImmutableState, player boardgame.PlayerIndex) []*api.MoveForm {
+	return []*api.MoveForm{
+		{
+			Name: "Place Token",
+			Fields: []*api.MoveFormField{
+				{
+					Name:         "Slot",
+					Type:         boardgame.TypeInt,
+					DefaultValue: 3,
+				},
+			},
+			LegalForPlayer: player == 0,
+		},
+	}
+}
+
+func (f *fakeBackend) ProposeMove(user *users.StorageRecord, game *boardgame.Game, moveName string, values map[string]string) *errors.Friendly {
+	f.lastValues = values
+	move := game.MoveByName(moveName)
+	if move == nil {
+		return errors.NewFriendly("No such move")
+	}
+	slot, _ := strconv.Atoi(values["Slot"])
+	if err := move.ReadSetter().SetIntProp("Slot", slot); err != nil {
+		return errors.New(err.Error())
+	}
+	if err := <-game.ProposeMove(move, 0); err != nil {
+		return errors.New(err.Error())
+	}
+	return nil
+}
+
+func (f *fakeBackend) WatchGame(gameID string) (<-chan int, func()) {
+	return f.versions, func() {}
+}
+
+func newTestClient(t *testing.T, backend Backend) (pb.BoardgameClient, func()) {
+	listener := bufconn.Listen(1024 * 1024)
+
+	service := NewService(backend)
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(service.UnaryInterceptor))
+	pb.RegisterBoardgameServer(server, service)
+
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	return pb.NewBoardgameClient(conn), func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func signedIn() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), CookieMetadataKey, testCookie)
+}
+
+func TestListGames(t *testing.T) {
+	client, done := newTestClient(t, newFakeBackend(t))
+	defer done()
+
+	resp, err := client.ListGames(context.Background(), &pb.ListGamesRequest{})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(len(resp.ParticipatingActive)).Equals(0)
+	assert.For(t).ThatActual(len(resp.VisibleActive)).Equals(1)
+
+	game := resp.VisibleActive[0]
+
+	assert.For(t).ThatActual(game.Id).Equals("GAMEID")
+	assert.For(t).ThatActual(game.NumPlayers).Equals(int32(2))
+	assert.For(t).ThatActual(game.Winners).Equals([]int32{1})
+	assert.For(t).ThatActual(game.HasPassword).IsTrue()
+	assert.For(t).ThatActual(len(game.Players)).Equals(2)
+	assert.For(t).ThatActual(game.Players[0].DisplayName).Equals("Alice")
+	assert.For(t).ThatActual(game.Players[1].IsEmpty).IsTrue()
+}
+
+func TestAuth(t *testing.T) {
+	client, done := newTestClient(t, newFakeBackend(t))
+	defer done()
+
+	_, err := client.NewGame(context.Background(), &pb.NewGameRequest{GameName: "tictactoe"})
+
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.Unauthenticated)
+
+	wrongCookie := metadata.AppendToOutgoingContext(context.Background(), CookieMetadataKey, "WRONG")
+
+	_, err = client.NewGame(wrongCookie, &pb.NewGameRequest{GameName: "tictactoe"})
+
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.Unauthenticated)
+
+	resp, err := client.NewGame(signedIn(), &pb.NewGameRequest{GameName: "tictactoe"})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(resp.GameName).Equals("tictactoe")
+	assert.For(t).ThatActual(resp.GameId).DoesNotEqual("")
+}
+
+func TestJoinGame(t *testing.T) {
+	backend := newFakeBackend(t)
+	client, done := newTestClient(t, backend)
+	defer done()
+
+	_, err := client.JoinGame(signedIn(), &pb.JoinGameRequest{GameName: "tictactoe", GameId: "MISSING"})
+
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.NotFound)
+
+	game, _ := backend.NewGame(backend.user, "tictactoe", 0, nil, true, true, nil)
+
+	_, err = client.JoinGame(signedIn(), &pb.JoinGameRequest{GameName: "tictactoe", GameId: game.ID()})
+
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.FailedPrecondition)
+	assert.For(t).ThatActual(status.Convert(err).Message()).Equals("Wrong password")
+
+	resp, err := client.JoinGame(signedIn(), &pb.JoinGameRequest{GameName: "tictactoe", GameId: game.ID(), Password: "secret"})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(resp.PlayerIndex).Equals(int32(1))
+}
+
+func TestRateLimit(t *testing.T) {
+	backend := newFakeBackend(t)
+	backend.limiter = ratelimit.NewLimiter(2, time.Hour)
+	client, done := newTestClient(t, backend)
+	defer done()
+
+	game, _ := backend.NewGame(backend.user, "tictactoe", 0, nil, true, true, nil)
+
+	_, err := client.JoinGame(signedIn(), &pb.JoinGameRequest{GameName: "tictactoe", GameId: game.ID(), Password: "secret"})
+	assert.For(t).ThatActual(err).IsNil()
+
+	move := &pb.ProposeMoveRequest{GameName: "tictactoe", GameId: game.ID(), MoveName: "Place Token"}
+
+	_, err = client.ProposeMove(signedIn(), move)
+	assert.For(t).ThatActual(err).IsNil()
+
+	_, err = client.ProposeMove(signedIn(), move)
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.ResourceExhausted)
+
+	_, err = client.JoinGame(signedIn(), &pb.JoinGameRequest{GameName: "tictactoe", GameId: game.ID(), Password: "secret"})
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.ResourceExhausted)
+
+	//Signed out clients are limited by address instead of by user.
+	_, err = client.ListGames(context.Background(), &pb.ListGamesRequest{})
+	assert.For(t).ThatActual(err).IsNil()
+}
+
+func TestProposeMoveAndSubscribe(t *testing.T) {
+	backend := newFakeBackend(t)
+	client, done := newTestClient(t, backend)
+	defer done()
+
+	game, _ := backend.NewGame(backend.user, "tictactoe", 0, nil, true, true, nil)
+
+	ctx, cancel := context.WithTimeout(signedIn(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &pb.SubscribeRequest{GameName: "tictactoe", GameId: game.ID()})
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	update, err := stream.Recv()
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(update.Version).Equals(int64(game.Version()))
+	assert.For(t).ThatActual(update.ViewingAsPlayer).Equals(int32(0))
+	assert.For(t).ThatActual(json.Valid(update.GameJson)).IsTrue()
+	assert.For(t).ThatActual(len(update.Forms)).Equals(1)
+	assert.For(t).ThatActual(update.Forms[0].LegalForPlayer).IsTrue()
+	assert.For(t).ThatActual(update.Forms[0].Fields[0].Type).Equals("TypeInt")
+	assert.For(t).ThatActual(string(update.Forms[0].Fields[0].DefaultValueJson)).Equals("3")
+
+	startVersion := game.Version()
+
+	_, err = client.ProposeMove(signedIn(), &pb.ProposeMoveRequest{
+		GameName: "tictactoe",
+		GameId:   game.ID(),
+		MoveName: "Place Token",
+		Fields: []*pb.MoveFieldValue{
+			{
+				Name:  "Slot",
+				Value: &pb.MoveFieldValue_IntValue{IntValue: 4},
+			},
+			{
+				Name:  "Unused",
+				Value: &pb.MoveFieldValue_BoolValue{BoolValue: true},
+			},
+			{
+				Name:  "Indexes",
+				Value: &pb.MoveFieldValue_IntSliceValue{IntSliceValue: &pb.IntSliceValue{Values: []int64{1, 3}}},
+			},
+			{
+				Name:  "NoIndexes",
+				Value: &pb.MoveFieldValue_IntSliceValue{IntSliceValue: &pb.IntSliceValue{}},
+			},
+		},
+	})
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(backend.lastValues).Equals(map[string]string{
+		"Slot":      "4",
+		"Unused":    "1",
+		"Indexes":   "1,3",
+		"NoIndexes": "",
+	})
+
+	game.Refresh()
+
+	assert.For(t).ThatActual(game.Version() > startVersion).IsTrue()
+
+	backend.versions <- game.Version()
+
+	//There's an update for every version since the last one sent.
+	for version := startVersion + 1; version <= game.Version(); version++ {
+		update, err = stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.For(t).ThatActual(update.Version).Equals(int64(version))
+		assert.For(t).ThatActual(update.Move == nil).IsFalse()
+		assert.For(t).ThatActual(update.Move.Version).Equals(int64(version))
+		if version == startVersion+1 {
+			assert.For(t).ThatActual(update.Move.Name).Equals("Place Token")
+		}
+	}
+
+	_, err = client.ProposeMove(signedIn(), &pb.ProposeMoveRequest{
+		GameName: "tictactoe",
+		GameId:   game.ID(),
+		MoveName: "Place Token",
+		Fields: []*pb.MoveFieldValue{
+			{
+				Name: "Slot",
+			},
+		},
+	})
+
+	assert.For(t).ThatActual(status.Code(err)).Equals(codes.InvalidArgument)
+}
@@ -0,0 +1,1809 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        (unknown)
+// source: boardgame.proto
+
+// The gRPC service served by server/grpc, alongside the REST endpoints of
+// server/api. The Go code in this directory is generated from this file with
+// protoc-gen-go and protoc-gen-go-grpc; regenerate it with `go generate` in
+// server/grpc after changing it.
+//
+// Calls are authenticated with the same auth cookie the REST endpoints use
+// (see the auth endpoint in server/api), passed in the "boardgame-cookie"
+// metadata key. Calls without a valid cookie act as a signed out user.
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If not empty, only games of this type are listed.
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListGamesRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParticipatingActive   []*GameListing `protobuf:"bytes,1,rep,name=participating_active,json=participatingActive,proto3" json:"participating_active,omitempty"`
+	ParticipatingFinished []*GameListing `protobuf:"bytes,2,rep,name=participating_finished,json=participatingFinished,proto3" json:"participating_finished,omitempty"`
+	VisibleJoinableActive []*GameListing `protobuf:"bytes,3,rep,name=visible_joinable_active,json=visibleJoinableActive,proto3" json:"visible_joinable_active,omitempty"`
+	VisibleActive         []*GameListing `protobuf:"bytes,4,rep,name=visible_active,json=visibleActive,proto3" json:"visible_active,omitempty"`
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListGamesResponse) GetParticipatingActive() []*GameListing {
+	if x != nil {
+		return x.ParticipatingActive
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetParticipatingFinished() []*GameListing {
+	if x != nil {
+		return x.ParticipatingFinished
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetVisibleJoinableActive() []*GameListing {
+	if x != nil {
+		return x.VisibleJoinableActive
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetVisibleActive() []*GameListing {
+	if x != nil {
+		return x.VisibleActive
+	}
+	return nil
+}
+
+type GameListing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id          string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Version     int64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	NumPlayers  int32   `protobuf:"varint,4,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	Finished    bool    `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Winners     []int32 `protobuf:"varint,6,rep,packed,name=winners,proto3" json:"winners,omitempty"`
+	Open        bool    `protobuf:"varint,7,opt,name=open,proto3" json:"open,omitempty"`
+	Visible     bool    `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
+	Owner       string  `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	HasPassword bool    `protobuf:"varint,10,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+	// UnixNano time of the most recent change to the game.
+	Modified int64     `protobuf:"varint,11,opt,name=modified,proto3" json:"modified,omitempty"`
+	Players  []*Player `protobuf:"bytes,12,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *GameListing) Reset() {
+	*x = GameListing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameListing) ProtoMessage() {}
+
+func (x *GameListing) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameListing.ProtoReflect.Descriptor instead.
+func (*GameListing) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameListing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameListing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameListing) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameListing) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *GameListing) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *GameListing) GetWinners() []int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *GameListing) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *GameListing) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *GameListing) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *GameListing) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *GameListing) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *GameListing) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsAgent     bool   `protobuf:"varint,2,opt,name=is_agent,json=isAgent,proto3" json:"is_agent,omitempty"`
+	IsEmpty     bool   `protobuf:"varint,3,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	PhotoUrl    string `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Player) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Player) GetIsAgent() bool {
+	if x != nil {
+		return x.IsAgent
+	}
+	return false
+}
+
+func (x *Player) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+func (x *Player) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type NewGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	// If 0, the game type's default number of players.
+	NumPlayers int32 `protobuf:"varint,2,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	// The name of the agent to play each seat, or "" for a human. May be
+	// shorter than the number of players.
+	Agents  []string          `protobuf:"bytes,3,rep,name=agents,proto3" json:"agents,omitempty"`
+	Open    bool              `protobuf:"varint,4,opt,name=open,proto3" json:"open,omitempty"`
+	Visible bool              `protobuf:"varint,5,opt,name=visible,proto3" json:"visible,omitempty"`
+	Variant map[string]string `protobuf:"bytes,6,rep,name=variant,proto3" json:"variant,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NewGameRequest) Reset() {
+	*x = NewGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameRequest) ProtoMessage() {}
+
+func (x *NewGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameRequest.ProtoReflect.Descriptor instead.
+func (*NewGameRequest) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NewGameRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *NewGameRequest) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *NewGameRequest) GetAgents() []string {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *NewGameRequest) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *NewGameRequest) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *NewGameRequest) GetVariant() map[string]string {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type NewGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	GameId   string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *NewGameResponse) Reset() {
+	*x = NewGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameResponse) ProtoMessage() {}
+
+func (x *NewGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameResponse.ProtoReflect.Descriptor instead.
+func (*NewGameResponse) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NewGameResponse) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *NewGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type JoinGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	GameId   string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Invite   string `protobuf:"bytes,3,opt,name=invite,proto3" json:"invite,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JoinGameRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetInvite() string {
+	if x != nil {
+		return x.Invite
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerIndex int32 `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JoinGameResponse) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+// MoveFieldValue is the value of one of the fields of a move, as described
+// by the fields of its MoveForm.
+type MoveFieldValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Value:
+	//	*MoveFieldValue_IntValue
+	//	*MoveFieldValue_BoolValue
+	//	*MoveFieldValue_EnumValue
+	//	*MoveFieldValue_PlayerIndexValue
+	//	*MoveFieldValue_IntSliceValue
+	Value isMoveFieldValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *MoveFieldValue) Reset() {
+	*x = MoveFieldValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFieldValue) ProtoMessage() {}
+
+func (x *MoveFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFieldValue.ProtoReflect.Descriptor instead.
+func (*MoveFieldValue) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveFieldValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *MoveFieldValue) GetValue() isMoveFieldValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *MoveFieldValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*MoveFieldValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *MoveFieldValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*MoveFieldValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *MoveFieldValue) GetEnumValue() string {
+	if x, ok := x.GetValue().(*MoveFieldValue_EnumValue); ok {
+		return x.EnumValue
+	}
+	return ""
+}
+
+func (x *MoveFieldValue) GetPlayerIndexValue() int32 {
+	if x, ok := x.GetValue().(*MoveFieldValue_PlayerIndexValue); ok {
+		return x.PlayerIndexValue
+	}
+	return 0
+}
+
+func (x *MoveFieldValue) GetIntSliceValue() *IntSliceValue {
+	if x, ok := x.GetValue().(*MoveFieldValue_IntSliceValue); ok {
+		return x.IntSliceValue
+	}
+	return nil
+}
+
+type isMoveFieldValue_Value interface {
+	isMoveFieldValue_Value()
+}
+
+type MoveFieldValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type MoveFieldValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type MoveFieldValue_EnumValue struct {
+	// The name (or number) of the enum value.
+	EnumValue string `protobuf:"bytes,4,opt,name=enum_value,json=enumValue,proto3,oneof"`
+}
+
+type MoveFieldValue_PlayerIndexValue struct {
+	PlayerIndexValue int32 `protobuf:"varint,5,opt,name=player_index_value,json=playerIndexValue,proto3,oneof"`
+}
+
+type MoveFieldValue_IntSliceValue struct {
+	IntSliceValue *IntSliceValue `protobuf:"bytes,6,opt,name=int_slice_value,json=intSliceValue,proto3,oneof"`
+}
+
+func (*MoveFieldValue_IntValue) isMoveFieldValue_Value() {}
+
+func (*MoveFieldValue_BoolValue) isMoveFieldValue_Value() {}
+
+func (*MoveFieldValue_EnumValue) isMoveFieldValue_Value() {}
+
+func (*MoveFieldValue_PlayerIndexValue) isMoveFieldValue_Value() {}
+
+func (*MoveFieldValue_IntSliceValue) isMoveFieldValue_Value() {}
+
+// IntSliceValue is the value of an int slice field, like the indexes of the
+// components a move affects.
+type IntSliceValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *IntSliceValue) Reset() {
+	*x = IntSliceValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntSliceValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntSliceValue) ProtoMessage() {}
+
+func (x *IntSliceValue) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntSliceValue.ProtoReflect.Descriptor instead.
+func (*IntSliceValue) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IntSliceValue) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ProposeMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	GameId   string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	MoveName string `protobuf:"bytes,3,opt,name=move_name,json=moveName,proto3" json:"move_name,omitempty"`
+	// Fields not provided are left at their zero value.
+	Fields []*MoveFieldValue `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ProposeMoveRequest) Reset() {
+	*x = ProposeMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeMoveRequest) ProtoMessage() {}
+
+func (x *ProposeMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeMoveRequest.ProtoReflect.Descriptor instead.
+func (*ProposeMoveRequest) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProposeMoveRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *ProposeMoveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ProposeMoveRequest) GetMoveName() string {
+	if x != nil {
+		return x.MoveName
+	}
+	return ""
+}
+
+func (x *ProposeMoveRequest) GetFields() []*MoveFieldValue {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ProposeMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProposeMoveResponse) Reset() {
+	*x = ProposeMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeMoveResponse) ProtoMessage() {}
+
+func (x *ProposeMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeMoveResponse.ProtoReflect.Descriptor instead.
+func (*ProposeMoveResponse) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{11}
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameName string `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	GameId   string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// If 0, the first update is the game's current version. Otherwise there
+	// is one update for every version after this one.
+	FromVersion int64 `protobuf:"varint,3,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubscribeRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+type GameUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version         int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ViewingAsPlayer int32 `protobuf:"varint,2,opt,name=viewing_as_player,json=viewingAsPlayer,proto3" json:"viewing_as_player,omitempty"`
+	// The game and its state at version, as JSON sanitized for
+	// viewing_as_player.
+	GameJson []byte `protobuf:"bytes,3,opt,name=game_json,json=gameJson,proto3" json:"game_json,omitempty"`
+	// The move that produced version. Not set for version 0.
+	Move *Move `protobuf:"bytes,4,opt,name=move,proto3" json:"move,omitempty"`
+	// The moves that can be proposed, and whether they are legal right now.
+	Forms []*MoveForm `protobuf:"bytes,5,rep,name=forms,proto3" json:"forms,omitempty"`
+}
+
+func (x *GameUpdate) Reset() {
+	*x = GameUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameUpdate) ProtoMessage() {}
+
+func (x *GameUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameUpdate.ProtoReflect.Descriptor instead.
+func (*GameUpdate) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GameUpdate) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameUpdate) GetViewingAsPlayer() int32 {
+	if x != nil {
+		return x.ViewingAsPlayer
+	}
+	return 0
+}
+
+func (x *GameUpdate) GetGameJson() []byte {
+	if x != nil {
+		return x.GameJson
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetForms() []*MoveForm {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+type Move struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Initiator int64  `protobuf:"varint,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Proposer  int32  `protobuf:"varint,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// UnixNano time the move was applied.
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Blob      []byte `protobuf:"bytes,6,opt,name=blob,proto3" json:"blob,omitempty"`
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Move) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Move) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Move) GetInitiator() int64 {
+	if x != nil {
+		return x.Initiator
+	}
+	return 0
+}
+
+func (x *Move) GetProposer() int32 {
+	if x != nil {
+		return x.Proposer
+	}
+	return 0
+}
+
+func (x *Move) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Move) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+type MoveForm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HelpText            string           `protobuf:"bytes,2,opt,name=help_text,json=helpText,proto3" json:"help_text,omitempty"`
+	Fields              []*MoveFormField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	LegalForPlayer      bool             `protobuf:"varint,4,opt,name=legal_for_player,json=legalForPlayer,proto3" json:"legal_for_player,omitempty"`
+	LegalForPlayerError string           `protobuf:"bytes,5,opt,name=legal_for_player_error,json=legalForPlayerError,proto3" json:"legal_for_player_error,omitempty"`
+	LegalForAnyone      bool             `protobuf:"varint,6,opt,name=legal_for_anyone,json=legalForAnyone,proto3" json:"legal_for_anyone,omitempty"`
+}
+
+func (x *MoveForm) Reset() {
+	*x = MoveForm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveForm) ProtoMessage() {}
+
+func (x *MoveForm) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveForm.ProtoReflect.Descriptor instead.
+func (*MoveForm) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveForm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveForm) GetHelpText() string {
+	if x != nil {
+		return x.HelpText
+	}
+	return ""
+}
+
+func (x *MoveForm) GetFields() []*MoveFormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *MoveForm) GetLegalForPlayer() bool {
+	if x != nil {
+		return x.LegalForPlayer
+	}
+	return false
+}
+
+func (x *MoveForm) GetLegalForPlayerError() string {
+	if x != nil {
+		return x.LegalForPlayerError
+	}
+	return ""
+}
+
+func (x *MoveForm) GetLegalForAnyone() bool {
+	if x != nil {
+		return x.LegalForAnyone
+	}
+	return false
+}
+
+type MoveFormField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The boardgame.PropertyType of the field, like "TypeInt".
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	EnumName string `protobuf:"bytes,3,opt,name=enum_name,json=enumName,proto3" json:"enum_name,omitempty"`
+	// The field's default value, as JSON.
+	DefaultValueJson []byte `protobuf:"bytes,4,opt,name=default_value_json,json=defaultValueJson,proto3" json:"default_value_json,omitempty"`
+}
+
+func (x *MoveFormField) Reset() {
+	*x = MoveFormField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boardgame_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFormField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFormField) ProtoMessage() {}
+
+func (x *MoveFormField) ProtoReflect() protoreflect.Message {
+	mi := &file_boardgame_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFormField.ProtoReflect.Descriptor instead.
+func (*MoveFormField) Descriptor() ([]byte, []int) {
+	return file_boardgame_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MoveFormField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveFormField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MoveFormField) GetEnumName() string {
+	if x != nil {
+		return x.EnumName
+	}
+	return ""
+}
+
+func (x *MoveFormField) GetDefaultValueJson() []byte {
+	if x != nil {
+		return x.DefaultValueJson
+	}
+	return nil
+}
+
+var File_boardgame_proto protoreflect.FileDescriptor
+
+var file_boardgame_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x02,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4d,
+	0x0a, 0x16, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x4e, 0x0a,
+	0x17, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x4a,
+	0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3d, 0x0a,
+	0x0e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xd2, 0x02, 0x0a,
+	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x22, 0x7e, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72,
+	0x6c, 0x22, 0x92, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61,
+	0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x7b, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x35, 0x0a, 0x10,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x69,
+	0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x49, 0x6e, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00,
+	0x52, 0x0d, 0x69, 0x6e, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x9a, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x15,
+	0x0a, 0x13, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61,
+	0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x76,
+	0x69, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x41,
+	0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65,
+	0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0xf6, 0x01, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x6c, 0x70,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x6c,
+	0x70, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x65, 0x67, 0x61, 0x6c,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x61, 0x6e, 0x79, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x41, 0x6e, 0x79, 0x6f, 0x6e, 0x65,
+	0x22, 0x82, 0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x32, 0xeb, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x67,
+	0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x4e,
+	0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4e, 0x65,
+	0x77, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6a, 0x6b, 0x6f, 0x6d, 0x6f, 0x72, 0x6f, 0x73, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_boardgame_proto_rawDescOnce sync.Once
+	file_boardgame_proto_rawDescData = file_boardgame_proto_rawDesc
+)
+
+func file_boardgame_proto_rawDescGZIP() []byte {
+	file_boardgame_proto_rawDescOnce.Do(func() {
+		file_boardgame_proto_rawDescData = protoimpl.X.CompressGZIP(file_boardgame_proto_rawDescData)
+	})
+	return file_boardgame_proto_rawDescData
+}
+
+var file_boardgame_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_boardgame_proto_goTypes = []interface{}{
+	(*ListGamesRequest)(nil),    // 0: boardgame.ListGamesRequest
+	(*ListGamesResponse)(nil),   // 1: boardgame.ListGamesResponse
+	(*GameListing)(nil),         // 2: boardgame.GameListing
+	(*Player)(nil),              // 3: boardgame.Player
+	(*NewGameRequest)(nil),      // 4: boardgame.NewGameRequest
+	(*NewGameResponse)(nil),     // 5: boardgame.NewGameResponse
+	(*JoinGameRequest)(nil),     // 6: boardgame.JoinGameRequest
+	(*JoinGameResponse)(nil),    // 7: boardgame.JoinGameResponse
+	(*MoveFieldValue)(nil),      // 8: boardgame.MoveFieldValue
+	(*IntSliceValue)(nil),       // 9: boardgame.IntSliceValue
+	(*ProposeMoveRequest)(nil),  // 10: boardgame.ProposeMoveRequest
+	(*ProposeMoveResponse)(nil), // 11: boardgame.ProposeMoveResponse
+	(*SubscribeRequest)(nil),    // 12: boardgame.SubscribeRequest
+	(*GameUpdate)(nil),          // 13: boardgame.GameUpdate
+	(*Move)(nil),                // 14: boardgame.Move
+	(*MoveForm)(nil),            // 15: boardgame.MoveForm
+	(*MoveFormField)(nil),       // 16: boardgame.MoveFormField
+	nil,                         // 17: boardgame.NewGameRequest.VariantEntry
+}
+var file_boardgame_proto_depIdxs = []int32{
+	2,  // 0: boardgame.ListGamesResponse.participating_active:type_name -> boardgame.GameListing
+	2,  // 1: boardgame.ListGamesResponse.participating_finished:type_name -> boardgame.GameListing
+	2,  // 2: boardgame.ListGamesResponse.visible_joinable_active:type_name -> boardgame.GameListing
+	2,  // 3: boardgame.ListGamesResponse.visible_active:type_name -> boardgame.GameListing
+	3,  // 4: boardgame.GameListing.players:type_name -> boardgame.Player
+	17, // 5: boardgame.NewGameRequest.variant:type_name -> boardgame.NewGameRequest.VariantEntry
+	9,  // 6: boardgame.MoveFieldValue.int_slice_value:type_name -> boardgame.IntSliceValue
+	8,  // 7: boardgame.ProposeMoveRequest.fields:type_name -> boardgame.MoveFieldValue
+	14, // 8: boardgame.GameUpdate.move:type_name -> boardgame.Move
+	15, // 9: boardgame.GameUpdate.forms:type_name -> boardgame.MoveForm
+	16, // 10: boardgame.MoveForm.fields:type_name -> boardgame.MoveFormField
+	0,  // 11: boardgame.Boardgame.ListGames:input_type -> boardgame.ListGamesRequest
+	4,  // 12: boardgame.Boardgame.NewGame:input_type -> boardgame.NewGameRequest
+	6,  // 13: boardgame.Boardgame.JoinGame:input_type -> boardgame.JoinGameRequest
+	10, // 14: boardgame.Boardgame.ProposeMove:input_type -> boardgame.ProposeMoveRequest
+	12, // 15: boardgame.Boardgame.Subscribe:input_type -> boardgame.SubscribeRequest
+	1,  // 16: boardgame.Boardgame.ListGames:output_type -> boardgame.ListGamesResponse
+	5,  // 17: boardgame.Boardgame.NewGame:output_type -> boardgame.NewGameResponse
+	7,  // 18: boardgame.Boardgame.JoinGame:output_type -> boardgame.JoinGameResponse
+	11, // 19: boardgame.Boardgame.ProposeMove:output_type -> boardgame.ProposeMoveResponse
+	13, // 20: boardgame.Boardgame.Subscribe:output_type -> boardgame.GameUpdate
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_boardgame_proto_init() }
+func file_boardgame_proto_init() {
+	if File_boardgame_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_boardgame_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameListing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFieldValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntSliceValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeMoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Move); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveForm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boardgame_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFormField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_boardgame_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*MoveFieldValue_IntValue)(nil),
+		(*MoveFieldValue_BoolValue)(nil),
+		(*MoveFieldValue_EnumValue)(nil),
+		(*MoveFieldValue_PlayerIndexValue)(nil),
+		(*MoveFieldValue_IntSliceValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_boardgame_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_boardgame_proto_goTypes,
+		DependencyIndexes: file_boardgame_proto_depIdxs,
+		MessageInfos:      file_boardgame_proto_msgTypes,
+	}.Build()
+	File_boardgame_proto = out.File
+	file_boardgame_proto_rawDesc = nil
+	file_boardgame_proto_goTypes = nil
+	file_boardgame_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BoardgameClient is the client API for Boardgame service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BoardgameClient interface {
+	// ListGames lists the games the signed in user can see.
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	// NewGame creates a new game owned by the signed in user.
+	NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*NewGameResponse, error)
+	// JoinGame seats the signed in user in an empty seat of a game.
+	JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error)
+	// ProposeMove proposes a move on behalf of the signed in user's seat, and
+	// returns once it has been applied or rejected.
+	ProposeMove(ctx context.Context, in *ProposeMoveRequest, opts ...grpc.CallOption) (*ProposeMoveResponse, error)
+	// Subscribe streams an update every time the game changes, sanitized for
+	// the seat the signed in user is in (or for an observer).
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Boardgame_SubscribeClient, error)
+}
+
+type boardgameClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBoardgameClient(cc grpc.ClientConnInterface) BoardgameClient {
+	return &boardgameClient{cc}
+}
+
+func (c *boardgameClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, "/boardgame.Boardgame/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardgameClient) NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*NewGameResponse, error) {
+	out := new(NewGameResponse)
+	err := c.cc.Invoke(ctx, "/boardgame.Boardgame/NewGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardgameClient) JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error) {
+	out := new(JoinGameResponse)
+	err := c.cc.Invoke(ctx, "/boardgame.Boardgame/JoinGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardgameClient) ProposeMove(ctx context.Context, in *ProposeMoveRequest, opts ...grpc.CallOption) (*ProposeMoveResponse, error) {
+	out := new(ProposeMoveResponse)
+	err := c.cc.Invoke(ctx, "/boardgame.Boardgame/ProposeMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardgameClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Boardgame_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Boardgame_ServiceDesc.Streams[0], "/boardgame.Boardgame/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardgameSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Boardgame_SubscribeClient interface {
+	Recv() (*GameUpdate, error)
+	grpc.ClientStream
+}
+
+type boardgameSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardgameSubscribeClient) Recv() (*GameUpdate, error) {
+	m := new(GameUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BoardgameServer is the server API for Boardgame service.
+// All implementations must embed UnimplementedBoardgameServer
+// for forward compatibility
+type BoardgameServer interface {
+	// ListGames lists the games the signed in user can see.
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	// NewGame creates a new game owned by the signed in user.
+	NewGame(context.Context, *NewGameRequest) (*NewGameResponse, error)
+	// JoinGame seats the signed in user in an empty seat of a game.
+	JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error)
+	// ProposeMove proposes a move on behalf of the signed in user's seat, and
+	// returns once it has been applied or rejected.
+	ProposeMove(context.Context, *ProposeMoveRequest) (*ProposeMoveResponse, error)
+	// Subscribe streams an update every time the game changes, sanitized for
+	// the seat the signed in user is in (or for an observer).
+	Subscribe(*SubscribeRequest, Boardgame_SubscribeServer) error
+	mustEmbedUnimplementedBoardgameServer()
+}
+
+// UnimplementedBoardgameServer must be embedded to have forward compatible implementations.
+type UnimplementedBoardgameServer struct {
+}
+
+func (UnimplementedBoardgameServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedBoardgameServer) NewGame(context.Context, *NewGameRequest) (*NewGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGame not implemented")
+}
+func (UnimplementedBoardgameServer) JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedBoardgameServer) ProposeMove(context.Context, *ProposeMoveRequest) (*ProposeMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeMove not implemented")
+}
+func (UnimplementedBoardgameServer) Subscribe(*SubscribeRequest, Boardgame_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedBoardgameServer) mustEmbedUnimplementedBoardgameServer() {}
+
+// UnsafeBoardgameServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BoardgameServer will
+// result in compilation errors.
+type UnsafeBoardgameServer interface {
+	mustEmbedUnimplementedBoardgameServer()
+}
+
+func RegisterBoardgameServer(s grpc.ServiceRegistrar, srv BoardgameServer) {
+	s.RegisterService(&Boardgame_ServiceDesc, srv)
+}
+
+func _Boardgame_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardgameServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardgame.Boardgame/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardgameServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boardgame_NewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardgameServer).NewGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardgame.Boardgame/NewGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardgameServer).NewGame(ctx, req.(*NewGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boardgame_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardgameServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardgame.Boardgame/JoinGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardgameServer).JoinGame(ctx, req.(*JoinGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boardgame_ProposeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardgameServer).ProposeMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardgame.Boardgame/ProposeMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardgameServer).ProposeMove(ctx, req.(*ProposeMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boardgame_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardgameServer).Subscribe(m, &boardgameSubscribeServer{stream})
+}
+
+type Boardgame_SubscribeServer interface {
+	Send(*GameUpdate) error
+	grpc.ServerStream
+}
+
+type boardgameSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardgameSubscribeServer) Send(m *GameUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Boardgame_ServiceDesc is the grpc.ServiceDesc for Boardgame service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Boardgame_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "boardgame.Boardgame",
+	HandlerType: (*BoardgameServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGames",
+			Handler:    _Boardgame_ListGames_Handler,
+		},
+		{
+			MethodName: "NewGame",
+			Handler:    _Boardgame_NewGame_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _Boardgame_JoinGame_Handler,
+		},
+		{
+			MethodName: "ProposeMove",
+			Handler:    _Boardgame_ProposeMove_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Boardgame_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "boardgame.proto",
+}
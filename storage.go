@@ -142,3 +142,16 @@ type StorageManager interface {
 	//managers need only return nil in all cases.
 	FetchInjectedDataForGame(gameID string, dataType string) interface{}
 }
+
+//GameUpdater is an optional interface for StorageManagers. If the
+//StorageManager implements it, games can change fields that aren't tied to a
+//particular version between moves, for example Agents when an agent takes
+//over a seat, or Finished when an admin ends the game.
+//ManagerInternals.SetAgent and ForceFinish return an error if the
+//StorageManager doesn't implement it. All of the StorageManagers in
+//boardgame/storage do.
+type GameUpdater interface {
+	//UpdateGame overwrites the stored GameStorageRecord for a game that has
+	//already been saved, without storing a new state or move.
+	UpdateGame(game *GameStorageRecord) error
+}
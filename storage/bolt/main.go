@@ -9,15 +9,20 @@ package bolt
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/boltdb/bolt"
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
 	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 	"github.com/jkomoros/boardgame/storage/internal/helpers"
 )
@@ -40,6 +45,10 @@ var (
 	cookiesBucket       = []byte("Cookies")
 	gameUsersBucket     = []byte("GameUsers")
 	agentStatesBucket   = []byte("AgentStates")
+	ratingsBucket       = []byte("Ratings")
+	ratingHistoryBucket = []byte("RatingHistory")
+	tournamentsBucket   = []byte("Tournaments")
+	auditBucket         = []byte("Audit")
 )
 
 //NewStorageManager returns a new StorageManager ready for use, backed by the
@@ -77,6 +86,18 @@ func NewStorageManager(fileName string) *StorageManager {
 		if _, err := tx.CreateBucketIfNotExists(agentStatesBucket); err != nil {
 			return errors.New("Cannot create agent states bucket" + err.Error())
 		}
+		if _, err := tx.CreateBucketIfNotExists(ratingsBucket); err != nil {
+			return errors.New("Cannot create ratings bucket" + err.Error())
+		}
+		if _, err := tx.CreateBucketIfNotExists(ratingHistoryBucket); err != nil {
+			return errors.New("Cannot create rating history bucket" + err.Error())
+		}
+		if _, err := tx.CreateBucketIfNotExists(tournamentsBucket); err != nil {
+			return errors.New("Cannot create tournaments bucket" + err.Error())
+		}
+		if _, err := tx.CreateBucketIfNotExists(auditBucket); err != nil {
+			return errors.New("Cannot create audit bucket" + err.Error())
+		}
 		return nil
 	})
 
@@ -116,6 +137,24 @@ func keyForAgentState(gameID string, player boardgame.PlayerIndex) []byte {
 	return []byte(gameID + "-" + player.String())
 }
 
+func keyForRating(userID string, gameName string) []byte {
+	return []byte(gameName + "-" + userID)
+}
+
+func keyForAudit(sequence uint64) []byte {
+	//Zero pad so that the keys sort in the order they were inserted.
+	return []byte(fmt.Sprintf("%020d", sequence))
+}
+
+func keyForTournament(id string) []byte {
+	return []byte(id)
+}
+
+func keyForRatingHistory(sequence uint64) []byte {
+	//Zero pad so that the keys sort in the order they were inserted.
+	return []byte(fmt.Sprintf("%020d", sequence))
+}
+
 //Name returns 'bolt'
 func (s *StorageManager) Name() string {
 	return "bolt"
@@ -365,6 +404,31 @@ func (s *StorageManager) SaveAgentState(gameID string, player boardgame.PlayerIn
 
 }
 
+//UpdateGame implements that method from the main storagemanager interface
+func (s *StorageManager) UpdateGame(game *boardgame.GameStorageRecord) error {
+
+	serializedGameRecord, err := json.Marshal(game)
+
+	if err != nil {
+		return errors.New("Couldn't serialize the internal game record: " + err.Error())
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		gBucket := tx.Bucket(gamesBucket)
+
+		if gBucket == nil {
+			return errors.New("Couldn't open games bucket")
+		}
+
+		if gBucket.Get(keyForGame(game.ID)) == nil {
+			return errors.New("No such game found")
+		}
+
+		return gBucket.Put(keyForGame(game.ID), serializedGameRecord)
+	})
+
+}
+
 //AllGames implements the extra method necessary for storage/internal/helpers
 func (s *StorageManager) AllGames() []*boardgame.GameStorageRecord {
 	var results []*boardgame.GameStorageRecord
@@ -484,13 +548,49 @@ func (s *StorageManager) CombinedGame(id string) (*extendedgame.CombinedStorageR
 //UpdateExtendedGame implements that method from the server api storagemanager interface
 func (s *StorageManager) UpdateExtendedGame(id string, eGame *extendedgame.StorageRecord) error {
 
-	serializedExtendedGameRecord, err := json.Marshal(eGame)
+	record := *eGame
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+
+		eBucket := tx.Bucket(extendedGamesBucket)
+
+		if eBucket == nil {
+			return errors.New("Couldn't open extended games bucket")
+		}
+
+		//Keep the stored clock; it's only changed by UpdateInactivityClock.
+		record.InactivityClock = extendedgame.InactivityClock{}
+
+		if rawRecord := eBucket.Get(keyForGame(id)); rawRecord != nil {
+			var existing extendedgame.StorageRecord
+			if err := json.Unmarshal(rawRecord, &existing); err != nil {
+				return errors.New("Couldn't unmarshal record: " + err.Error())
+			}
+			record.InactivityClock = existing.InactivityClock
+		}
+
+		serializedExtendedGameRecord, err := json.Marshal(record)
+
+		if err != nil {
+			return errors.New("couldn't serialize record: " + err.Error())
+		}
+
+		return eBucket.Put(keyForGame(id), serializedExtendedGameRecord)
+	})
 
 	if err != nil {
-		return errors.New("couldn't serialize record: " + err.Error())
+		return errors.New("Couldn't save extended game: " + err.Error())
 	}
 
-	err = s.db.Update(func(tx *bolt.Tx) error {
+	return nil
+
+}
+
+//UpdateInactivityClock implements that method from the server api
+//storagemanager interface
+func (s *StorageManager) UpdateInactivityClock(id string, clock *extendedgame.InactivityClock) error {
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
 
 		eBucket := tx.Bucket(extendedGamesBucket)
 
@@ -498,15 +598,67 @@ func (s *StorageManager) UpdateExtendedGame(id string, eGame *extendedgame.Stora
 			return errors.New("Couldn't open extended games bucket")
 		}
 
+		rawRecord := eBucket.Get(keyForGame(id))
+
+		if rawRecord == nil {
+			return errors.New("No such extended game found")
+		}
+
+		var record extendedgame.StorageRecord
+
+		if err := json.Unmarshal(rawRecord, &record); err != nil {
+			return errors.New("Couldn't unmarshal record: " + err.Error())
+		}
+
+		record.InactivityClock = *clock
+
+		serializedExtendedGameRecord, err := json.Marshal(record)
+
+		if err != nil {
+			return errors.New("couldn't serialize record: " + err.Error())
+		}
+
 		return eBucket.Put(keyForGame(id), serializedExtendedGameRecord)
 	})
 
 	if err != nil {
-		return errors.New("Couldn't save extended game: " + err.Error())
+		return errors.New("Couldn't save inactivity clock: " + err.Error())
 	}
 
 	return nil
+}
+
+//DueInactivityChecks implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) DueInactivityChecks(now int64) []string {
 
+	var result []string
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		eBucket := tx.Bucket(extendedGamesBucket)
+
+		if eBucket == nil {
+			return errors.New("Couldn't open extended games bucket")
+		}
+
+		return eBucket.ForEach(func(k, v []byte) error {
+			var eGame extendedgame.StorageRecord
+			if err := json.Unmarshal(v, &eGame); err != nil {
+				return errors.New("Couldn't deserialize an extended game: " + err.Error())
+			}
+			if eGame.InactivityDeadline != 0 && eGame.InactivityDeadline <= now {
+				result = append(result, string(k))
+			}
+			return nil
+		})
+	})
+
+	if err != nil {
+		log.Println("Error in DueInactivityChecks: ", err)
+		return nil
+	}
+
+	return result
 }
 
 //SetPlayerForGame implements that method from the server api storagemanager interface
@@ -522,13 +674,12 @@ func (s *StorageManager) SetPlayerForGame(gameID string, playerIndex boardgame.P
 		return errors.New("PlayerIndex " + playerIndex.String() + " is not valid for this game")
 	}
 
-	if ids[playerIndex] != "" {
+	//A userID of "" clears the seat, which is always allowed.
+	if userID != "" && ids[playerIndex] != "" {
 		return errors.New("PlayerIndex " + playerIndex.String() + " is already taken")
 	}
 
-	user := s.GetUserByID(userID)
-
-	if user == nil {
+	if userID != "" && s.GetUserByID(userID) == nil {
 		return errors.New("That userId does not describe an existing user")
 	}
 
@@ -732,6 +883,648 @@ func (s *StorageManager) ConnectCookieToUser(cookie string, user *users.StorageR
 	return err
 }
 
+//Rating implements that method from the server api storagemanager interface
+func (s *StorageManager) Rating(userID string, gameName string) (*ratings.StorageRecord, error) {
+
+	var rawRecord []byte
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		rBucket := tx.Bucket(ratingsBucket)
+
+		if rBucket == nil {
+			return errors.New("Couldn't open ratings bucket")
+		}
+
+		rawRecord = rBucket.Get(keyForRating(userID, gameName))
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rawRecord == nil {
+		return ratings.DefaultStorageRecord(userID, gameName), nil
+	}
+
+	var result ratings.StorageRecord
+
+	if err := json.Unmarshal(rawRecord, &result); err != nil {
+		return nil, errors.New("Couldn't unmarshal rating: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+//UpdateRating implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) UpdateRating(rating *ratings.StorageRecord, history *ratings.HistoryRecord) error {
+
+	if rating == nil {
+		return errors.New("No rating provided")
+	}
+
+	ratingBlob, err := json.Marshal(rating)
+
+	if err != nil {
+		return errors.New("Couldn't marshal rating: " + err.Error())
+	}
+
+	var historyBlob []byte
+
+	if history != nil {
+		historyBlob, err = json.Marshal(history)
+		if err != nil {
+			return errors.New("Couldn't marshal rating history: " + err.Error())
+		}
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		rBucket := tx.Bucket(ratingsBucket)
+
+		if rBucket == nil {
+			return errors.New("Couldn't open ratings bucket")
+		}
+
+		if err := rBucket.Put(keyForRating(rating.UserID, rating.GameName), ratingBlob); err != nil {
+			return err
+		}
+
+		if historyBlob == nil {
+			return nil
+		}
+
+		hBucket := tx.Bucket(ratingHistoryBucket)
+
+		if hBucket == nil {
+			return errors.New("Couldn't open rating history bucket")
+		}
+
+		sequence, err := hBucket.NextSequence()
+
+		if err != nil {
+			return err
+		}
+
+		return hBucket.Put(keyForRatingHistory(sequence), historyBlob)
+	})
+}
+
+//ListRatings implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) ListRatings(gameName string, max int) []*ratings.StorageRecord {
+
+	var result []*ratings.StorageRecord
+
+	prefix := keyForRating("", gameName)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		rBucket := tx.Bucket(ratingsBucket)
+
+		if rBucket == nil {
+			return errors.New("Couldn't open ratings bucket")
+		}
+
+		c := rBucket.Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, v = c.Next() {
+			var record ratings.StorageRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return errors.New("Couldn't deserialize a rating: " + err.Error())
+			}
+			//Game names that are a prefix of another game name will also
+			//match the key prefix.
+			if record.GameName != gameName {
+				continue
+			}
+			result = append(result, &record)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Println("Error in ListRatings: ", err)
+		return nil
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Rating > result[j].Rating
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//RatingHistory implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) RatingHistory(userID string, gameName string, max int) []*ratings.HistoryRecord {
+
+	var result []*ratings.HistoryRecord
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		hBucket := tx.Bucket(ratingHistoryBucket)
+
+		if hBucket == nil {
+			return errors.New("Couldn't open rating history bucket")
+		}
+
+		c := hBucket.Cursor()
+
+		//Walk backwards so the most recent records come first.
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var record ratings.HistoryRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return errors.New("Couldn't deserialize rating history: " + err.Error())
+			}
+			if record.UserID != userID {
+				continue
+			}
+			if gameName != "" && record.GameName != gameName {
+				continue
+			}
+			result = append(result, &record)
+			if len(result) >= max {
+				break
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Println("Error in RatingHistory: ", err)
+		return nil
+	}
+
+	return result
+}
+
+//Tournament implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) Tournament(id string) (*tournaments.StorageRecord, error) {
+
+	var rawRecord []byte
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		tBucket := tx.Bucket(tournamentsBucket)
+
+		if tBucket == nil {
+			return errors.New("Couldn't open tournaments bucket")
+		}
+
+		rawRecord = tBucket.Get(keyForTournament(id))
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rawRecord == nil {
+		return nil, errors.New("No such tournament")
+	}
+
+	var result tournaments.StorageRecord
+
+	if err := json.Unmarshal(rawRecord, &result); err != nil {
+		return nil, errors.New("Couldn't unmarshal tournament: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+//UpdateTournament implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) UpdateTournament(tournament *tournaments.StorageRecord) error {
+
+	if tournament == nil {
+		return errors.New("No tournament provided")
+	}
+
+	blob, err := json.Marshal(tournament)
+
+	if err != nil {
+		return errors.New("Couldn't marshal tournament: " + err.Error())
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		tBucket := tx.Bucket(tournamentsBucket)
+
+		if tBucket == nil {
+			return errors.New("Couldn't open tournaments bucket")
+		}
+
+		return tBucket.Put(keyForTournament(tournament.ID), blob)
+	})
+}
+
+//ListTournaments implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) ListTournaments(max int, gameName string) []*tournaments.StorageRecord {
+
+	var result []*tournaments.StorageRecord
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		tBucket := tx.Bucket(tournamentsBucket)
+
+		if tBucket == nil {
+			return errors.New("Couldn't open tournaments bucket")
+		}
+
+		return tBucket.ForEach(func(k, v []byte) error {
+			var record tournaments.StorageRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return errors.New("Couldn't deserialize a tournament: " + err.Error())
+			}
+			if gameName != "" && record.GameName != gameName {
+				return nil
+			}
+			result = append(result, &record)
+			return nil
+		})
+	})
+
+	if err != nil {
+		log.Println("Error in ListTournaments: ", err)
+		return nil
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Created > result[j].Created
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//ListUsers implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) ListUsers(max int, query string) []*users.StorageRecord {
+
+	var result []*users.StorageRecord
+
+	query = strings.ToLower(query)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		uBucket := tx.Bucket(usersBucket)
+
+		if uBucket == nil {
+			return errors.New("Couldn't open users bucket")
+		}
+
+		return uBucket.ForEach(func(k, v []byte) error {
+			var user users.StorageRecord
+			if err := json.Unmarshal(v, &user); err != nil {
+				return errors.New("Couldn't deserialize a user: " + err.Error())
+			}
+			if query != "" &&
+				!strings.Contains(strings.ToLower(user.ID), query) &&
+				!strings.Contains(strings.ToLower(user.DisplayName), query) &&
+				!strings.Contains(strings.ToLower(user.Email), query) {
+				return nil
+			}
+			result = append(result, &user)
+			return nil
+		})
+	})
+
+	if err != nil {
+		log.Println("Error in ListUsers: ", err)
+		return nil
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeen > result[j].LastSeen
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//DeleteGame implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) DeleteGame(id string) error {
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		gBucket := tx.Bucket(gamesBucket)
+
+		if gBucket == nil {
+			return errors.New("Couldn't open games bucket")
+		}
+
+		if gBucket.Get(keyForGame(id)) == nil {
+			return errors.New("No such game")
+		}
+
+		if err := gBucket.Delete(keyForGame(id)); err != nil {
+			return err
+		}
+
+		for _, bucketName := range [][]byte{extendedGamesBucket, gameUsersBucket} {
+			bucket := tx.Bucket(bucketName)
+			if bucket == nil {
+				return errors.New("Couldn't open " + string(bucketName) + " bucket")
+			}
+			if err := bucket.Delete(keyForGame(id)); err != nil {
+				return err
+			}
+		}
+
+		//States, moves, and agent states are keyed by the game ID followed
+		//by a separator and the version or player.
+		prefixes := map[string][]byte{
+			string(statesBucket):      []byte(id + "_"),
+			string(movesBucket):       []byte(id + "_"),
+			string(agentStatesBucket): []byte(id + "-"),
+		}
+
+		for bucketName, prefix := range prefixes {
+			bucket := tx.Bucket([]byte(bucketName))
+			if bucket == nil {
+				return errors.New("Couldn't open " + bucketName + " bucket")
+			}
+			var keys [][]byte
+			c := bucket.Cursor()
+			for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
+				keys = append(keys, k)
+			}
+			for _, k := range keys {
+				if err := bucket.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+//DeleteUser implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) DeleteUser(uid string) error {
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		uBucket := tx.Bucket(usersBucket)
+
+		if uBucket == nil {
+			return errors.New("Couldn't open users bucket")
+		}
+
+		if uBucket.Get(keyForUser(uid)) == nil {
+			return errors.New("No such user")
+		}
+
+		if err := uBucket.Delete(keyForUser(uid)); err != nil {
+			return err
+		}
+
+		cBucket := tx.Bucket(cookiesBucket)
+
+		if cBucket == nil {
+			return errors.New("Couldn't open cookies bucket")
+		}
+
+		var keys [][]byte
+
+		err := cBucket.ForEach(func(k, v []byte) error {
+			if string(v) == string(keyForUser(uid)) {
+				keys = append(keys, k)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			if err := cBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		gUBucket := tx.Bucket(gameUsersBucket)
+
+		if gUBucket == nil {
+			return errors.New("Couldn't open game users bucket")
+		}
+
+		updates := make(map[string][]byte)
+
+		err = gUBucket.ForEach(func(k, v []byte) error {
+			var ids []string
+			if err := json.Unmarshal(v, &ids); err != nil {
+				return errors.New("Couldn't unmarshal ids blob: " + err.Error())
+			}
+			found := false
+			for i, id := range ids {
+				if id == uid {
+					ids[i] = users.DeletedUserID
+					found = true
+				}
+			}
+			if !found {
+				return nil
+			}
+			blob, err := json.Marshal(ids)
+			if err != nil {
+				return errors.New("Unable to marshal ids blob: " + err.Error())
+			}
+			updates[string(k)] = blob
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range updates {
+			if err := gUBucket.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		eBucket := tx.Bucket(extendedGamesBucket)
+
+		if eBucket == nil {
+			return errors.New("Couldn't open extended games bucket")
+		}
+
+		updates = make(map[string][]byte)
+
+		err = eBucket.ForEach(func(k, v []byte) error {
+			var eGame extendedgame.StorageRecord
+			if err := json.Unmarshal(v, &eGame); err != nil {
+				return errors.New("Couldn't unmarshal extended game: " + err.Error())
+			}
+			if eGame.Owner != uid {
+				return nil
+			}
+			eGame.Owner = users.DeletedUserID
+			blob, err := json.Marshal(eGame)
+			if err != nil {
+				return errors.New("Couldn't marshal extended game: " + err.Error())
+			}
+			updates[string(k)] = blob
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range updates {
+			if err := eBucket.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		tBucket := tx.Bucket(tournamentsBucket)
+
+		if tBucket == nil {
+			return errors.New("Couldn't open tournaments bucket")
+		}
+
+		updates = make(map[string][]byte)
+
+		err = tBucket.ForEach(func(k, v []byte) error {
+			var tournament tournaments.StorageRecord
+			if err := json.Unmarshal(v, &tournament); err != nil {
+				return errors.New("Couldn't unmarshal tournament: " + err.Error())
+			}
+			if !tournament.RemoveUser(uid) {
+				return nil
+			}
+			blob, err := json.Marshal(tournament)
+			if err != nil {
+				return errors.New("Couldn't marshal tournament: " + err.Error())
+			}
+			updates[string(k)] = blob
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range updates {
+			if err := tBucket.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		//Ratings are keyed by game name first, and history by sequence, so
+		//we have to look at every record.
+		for _, bucketName := range [][]byte{ratingsBucket, ratingHistoryBucket} {
+			bucket := tx.Bucket(bucketName)
+			if bucket == nil {
+				return errors.New("Couldn't open " + string(bucketName) + " bucket")
+			}
+			keys = nil
+			err := bucket.ForEach(func(k, v []byte) error {
+				var record struct {
+					UserID string
+				}
+				if err := json.Unmarshal(v, &record); err != nil {
+					return errors.New("Couldn't unmarshal rating: " + err.Error())
+				}
+				if record.UserID == uid {
+					keys = append(keys, k)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				if err := bucket.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+//AddAuditRecord implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) AddAuditRecord(record *audit.StorageRecord) error {
+
+	if record == nil {
+		return errors.New("No record provided")
+	}
+
+	blob, err := json.Marshal(record)
+
+	if err != nil {
+		return errors.New("Couldn't marshal audit record: " + err.Error())
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		aBucket := tx.Bucket(auditBucket)
+
+		if aBucket == nil {
+			return errors.New("Couldn't open audit bucket")
+		}
+
+		sequence, err := aBucket.NextSequence()
+
+		if err != nil {
+			return err
+		}
+
+		return aBucket.Put(keyForAudit(sequence), blob)
+	})
+}
+
+//AuditRecords implements that method from the server api storagemanager
+//interface
+func (s *StorageManager) AuditRecords(max int) []*audit.StorageRecord {
+
+	var result []*audit.StorageRecord
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		aBucket := tx.Bucket(auditBucket)
+
+		if aBucket == nil {
+			return errors.New("Couldn't open audit bucket")
+		}
+
+		c := aBucket.Cursor()
+
+		//Walk backwards so the most recent records come first.
+		for k, v := c.Last(); k != nil && len(result) < max; k, v = c.Prev() {
+			var record audit.StorageRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return errors.New("Couldn't deserialize an audit record: " + err.Error())
+			}
+			result = append(result, &record)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Println("Error in AuditRecords: ", err)
+		return nil
+	}
+
+	return result
+}
+
 //Connect is a no op
 func (s *StorageManager) Connect(config string) error {
 	return nil
@@ -268,6 +268,51 @@ func (s *StorageManager) SaveGameAndCurrentState(game *boardgame.GameStorageReco
 
 }
 
+//UpdateGame updates the game record without adding a new state.
+func (s *StorageManager) UpdateGame(game *boardgame.GameStorageRecord) error {
+	rec, err := s.RecordForID(game.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := rec.SetGame(game); err != nil {
+		return err
+	}
+
+	return s.saveRecordForID(game.ID, rec)
+}
+
+//DeleteGame removes the game's file, along with everything else stored about
+//it.
+func (s *StorageManager) DeleteGame(id string) error {
+
+	fileID := strings.ToLower(id)
+
+	if s.DebugNoDisk {
+		if _, ok := s.records[fileID]; !ok {
+			return errors.New("No record with that ID has been saved: " + fileID)
+		}
+		delete(s.records, fileID)
+	} else {
+		path := pathForID(s.basePath, fileID)
+
+		if path == "" {
+			return errors.New("Couldn't find file matching: " + fileID)
+		}
+
+		if err := record.Delete(path); err != nil {
+			return err
+		}
+
+		delete(idToPath, fileID)
+	}
+
+	s.DeleteExtendedGame(id)
+
+	return nil
+}
+
 //CombinedGame returns the combined game
 func (s *StorageManager) CombinedGame(id string) (*extendedgame.CombinedStorageRecord, error) {
 	rec, err := s.RecordForID(id)
@@ -347,6 +347,23 @@ func (r *Record) Game() *boardgame.GameStorageRecord {
 	return r.data.Game
 }
 
+//SetGame overwrites the GameStorageRecord in the record, for when game-level
+//fields change without a new state being added. It may not be used to change
+//the version.
+func (r *Record) SetGame(game *boardgame.GameStorageRecord) error {
+	if r.data == nil || r.data.Game == nil {
+		return errors.New("No existing game in record")
+	}
+	if game == nil {
+		return errors.New("No game provided")
+	}
+	if game.Version != r.data.Game.Version {
+		return errors.New("SetGame may not change the version of the game")
+	}
+	r.data.Game = game
+	return nil
+}
+
 //SetDescription allows you to set the description that will be written.
 func (r *Record) SetDescription(description string) {
 	if r.data == nil {
@@ -491,6 +508,16 @@ func (r *Record) Save(filename string, fullEncodingErrors bool) error {
 	return nil
 }
 
+//Delete removes the file at the given path, as well as any record for it in
+//the cache.
+func Delete(filename string) error {
+	delete(recCache, filename)
+	if err := os.Remove(filename); err != nil {
+		return errors.New("Couldn't delete file: " + err.Error())
+	}
+	return nil
+}
+
 //AddGameAndCurrentState adds the game, state, and move (if non-nil), ready
 //for saving. Designed to be used in a SaveGameAndCurrentState method. If the
 //state cannot be succcesfully encoded as a diffed encoding (due to an
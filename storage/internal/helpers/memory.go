@@ -2,10 +2,15 @@ package helpers
 
 import (
 	"errors"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 )
 
@@ -26,11 +31,18 @@ type ExtendedMemoryStorageManager struct {
 	usersByID     map[string]*users.StorageRecord
 	usersByCookie map[string]*users.StorageRecord
 	usersForGames map[string][]string
+	ratings       map[string]*ratings.StorageRecord
+	ratingHistory []*ratings.HistoryRecord
+	tournaments   map[string]*tournaments.StorageRecord
+	auditLog      []*audit.StorageRecord
 
 	agentStatesLock   sync.RWMutex
 	extendedGamesLock sync.RWMutex
 	usersLock         sync.RWMutex
 	usersForGamesLock sync.RWMutex
+	ratingsLock       sync.RWMutex
+	tournamentsLock   sync.RWMutex
+	auditLock         sync.RWMutex
 
 	gameChecker GameChecker
 }
@@ -49,6 +61,8 @@ func NewExtendedMemoryStorageManager(checker GameChecker) *ExtendedMemoryStorage
 		usersByCookie: make(map[string]*users.StorageRecord),
 		usersForGames: make(map[string][]string),
 		agentStates:   make(map[string][]byte),
+		ratings:       make(map[string]*ratings.StorageRecord),
+		tournaments:   make(map[string]*tournaments.StorageRecord),
 		gameChecker:   checker,
 	}
 }
@@ -103,6 +117,15 @@ func (s *ExtendedMemoryStorageManager) CombinedGame(id string) (*extendedgame.Co
 	return result, nil
 }
 
+//copyExtendedGame returns a copy of eGame that shares no slices with it, so
+//callers can modify what they're given without touching what's stored.
+func copyExtendedGame(eGame *extendedgame.StorageRecord) *extendedgame.StorageRecord {
+	result := *eGame
+	result.Invites = append([]*extendedgame.Invite(nil), eGame.Invites...)
+	result.SeatLastMove = append([]int64(nil), eGame.SeatLastMove...)
+	return &result
+}
+
 //ExtendedGame will return extendedgame.DefaultStorageRecord() if the
 //associated game exists.
 func (s *ExtendedMemoryStorageManager) ExtendedGame(id string) (*extendedgame.StorageRecord, error) {
@@ -119,12 +142,34 @@ func (s *ExtendedMemoryStorageManager) ExtendedGame(id string) (*extendedgame.St
 		return nil, errors.New("No such extended game")
 	}
 
-	return eGame, nil
+	return copyExtendedGame(eGame), nil
 }
 
 //UpdateExtendedGame implements that part of the server storage interface
 func (s *ExtendedMemoryStorageManager) UpdateExtendedGame(id string, eGame *extendedgame.StorageRecord) error {
+	eGame = copyExtendedGame(eGame)
+	s.extendedGamesLock.Lock()
+	eGame.InactivityClock = extendedgame.InactivityClock{}
+	if existing := s.extendedGames[id]; existing != nil {
+		eGame.InactivityClock = existing.InactivityClock
+	}
+	s.extendedGames[id] = eGame
+	s.extendedGamesLock.Unlock()
+	return nil
+}
+
+//UpdateInactivityClock implements that part of the server storage interface
+func (s *ExtendedMemoryStorageManager) UpdateInactivityClock(id string, clock *extendedgame.InactivityClock) error {
+	eGame, err := s.ExtendedGame(id)
+	if err != nil {
+		return err
+	}
 	s.extendedGamesLock.Lock()
+	if existing := s.extendedGames[id]; existing != nil {
+		eGame = copyExtendedGame(existing)
+	}
+	eGame.InactivityClock = *clock
+	eGame.SeatLastMove = append([]int64(nil), clock.SeatLastMove...)
 	s.extendedGames[id] = eGame
 	s.extendedGamesLock.Unlock()
 	return nil
@@ -155,13 +200,12 @@ func (s *ExtendedMemoryStorageManager) SetPlayerForGame(gameID string, playerInd
 		return errors.New("PlayerIndex " + playerIndex.String() + " is not valid for this game.")
 	}
 
-	if ids[playerIndex] != "" {
+	//A userID of "" clears the seat, which is always allowed.
+	if userID != "" && ids[playerIndex] != "" {
 		return errors.New("PlayerIndex " + playerIndex.String() + " is already taken.")
 	}
 
-	user := s.GetUserByID(userID)
-
-	if user == nil {
+	if userID != "" && s.GetUserByID(userID) == nil {
 		return errors.New("That uid does not describe an existing user")
 	}
 
@@ -227,6 +271,302 @@ func (s *ExtendedMemoryStorageManager) ConnectCookieToUser(cookie string, user *
 	return nil
 }
 
+func keyForRating(userID string, gameName string) string {
+	return gameName + "-" + userID
+}
+
+//Rating implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) Rating(userID string, gameName string) (*ratings.StorageRecord, error) {
+	s.ratingsLock.RLock()
+	record := s.ratings[keyForRating(userID, gameName)]
+	s.ratingsLock.RUnlock()
+
+	if record == nil {
+		return ratings.DefaultStorageRecord(userID, gameName), nil
+	}
+
+	//Return a copy so callers modifying it don't modify our copy.
+	result := *record
+
+	return &result, nil
+}
+
+//UpdateRating implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) UpdateRating(rating *ratings.StorageRecord, history *ratings.HistoryRecord) error {
+	if rating == nil {
+		return errors.New("No rating provided")
+	}
+
+	record := *rating
+
+	s.ratingsLock.Lock()
+	s.ratings[keyForRating(rating.UserID, rating.GameName)] = &record
+	if history != nil {
+		s.ratingHistory = append(s.ratingHistory, history)
+	}
+	s.ratingsLock.Unlock()
+
+	return nil
+}
+
+//ListRatings implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) ListRatings(gameName string, max int) []*ratings.StorageRecord {
+	var result []*ratings.StorageRecord
+
+	s.ratingsLock.RLock()
+	for _, record := range s.ratings {
+		if record.GameName != gameName {
+			continue
+		}
+		result = append(result, record)
+	}
+	s.ratingsLock.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Rating > result[j].Rating
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//RatingHistory implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) RatingHistory(userID string, gameName string, max int) []*ratings.HistoryRecord {
+	var result []*ratings.HistoryRecord
+
+	s.ratingsLock.RLock()
+	//Walk backwards so the most recent records come first.
+	for i := len(s.ratingHistory) - 1; i >= 0; i-- {
+		record := s.ratingHistory[i]
+		if record.UserID != userID {
+			continue
+		}
+		if gameName != "" && record.GameName != gameName {
+			continue
+		}
+		result = append(result, record)
+		if len(result) >= max {
+			break
+		}
+	}
+	s.ratingsLock.RUnlock()
+
+	return result
+}
+
+//DueInactivityChecks implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) DueInactivityChecks(now int64) []string {
+	var result []string
+
+	s.extendedGamesLock.RLock()
+	for id, eGame := range s.extendedGames {
+		if eGame.InactivityDeadline != 0 && eGame.InactivityDeadline <= now {
+			result = append(result, id)
+		}
+	}
+	s.extendedGamesLock.RUnlock()
+
+	sort.Strings(result)
+
+	return result
+}
+
+//Tournament implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) Tournament(id string) (*tournaments.StorageRecord, error) {
+	s.tournamentsLock.RLock()
+	record := s.tournaments[id]
+	s.tournamentsLock.RUnlock()
+
+	if record == nil {
+		return nil, errors.New("No such tournament")
+	}
+
+	//Return a copy so callers modifying it don't modify our copy.
+	return record.Copy(), nil
+}
+
+//UpdateTournament implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) UpdateTournament(tournament *tournaments.StorageRecord) error {
+	if tournament == nil {
+		return errors.New("No tournament provided")
+	}
+
+	record := tournament.Copy()
+
+	s.tournamentsLock.Lock()
+	s.tournaments[record.ID] = record
+	s.tournamentsLock.Unlock()
+
+	return nil
+}
+
+//ListTournaments implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) ListTournaments(max int, gameName string) []*tournaments.StorageRecord {
+	var result []*tournaments.StorageRecord
+
+	s.tournamentsLock.RLock()
+	for _, record := range s.tournaments {
+		if gameName != "" && record.GameName != gameName {
+			continue
+		}
+		result = append(result, record.Copy())
+	}
+	s.tournamentsLock.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Created > result[j].Created
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//ListUsers implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) ListUsers(max int, query string) []*users.StorageRecord {
+	var result []*users.StorageRecord
+
+	query = strings.ToLower(query)
+
+	s.usersLock.RLock()
+	for _, user := range s.usersByID {
+		if query != "" &&
+			!strings.Contains(strings.ToLower(user.ID), query) &&
+			!strings.Contains(strings.ToLower(user.DisplayName), query) &&
+			!strings.Contains(strings.ToLower(user.Email), query) {
+			continue
+		}
+		result = append(result, user)
+	}
+	s.usersLock.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeen > result[j].LastSeen
+	})
+
+	if len(result) > max {
+		result = result[:max]
+	}
+
+	return result
+}
+
+//DeleteExtendedGame removes the extended record, seat assignments and agent
+//states for the given game. Storage managers that embed this should call it
+//from their DeleteGame.
+func (s *ExtendedMemoryStorageManager) DeleteExtendedGame(gameID string) {
+	s.extendedGamesLock.Lock()
+	delete(s.extendedGames, gameID)
+	s.extendedGamesLock.Unlock()
+
+	s.usersForGamesLock.Lock()
+	delete(s.usersForGames, gameID)
+	s.usersForGamesLock.Unlock()
+
+	prefix := keyForAgent(gameID, 0)
+	prefix = prefix[:len(prefix)-1]
+
+	s.agentStatesLock.Lock()
+	for key := range s.agentStates {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.agentStates, key)
+		}
+	}
+	s.agentStatesLock.Unlock()
+}
+
+//DeleteUser implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) DeleteUser(uid string) error {
+	s.usersLock.Lock()
+	_, ok := s.usersByID[uid]
+	delete(s.usersByID, uid)
+	for cookie, user := range s.usersByCookie {
+		if user.ID == uid {
+			delete(s.usersByCookie, cookie)
+		}
+	}
+	s.usersLock.Unlock()
+
+	if !ok {
+		return errors.New("No such user")
+	}
+
+	s.usersForGamesLock.Lock()
+	for _, ids := range s.usersForGames {
+		for i, id := range ids {
+			if id == uid {
+				ids[i] = users.DeletedUserID
+			}
+		}
+	}
+	s.usersForGamesLock.Unlock()
+
+	s.extendedGamesLock.Lock()
+	for _, eGame := range s.extendedGames {
+		if eGame.Owner == uid {
+			eGame.Owner = users.DeletedUserID
+		}
+	}
+	s.extendedGamesLock.Unlock()
+
+	s.tournamentsLock.Lock()
+	for _, tournament := range s.tournaments {
+		tournament.RemoveUser(uid)
+	}
+	s.tournamentsLock.Unlock()
+
+	s.ratingsLock.Lock()
+	for key, record := range s.ratings {
+		if record.UserID == uid {
+			delete(s.ratings, key)
+		}
+	}
+	var history []*ratings.HistoryRecord
+	for _, record := range s.ratingHistory {
+		if record.UserID != uid {
+			history = append(history, record)
+		}
+	}
+	s.ratingHistory = history
+	s.ratingsLock.Unlock()
+
+	return nil
+}
+
+//AddAuditRecord implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) AddAuditRecord(record *audit.StorageRecord) error {
+	if record == nil {
+		return errors.New("No record provided")
+	}
+
+	recordCopy := *record
+
+	s.auditLock.Lock()
+	s.auditLog = append(s.auditLog, &recordCopy)
+	s.auditLock.Unlock()
+
+	return nil
+}
+
+//AuditRecords implements that part of the server storage interface.
+func (s *ExtendedMemoryStorageManager) AuditRecords(max int) []*audit.StorageRecord {
+	var result []*audit.StorageRecord
+
+	s.auditLock.RLock()
+	//Walk backwards so the most recent records come first.
+	for i := len(s.auditLog) - 1; i >= 0 && len(result) < max; i-- {
+		result = append(result, s.auditLog[i])
+	}
+	s.auditLock.RUnlock()
+
+	return result
+}
+
 //Provide defaults for all of these that are no op
 
 //Connect is a no op
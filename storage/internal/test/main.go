@@ -20,16 +20,21 @@ import (
 	"github.com/jkomoros/boardgame/examples/blackjack"
 	"github.com/jkomoros/boardgame/examples/memory"
 	"github.com/jkomoros/boardgame/examples/tictactoe"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
 	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 	"github.com/workfit/tester/assert"
 )
 
 //StorageManager is the interface for everything that needs to be connected.
-//It's just api.StorageManager with one more method.
+//It's just api.StorageManager with one more method, and the optional
+//boardgame.GameUpdater, which every storage manager is expected to implement.
 type StorageManager interface {
 	api.StorageManager
+	boardgame.GameUpdater
 
 	//CleanUp will be called when a given manager is done and can be dispoed of.
 	CleanUp()
@@ -46,13 +51,18 @@ func (m managerMap) Get(name string) *boardgame.GameManager {
 type StorageManagerFactory func() StorageManager
 
 //Test is the primary entrypoint for this package, running BasicTest, UsersTest,
-//AgentsTest, and ListingTest.
+//AgentsTest, ListingTest, and RatingsTest.
 func Test(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
 
 	BasicTest(factory, testName, connectConfig, t)
 	UsersTest(factory, testName, connectConfig, t)
 	AgentsTest(factory, testName, connectConfig, t)
 	ListingTest(factory, testName, connectConfig, t)
+	RatingsTest(factory, testName, connectConfig, t)
+	InactivityTest(factory, testName, connectConfig, t)
+	TournamentsTest(factory, testName, connectConfig, t)
+	AdminTest(factory, testName, connectConfig, t)
+	DeleteUserTest(factory, testName, connectConfig, t)
 
 }
 
@@ -98,6 +108,12 @@ func BasicTest(factory StorageManagerFactory, testName string, connectConfig str
 
 	eGame.Owner = "Foo"
 
+	assert.For(t).ThatActual(eGame.SetPassword("secret")).IsNil()
+
+	invite, err := eGame.AddInvite(1)
+
+	assert.For(t).ThatActual(err).IsNil()
+
 	lastSeenTimestamp := tictactoeGame.Modified()
 
 	err = storage.UpdateExtendedGame(tictactoeGame.ID(), eGame)
@@ -110,6 +126,10 @@ func BasicTest(factory StorageManagerFactory, testName string, connectConfig str
 
 	assert.For(t).ThatActual(newEGame).Equals(eGame)
 
+	assert.For(t).ThatActual(newEGame.CheckPassword("secret")).IsTrue()
+
+	assert.For(t).ThatActual(newEGame.Invite(invite.Token)).Equals(invite)
+
 	move := tictactoeGame.MoveByName("Place Token")
 
 	if move == nil {
@@ -287,6 +307,21 @@ func UsersTest(factory StorageManagerFactory, testName string, connectConfig str
 
 	assert.For(t).ThatActual(fetchedUser).Equals(user)
 
+	notifyingUser := &users.StorageRecord{
+		ID:               userID,
+		Email:            "foo@example.com",
+		NotifyByEmail:    true,
+		NotifyWebhookURL: "https://example.com/hook",
+	}
+
+	err = storage.UpdateUser(notifyingUser)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	fetchedUser = storage.GetUserByID(userID)
+
+	assert.For(t).ThatActual(fetchedUser).Equals(notifyingUser)
+
 	err = storage.SetPlayerForGame(game.ID(), 0, userID)
 
 	assert.For(t).ThatActual(err).IsNil()
@@ -298,6 +333,18 @@ func UsersTest(factory StorageManagerFactory, testName string, connectConfig str
 	err = storage.SetPlayerForGame(game.ID(), 0, userID)
 
 	assert.For(t).ThatActual(err).IsNotNil()
+
+	err = storage.SetPlayerForGame(game.ID(), 0, "")
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	ids = storage.UserIDsForGame(game.ID())
+
+	assert.For(t).ThatActual(ids).Equals([]string{"", ""})
+
+	err = storage.SetPlayerForGame(game.ID(), 0, userID)
+
+	assert.For(t).ThatActual(err).IsNil()
 }
 
 //AgentsTest does the basic tests of Agents.
@@ -354,6 +401,31 @@ func AgentsTest(factory StorageManagerFactory, testName string, connectConfig st
 
 	assert.For(t).ThatActual(refriedBlob).Equals(newBlob)
 
+	gameRecord, err := storage.Game(game.ID())
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	updatedRecord := *gameRecord
+	updatedRecord.Agents = []string{"ai", ""}
+
+	err = storage.UpdateGame(&updatedRecord)
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	refriedRecord, err := storage.Game(game.ID())
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	assert.For(t).ThatActual(refriedRecord.Agents).Equals([]string{"ai", ""})
+	assert.For(t).ThatActual(refriedRecord.Version).Equals(gameRecord.Version)
+
+	missingRecord := updatedRecord
+	missingRecord.ID = "DOESNOTEXIST"
+
+	err = storage.UpdateGame(&missingRecord)
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
 }
 
 //ListingTest does the basic tests of Listing.
@@ -726,6 +798,454 @@ func ListingTest(factory StorageManagerFactory, testName string, connectConfig s
 
 }
 
+//RatingsTest does the basic tests of Ratings.
+func RatingsTest(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
+
+	storage := factory()
+
+	defer storage.Close()
+	defer storage.CleanUp()
+
+	if err := storage.Connect(connectConfig); err != nil {
+		t.Fatal("Err connecting to storage: ", err)
+	}
+
+	rating, err := storage.Rating("Foo", "tictactoe")
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(rating).Equals(ratings.DefaultStorageRecord("Foo", "tictactoe"))
+
+	fooRating := &ratings.StorageRecord{
+		UserID:      "Foo",
+		GameName:    "tictactoe",
+		Rating:      1516,
+		NumGames:    1,
+		NumWins:     1,
+		LastUpdated: 10,
+	}
+
+	barRating := &ratings.StorageRecord{
+		UserID:      "Bar",
+		GameName:    "tictactoe",
+		Rating:      1484,
+		NumGames:    1,
+		LastUpdated: 10,
+	}
+
+	otherGameRating := &ratings.StorageRecord{
+		UserID:      "Foo",
+		GameName:    "blackjack",
+		Rating:      1600,
+		NumGames:    3,
+		NumWins:     3,
+		LastUpdated: 20,
+	}
+
+	fooHistory := &ratings.HistoryRecord{
+		UserID:    "Foo",
+		GameName:  "tictactoe",
+		GameID:    "ABC",
+		Rating:    1516,
+		Delta:     16,
+		Won:       true,
+		Timestamp: 10,
+	}
+
+	barHistory := &ratings.HistoryRecord{
+		UserID:    "Bar",
+		GameName:  "tictactoe",
+		GameID:    "ABC",
+		Rating:    1484,
+		Delta:     -16,
+		Timestamp: 10,
+	}
+
+	otherGameHistory := &ratings.HistoryRecord{
+		UserID:    "Foo",
+		GameName:  "blackjack",
+		GameID:    "DEF",
+		Rating:    1600,
+		Delta:     20,
+		Won:       true,
+		Timestamp: 20,
+	}
+
+	assert.For(t).ThatActual(storage.UpdateRating(fooRating, fooHistory)).IsNil()
+	assert.For(t).ThatActual(storage.UpdateRating(barRating, barHistory)).IsNil()
+	assert.For(t).ThatActual(storage.UpdateRating(otherGameRating, otherGameHistory)).IsNil()
+
+	rating, err = storage.Rating("Foo", "tictactoe")
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(rating).Equals(fooRating)
+
+	assert.For(t).ThatActual(storage.ListRatings("tictactoe", 10)).Equals([]*ratings.StorageRecord{fooRating, barRating})
+	assert.For(t).ThatActual(storage.ListRatings("tictactoe", 1)).Equals([]*ratings.StorageRecord{fooRating})
+
+	assert.For(t).ThatActual(storage.RatingHistory("Foo", "", 10)).Equals([]*ratings.HistoryRecord{otherGameHistory, fooHistory})
+	assert.For(t).ThatActual(storage.RatingHistory("Foo", "tictactoe", 10)).Equals([]*ratings.HistoryRecord{fooHistory})
+
+	//Updating an existing rating should overwrite it, not add a new one.
+	updatedBarRating := &ratings.StorageRecord{
+		UserID:      "Bar",
+		GameName:    "tictactoe",
+		Rating:      1550,
+		NumGames:    2,
+		NumWins:     1,
+		LastUpdated: 30,
+	}
+
+	assert.For(t).ThatActual(storage.UpdateRating(updatedBarRating, nil)).IsNil()
+
+	assert.For(t).ThatActual(storage.ListRatings("tictactoe", 10)).Equals([]*ratings.StorageRecord{updatedBarRating, fooRating})
+	assert.For(t).ThatActual(storage.RatingHistory("Bar", "", 10)).Equals([]*ratings.HistoryRecord{barHistory})
+
+}
+
+//InactivityTest tests that inactivity deadlines are stored and queried
+//correctly.
+func InactivityTest(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
+
+	storage := factory()
+
+	defer storage.Close()
+	defer storage.CleanUp()
+
+	if err := storage.Connect(connectConfig); err != nil {
+		t.Fatal("Err connecting to storage: ", err)
+	}
+
+	manager, _ := boardgame.NewGameManager(tictactoe.NewDelegate(), storage)
+
+	var ids []string
+
+	for i := 0; i < 3; i++ {
+		game, err := manager.NewDefaultGame()
+		if err != nil {
+			t.Fatal(testName, "Couldn't create game", err)
+		}
+		ids = append(ids, game.ID())
+	}
+
+	assert.For(t).ThatActual(len(storage.DueInactivityChecks(1000))).Equals(0)
+
+	for i, deadline := range []int64{500, 1500, 0} {
+		assert.For(t).ThatActual(storage.UpdateInactivityClock(ids[i], &extendedgame.InactivityClock{
+			SeatLastMove:       []int64{100, 0},
+			TurnStarted:        100,
+			InactivityDeadline: deadline,
+			InactivityWarned:   i == 0,
+		})).IsNil()
+	}
+
+	eGame, err := storage.ExtendedGame(ids[0])
+
+	assert.For(t).ThatActual(err).IsNil()
+
+	//UpdateExtendedGame shouldn't touch the clock, even with a stale copy.
+	staleGame := *eGame
+	staleGame.InactivityClock = extendedgame.InactivityClock{}
+	staleGame.Open = !eGame.Open
+
+	assert.For(t).ThatActual(storage.UpdateExtendedGame(ids[0], &staleGame)).IsNil()
+
+	eGame, err = storage.ExtendedGame(ids[0])
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(eGame.Open).Equals(staleGame.Open)
+	assert.For(t).ThatActual(eGame.SeatLastMove).Equals([]int64{100, 0})
+	assert.For(t).ThatActual(eGame.TurnStarted).Equals(int64(100))
+	assert.For(t).ThatActual(eGame.InactivityDeadline).Equals(int64(500))
+	assert.For(t).ThatActual(eGame.InactivityWarned).IsTrue()
+
+	assert.For(t).ThatActual(storage.DueInactivityChecks(1000)).Equals([]string{ids[0]})
+
+	due := storage.DueInactivityChecks(2000)
+	sort.Strings(due)
+
+	expected := []string{ids[0], ids[1]}
+	sort.Strings(expected)
+
+	assert.For(t).ThatActual(due).Equals(expected)
+
+}
+
+//TournamentsTest tests storing and listing tournaments, and that games
+//remember which tournament they're part of.
+func TournamentsTest(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
+
+	storage := factory()
+
+	defer storage.Close()
+	defer storage.CleanUp()
+
+	if err := storage.Connect(connectConfig); err != nil {
+		t.Fatal("Err connecting to storage: ", err)
+	}
+
+	_, err := storage.Tournament("missing")
+
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	first, err := tournaments.New("First", "tictactoe", tournaments.RoundRobin, "owner")
+	assert.For(t).ThatActual(err).IsNil()
+	first.Created = 100
+
+	second, err := tournaments.New("Second", "memory", tournaments.Swiss, "owner")
+	assert.For(t).ThatActual(err).IsNil()
+	second.Created = 200
+
+	assert.For(t).ThatActual(storage.UpdateTournament(first)).IsNil()
+	assert.For(t).ThatActual(storage.UpdateTournament(second)).IsNil()
+
+	assert.For(t).ThatActual(first.Register("a")).IsNil()
+	assert.For(t).ThatActual(first.Register("b")).IsNil()
+
+	_, err = first.Start()
+	assert.For(t).ThatActual(err).IsNil()
+
+	first.Matches[0].GameID = "game"
+	first.Version++
+
+	assert.For(t).ThatActual(storage.UpdateTournament(first)).IsNil()
+
+	fetched, err := storage.Tournament(first.ID)
+
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(fetched).Equals(first)
+
+	list := storage.ListTournaments(10, "")
+
+	assert.For(t).ThatActual(len(list)).Equals(2)
+	assert.For(t).ThatActual(list[0].ID).Equals(second.ID)
+	assert.For(t).ThatActual(list[1].ID).Equals(first.ID)
+
+	list = storage.ListTournaments(10, "tictactoe")
+
+	assert.For(t).ThatActual(len(list)).Equals(1)
+	assert.For(t).ThatActual(list[0].ID).Equals(first.ID)
+
+	assert.For(t).ThatActual(len(storage.ListTournaments(1, ""))).Equals(1)
+
+	manager, _ := boardgame.NewGameManager(tictactoe.NewDelegate(), storage)
+
+	game, err := manager.NewDefaultGame()
+
+	if err != nil {
+		t.Fatal(testName, "Couldn't create game", err)
+	}
+
+	eGame, err := storage.ExtendedGame(game.ID())
+	assert.For(t).ThatActual(err).IsNil()
+	eGame.TournamentID = first.ID
+	assert.For(t).ThatActual(storage.UpdateExtendedGame(game.ID(), eGame)).IsNil()
+
+	eGame, err = storage.ExtendedGame(game.ID())
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(eGame.TournamentID).Equals(first.ID)
+
+}
+
+//AdminTest tests the methods the admin console relies on: listing users,
+//deleting games, and the audit log.
+func AdminTest(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
+
+	storage := factory()
+
+	defer storage.Close()
+	defer storage.CleanUp()
+
+	if err := storage.Connect(connectConfig); err != nil {
+		t.Fatal("Err connecting to storage: ", err)
+	}
+
+	for i, user := range []*users.StorageRecord{
+		{ID: "alice", DisplayName: "Alice", Email: "alice@example.com"},
+		{ID: "bob", DisplayName: "Bob", Email: "bob@example.com", Banned: true},
+		{ID: "carol", DisplayName: "Carol Alison"},
+	} {
+		user.LastSeen = int64(i + 1)
+		assert.For(t).ThatActual(storage.UpdateUser(user)).IsNil()
+	}
+
+	assert.For(t).ThatActual(storage.GetUserByID("bob").Banned).IsTrue()
+
+	listIDs := func(max int, query string) []string {
+		var result []string
+		for _, user := range storage.ListUsers(max, query) {
+			result = append(result, user.ID)
+		}
+		return result
+	}
+
+	assert.For(t).ThatActual(listIDs(10, "")).Equals([]string{"carol", "bob", "alice"})
+	assert.For(t).ThatActual(listIDs(10, "ALI")).Equals([]string{"carol", "alice"})
+	assert.For(t).ThatActual(listIDs(10, "bob@")).Equals([]string{"bob"})
+	assert.For(t).ThatActual(listIDs(1, "")).Equals([]string{"carol"})
+
+	manager, _ := boardgame.NewGameManager(tictactoe.NewDelegate(), storage)
+
+	game, err := manager.NewGame(2, nil, nil)
+
+	if err != nil {
+		t.Fatal(testName, "Couldn't create game", err)
+	}
+
+	otherGame, err := manager.NewGame(2, nil, nil)
+
+	if err != nil {
+		t.Fatal(testName, "Couldn't create game", err)
+	}
+
+	assert.For(t).ThatActual(storage.SetPlayerForGame(game.ID(), 0, "alice")).IsNil()
+	assert.For(t).ThatActual(storage.SetPlayerForGame(otherGame.ID(), 0, "alice")).IsNil()
+	assert.For(t).ThatActual(storage.SaveAgentState(game.ID(), 1, []byte("{}"))).IsNil()
+
+	assert.For(t).ThatActual(storage.DeleteGame("missing")).IsNotNil()
+
+	assert.For(t).ThatActual(storage.DeleteGame(game.ID())).IsNil()
+
+	_, err = storage.Game(game.ID())
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	_, err = storage.State(game.ID(), 0)
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	_, err = storage.ExtendedGame(game.ID())
+	assert.For(t).ThatActual(err).IsNotNil()
+
+	agentState, _ := storage.AgentState(game.ID(), 1)
+	assert.For(t).ThatActual(len(agentState)).Equals(0)
+
+	assert.For(t).ThatActual(storage.DeleteGame(game.ID())).IsNotNil()
+
+	//Other games are untouched.
+	_, err = storage.Game(otherGame.ID())
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(storage.UserIDsForGame(otherGame.ID())).Equals([]string{"alice", ""})
+
+	assert.For(t).ThatActual(len(storage.AuditRecords(10))).Equals(0)
+
+	first := &audit.StorageRecord{
+		Timestamp: 100,
+		AdminID:   "alice",
+		Action:    audit.BanUser,
+		UserID:    "bob",
+	}
+
+	second := &audit.StorageRecord{
+		Timestamp: 200,
+		AdminID:   "alice",
+		Action:    audit.DeleteGame,
+		GameID:    game.ID(),
+		Details:   "Abandoned",
+	}
+
+	assert.For(t).ThatActual(storage.AddAuditRecord(first)).IsNil()
+	assert.For(t).ThatActual(storage.AddAuditRecord(second)).IsNil()
+
+	assert.For(t).ThatActual(storage.AuditRecords(10)).Equals([]*audit.StorageRecord{second, first})
+	assert.For(t).ThatActual(storage.AuditRecords(1)).Equals([]*audit.StorageRecord{second})
+
+}
+
+//DeleteUserTest tests that deleting a user removes everything about them but
+//leaves the games and tournaments they played in intact.
+func DeleteUserTest(factory StorageManagerFactory, testName string, connectConfig string, t *testing.T) {
+
+	storage := factory()
+
+	defer storage.Close()
+	defer storage.CleanUp()
+
+	if err := storage.Connect(connectConfig); err != nil {
+		t.Fatal("Err connecting to storage: ", err)
+	}
+
+	alice := &users.StorageRecord{ID: "alice", DisplayName: "Alice", Email: "alice@example.com"}
+	bob := &users.StorageRecord{ID: "bob", DisplayName: "Bob"}
+
+	assert.For(t).ThatActual(storage.UpdateUser(alice)).IsNil()
+	assert.For(t).ThatActual(storage.UpdateUser(bob)).IsNil()
+
+	assert.For(t).ThatActual(storage.ConnectCookieToUser("alicecookie", alice)).IsNil()
+	assert.For(t).ThatActual(storage.ConnectCookieToUser("alicecookie2", alice)).IsNil()
+	assert.For(t).ThatActual(storage.ConnectCookieToUser("bobcookie", bob)).IsNil()
+
+	manager, _ := boardgame.NewGameManager(tictactoe.NewDelegate(), storage)
+
+	game, err := manager.NewGame(2, nil, nil)
+
+	if err != nil {
+		t.Fatal(testName, "Couldn't create game", err)
+	}
+
+	assert.For(t).ThatActual(storage.SetPlayerForGame(game.ID(), 0, "alice")).IsNil()
+	assert.For(t).ThatActual(storage.SetPlayerForGame(game.ID(), 1, "bob")).IsNil()
+
+	eGame, err := storage.ExtendedGame(game.ID())
+	assert.For(t).ThatActual(err).IsNil()
+	eGame.Owner = "alice"
+	assert.For(t).ThatActual(storage.UpdateExtendedGame(game.ID(), eGame)).IsNil()
+
+	for _, userID := range []string{"alice", "bob"} {
+		rating := &ratings.StorageRecord{
+			UserID:   userID,
+			GameName: "tictactoe",
+			Rating:   1516,
+			NumGames: 1,
+		}
+		history := &ratings.HistoryRecord{
+			UserID:   userID,
+			GameName: "tictactoe",
+			GameID:   game.ID(),
+			Rating:   1516,
+			Delta:    16,
+		}
+		assert.For(t).ThatActual(storage.UpdateRating(rating, history)).IsNil()
+	}
+
+	tournament, err := tournaments.New("Test", "tictactoe", tournaments.Swiss, "alice")
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.Register("alice")).IsNil()
+	assert.For(t).ThatActual(tournament.Register("bob")).IsNil()
+	assert.For(t).ThatActual(storage.UpdateTournament(tournament)).IsNil()
+
+	assert.For(t).ThatActual(storage.DeleteUser("missing")).IsNotNil()
+
+	assert.For(t).ThatActual(storage.DeleteUser("alice")).IsNil()
+
+	assert.For(t).ThatActual(storage.GetUserByID("alice") == nil).IsTrue()
+	assert.For(t).ThatActual(storage.GetUserByCookie("alicecookie") == nil).IsTrue()
+	assert.For(t).ThatActual(storage.GetUserByCookie("alicecookie2") == nil).IsTrue()
+
+	rating, err := storage.Rating("alice", "tictactoe")
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(rating).Equals(ratings.DefaultStorageRecord("alice", "tictactoe"))
+	assert.For(t).ThatActual(len(storage.RatingHistory("alice", "", 10))).Equals(0)
+
+	//The game is still there, but no longer identifies alice.
+	assert.For(t).ThatActual(storage.UserIDsForGame(game.ID())).Equals([]string{users.DeletedUserID, "bob"})
+
+	eGame, err = storage.ExtendedGame(game.ID())
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(eGame.Owner).Equals(users.DeletedUserID)
+
+	tournament, err = storage.Tournament(tournament.ID)
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(tournament.Owner).Equals(users.DeletedUserID)
+	assert.For(t).ThatActual(tournament.Players).Equals([]string{users.DeletedUserID, "bob"})
+
+	//Bob is untouched.
+	assert.For(t).ThatActual(storage.GetUserByCookie("bobcookie").ID).Equals("bob")
+	rating, err = storage.Rating("bob", "tictactoe")
+	assert.For(t).ThatActual(err).IsNil()
+	assert.For(t).ThatActual(rating.Rating).Equals(1516.0)
+	assert.For(t).ThatActual(len(storage.RatingHistory("bob", "", 10))).Equals(1)
+
+	assert.For(t).ThatActual(storage.DeleteUser("alice")).IsNotNil()
+}
+
 func compareJSONObjects(in []byte, golden []byte, message string, t *testing.T) {
 
 	//recreated in boardgame/state_test.go
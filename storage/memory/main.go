@@ -132,6 +132,24 @@ func (s *StorageManager) Game(id string) (*boardgame.GameStorageRecord, error) {
 	return record, nil
 }
 
+//UpdateGame implements that part of the core storage interface
+func (s *StorageManager) UpdateGame(game *boardgame.GameStorageRecord) error {
+	if game == nil {
+		return errors.New("No game provided")
+	}
+
+	s.gamesLock.Lock()
+	defer s.gamesLock.Unlock()
+
+	if s.games[game.ID] == nil {
+		return errors.New("No such game")
+	}
+
+	s.games[game.ID] = game
+
+	return nil
+}
+
 //SaveGameAndCurrentState implements that part of the core storage interface
 func (s *StorageManager) SaveGameAndCurrentState(game *boardgame.GameStorageRecord, state boardgame.StateStorageRecord, move *boardgame.MoveStorageRecord) error {
 	if game == nil {
@@ -195,6 +213,31 @@ func (s *StorageManager) SaveGameAndCurrentState(game *boardgame.GameStorageReco
 	return nil
 }
 
+//DeleteGame implements that part of the server storage interface
+func (s *StorageManager) DeleteGame(id string) error {
+
+	s.gamesLock.Lock()
+	_, ok := s.games[id]
+	delete(s.games, id)
+	s.gamesLock.Unlock()
+
+	if !ok {
+		return errors.New("No such game")
+	}
+
+	s.statesLock.Lock()
+	delete(s.states, id)
+	s.statesLock.Unlock()
+
+	s.movesLock.Lock()
+	delete(s.moves, id)
+	s.movesLock.Unlock()
+
+	s.DeleteExtendedGame(id)
+
+	return nil
+}
+
 //AllGames implements the extra method that storage/internal/helpers needs.
 func (s *StorageManager) AllGames() []*boardgame.GameStorageRecord {
 	var result []*boardgame.GameStorageRecord
@@ -11,11 +11,15 @@ import (
 	"database/sql"
 	"errors"
 	"log"
+	"strings"
 
 	"github.com/go-gorp/gorp"
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
 	"github.com/jkomoros/boardgame/server/api/listing"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 	"github.com/jkomoros/boardgame/storage/mysql/connect"
 )
@@ -29,10 +33,23 @@ const (
 	tableCookies       = "cookies"
 	tablePlayers       = "players"
 	tableAgentStates   = "agentstates"
+	tableRatings       = "ratings"
+	tableRatingHistory = "ratinghistory"
+	tableTournaments   = "tournaments"
+	tableAudit         = "audit"
 )
 
 const baseCombinedSelectQuery = "select g.Name, g.ID, g.SecretSalt, g.Version, g.Winners, g.Finished, g.NumPlayers, g.Agents, " +
-	"g.Created, g.Modified, e.Open, e.Visible, e.Owner"
+	"g.Created, g.Modified, e.Open, e.Visible, e.Owner, e.PasswordHash"
+
+//inactivityClockColumns are the columns of tableExtendedGames that make up
+//extendedgame.InactivityClock.
+var inactivityClockColumns = map[string]bool{
+	"SeatLastMove":       true,
+	"TurnStarted":        true,
+	"InactivityDeadline": true,
+	"InactivityWarned":   true,
+}
 
 const baseCombinedFromQuery = "from " + tableGames + " g, " + tableExtendedGames + " e"
 
@@ -113,6 +130,10 @@ func (s *StorageManager) Connect(config string) error {
 	s.dbMap.AddTableWithName(playerStorageRecord{}, tablePlayers).SetKeys(true, "ID")
 	s.dbMap.AddTableWithName(agentStateStorageRecord{}, tableAgentStates).SetKeys(true, "ID")
 	s.dbMap.AddTableWithName(moveStorageRecord{}, tableMoves).SetKeys(true, "ID")
+	s.dbMap.AddTableWithName(ratingStorageRecord{}, tableRatings).SetKeys(true, "ID")
+	s.dbMap.AddTableWithName(ratingHistoryStorageRecord{}, tableRatingHistory).SetKeys(true, "ID")
+	s.dbMap.AddTableWithName(tournamentStorageRecord{}, tableTournaments).SetKeys(false, "ID")
+	s.dbMap.AddTableWithName(auditStorageRecord{}, tableAudit).SetKeys(true, "ID")
 
 	_, err = s.dbMap.SelectInt("select count(*) from " + tableGames)
 
@@ -389,6 +410,31 @@ func (s *StorageManager) SaveAgentState(gameID string, player boardgame.PlayerIn
 	return nil
 }
 
+//UpdateGame updates the given game without adding a new state or move.
+func (s *StorageManager) UpdateGame(game *boardgame.GameStorageRecord) error {
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	//Update's count is rows changed, which is 0 if nothing about the game
+	//changed, so check that it exists separately.
+	count, err := s.dbMap.SelectInt("select count(*) from "+tableGames+" where ID=?", game.ID)
+
+	if err != nil {
+		return errors.New("Couldn't check for game: " + err.Error())
+	}
+
+	if count < 1 {
+		return errors.New("No such game")
+	}
+
+	if _, err := s.dbMap.Update(newGameStorageRecord(game)); err != nil {
+		return errors.New("Couldn't update game: " + err.Error())
+	}
+
+	return nil
+}
+
 //UpdateExtendedGame updates the given extended game properties
 func (s *StorageManager) UpdateExtendedGame(id string, eGame *extendedgame.StorageRecord) error {
 
@@ -399,11 +445,45 @@ func (s *StorageManager) UpdateExtendedGame(id string, eGame *extendedgame.Stora
 	record := newExtendedGameStorageRecord(eGame)
 	record.ID = id
 
-	_, err := s.dbMap.Update(record)
+	//The clock is only changed by UpdateInactivityClock.
+	_, err := s.dbMap.UpdateColumns(func(col *gorp.ColumnMap) bool {
+		return !inactivityClockColumns[col.ColumnName]
+	}, record)
 
 	return err
 }
 
+//UpdateInactivityClock updates just the inactivity clock columns of the
+//given extended game.
+func (s *StorageManager) UpdateInactivityClock(id string, clock *extendedgame.InactivityClock) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	_, err := s.dbMap.Exec("update "+tableExtendedGames+" set SeatLastMove=?, TurnStarted=?, InactivityDeadline=?, InactivityWarned=? where ID=?", timestampsToString(clock.SeatLastMove), clock.TurnStarted, clock.InactivityDeadline, clock.InactivityWarned, id)
+
+	return err
+}
+
+//DueInactivityChecks returns the IDs of games whose inactivity deadline has
+//passed
+func (s *StorageManager) DueInactivityChecks(now int64) []string {
+
+	if !s.connected {
+		return nil
+	}
+
+	var ids []string
+
+	if _, err := s.dbMap.Select(&ids, "select ID from "+tableExtendedGames+" where InactivityDeadline > 0 and InactivityDeadline <= ?", now); err != nil {
+		log.Println("Due inactivity checks failed: " + err.Error())
+		return nil
+	}
+
+	return ids
+}
+
 //ListGames lists the given games
 func (s *StorageManager) ListGames(max int, list listing.Type, userID string, gameType string) []*extendedgame.CombinedStorageRecord {
 
@@ -525,6 +605,11 @@ func (s *StorageManager) SetPlayerForGame(gameID string, playerIndex boardgame.P
 		return errors.New("Failed to retrieve existing Player line: " + err.Error())
 	}
 
+	//A userID of "" clears the seat, which is always allowed.
+	if userID != "" && player.UserID != "" {
+		return errors.New("PlayerIndex " + playerIndex.String() + " is already taken")
+	}
+
 	player.UserID = userID
 
 	_, err = s.dbMap.Update(player)
@@ -721,6 +806,425 @@ func (s *StorageManager) ConnectCookieToUser(cookie string, user *users.StorageR
 	return nil
 }
 
+//Rating returns the rating for the given user and game type
+func (s *StorageManager) Rating(userID string, gameName string) (*ratings.StorageRecord, error) {
+
+	if !s.connected {
+		return nil, errors.New("Database not connected yet")
+	}
+
+	var record ratingStorageRecord
+
+	err := s.dbMap.SelectOne(&record, "select * from "+tableRatings+" where UserID=? and GameName=?", userID, gameName)
+
+	if err == sql.ErrNoRows {
+		return ratings.DefaultStorageRecord(userID, gameName), nil
+	}
+
+	if err != nil {
+		return nil, errors.New("Unexpected error: " + err.Error())
+	}
+
+	return (&record).ToStorageRecord(), nil
+}
+
+//UpdateRating stores the given rating and appends the history record
+func (s *StorageManager) UpdateRating(rating *ratings.StorageRecord, history *ratings.HistoryRecord) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	if rating == nil {
+		return errors.New("No rating provided")
+	}
+
+	record := newRatingStorageRecord(rating)
+
+	var existing ratingStorageRecord
+
+	err := s.dbMap.SelectOne(&existing, "select * from "+tableRatings+" where UserID=? and GameName=?", rating.UserID, rating.GameName)
+
+	if err == sql.ErrNoRows {
+		if err := s.dbMap.Insert(record); err != nil {
+			return errors.New("Couldn't insert rating: " + err.Error())
+		}
+	} else if err != nil {
+		return errors.New("Unexpected error: " + err.Error())
+	} else {
+		record.ID = existing.ID
+		if _, err := s.dbMap.Update(record); err != nil {
+			return errors.New("Couldn't update rating: " + err.Error())
+		}
+	}
+
+	if history == nil {
+		return nil
+	}
+
+	if err := s.dbMap.Insert(newRatingHistoryStorageRecord(history)); err != nil {
+		return errors.New("Couldn't insert rating history: " + err.Error())
+	}
+
+	return nil
+}
+
+//ListRatings returns the top ratings for the given game type
+func (s *StorageManager) ListRatings(gameName string, max int) []*ratings.StorageRecord {
+
+	if !s.connected {
+		return nil
+	}
+
+	var records []ratingStorageRecord
+
+	if _, err := s.dbMap.Select(&records, "select * from "+tableRatings+" where GameName=? order by Rating desc limit ?", gameName, max); err != nil {
+		log.Println("List ratings failed: " + err.Error())
+		return nil
+	}
+
+	result := make([]*ratings.StorageRecord, len(records))
+
+	for i, record := range records {
+		result[i] = (&record).ToStorageRecord()
+	}
+
+	return result
+}
+
+//RatingHistory returns the most recent rating changes for the given user
+func (s *StorageManager) RatingHistory(userID string, gameName string, max int) []*ratings.HistoryRecord {
+
+	if !s.connected {
+		return nil
+	}
+
+	var records []ratingHistoryStorageRecord
+
+	query := "select * from " + tableRatingHistory + " where UserID=?"
+	args := []interface{}{userID}
+
+	if gameName != "" {
+		query += " and GameName=?"
+		args = append(args, gameName)
+	}
+
+	query += " order by ID desc limit ?"
+	args = append(args, max)
+
+	if _, err := s.dbMap.Select(&records, query, args...); err != nil {
+		log.Println("Rating history failed: " + err.Error())
+		return nil
+	}
+
+	result := make([]*ratings.HistoryRecord, len(records))
+
+	for i, record := range records {
+		result[i] = (&record).ToStorageRecord()
+	}
+
+	return result
+}
+
+//Tournament returns the tournament with the given ID
+func (s *StorageManager) Tournament(id string) (*tournaments.StorageRecord, error) {
+
+	if !s.connected {
+		return nil, errors.New("Database not connected yet")
+	}
+
+	var record tournamentStorageRecord
+
+	err := s.dbMap.SelectOne(&record, "select * from "+tableTournaments+" where ID=?", id)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.New("No such tournament")
+	}
+
+	if err != nil {
+		return nil, errors.New("Unexpected error: " + err.Error())
+	}
+
+	return (&record).ToStorageRecord()
+}
+
+//UpdateTournament inserts or updates the given tournament
+func (s *StorageManager) UpdateTournament(tournament *tournaments.StorageRecord) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	if tournament == nil {
+		return errors.New("No tournament provided")
+	}
+
+	record, err := newTournamentStorageRecord(tournament)
+
+	if err != nil {
+		return err
+	}
+
+	count, err := s.dbMap.SelectInt("select count(*) from "+tableTournaments+" where ID=?", tournament.ID)
+
+	if err != nil {
+		return errors.New("Unexpected error: " + err.Error())
+	}
+
+	if count < 1 {
+		if err := s.dbMap.Insert(record); err != nil {
+			return errors.New("Couldn't insert tournament: " + err.Error())
+		}
+		return nil
+	}
+
+	if _, err := s.dbMap.Update(record); err != nil {
+		return errors.New("Couldn't update tournament: " + err.Error())
+	}
+
+	return nil
+}
+
+//ListTournaments returns the most recently created tournaments
+func (s *StorageManager) ListTournaments(max int, gameName string) []*tournaments.StorageRecord {
+
+	if !s.connected {
+		return nil
+	}
+
+	var records []tournamentStorageRecord
+
+	query := "select * from " + tableTournaments
+	var args []interface{}
+
+	if gameName != "" {
+		query += " where GameName=?"
+		args = append(args, gameName)
+	}
+
+	query += " order by Created desc limit ?"
+	args = append(args, max)
+
+	if _, err := s.dbMap.Select(&records, query, args...); err != nil {
+		log.Println("List tournaments failed: " + err.Error())
+		return nil
+	}
+
+	var result []*tournaments.StorageRecord
+
+	for _, record := range records {
+		tournament, err := (&record).ToStorageRecord()
+		if err != nil {
+			log.Println("Skipping tournament " + record.ID + ": " + err.Error())
+			continue
+		}
+		result = append(result, tournament)
+	}
+
+	return result
+}
+
+//ListUsers returns the most recently seen users matching query
+func (s *StorageManager) ListUsers(max int, query string) []*users.StorageRecord {
+
+	if !s.connected {
+		return nil
+	}
+
+	var records []userStorageRecord
+
+	sqlQuery := "select * from " + tableUsers
+	var args []interface{}
+
+	if query != "" {
+		pattern := "%" + strings.ToLower(query) + "%"
+		sqlQuery += " where lower(ID) like ? or lower(DisplayName) like ? or lower(Email) like ?"
+		args = append(args, pattern, pattern, pattern)
+	}
+
+	sqlQuery += " order by LastSeen desc limit ?"
+	args = append(args, max)
+
+	if _, err := s.dbMap.Select(&records, sqlQuery, args...); err != nil {
+		log.Println("List users failed: " + err.Error())
+		return nil
+	}
+
+	result := make([]*users.StorageRecord, len(records))
+
+	for i, record := range records {
+		result[i] = (&record).ToStorageRecord()
+	}
+
+	return result
+}
+
+//DeleteGame deletes the game and all of its associated rows
+func (s *StorageManager) DeleteGame(id string) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	count, err := s.dbMap.SelectInt("select count(*) from "+tableGames+" where ID=?", id)
+
+	if err != nil {
+		return errors.New("Unexpected error: " + err.Error())
+	}
+
+	if count < 1 {
+		return errors.New("No such game")
+	}
+
+	tx, err := s.dbMap.Begin()
+
+	if err != nil {
+		return errors.New("Couldn't start transaction: " + err.Error())
+	}
+
+	queries := []string{
+		"delete from " + tableStates + " where GameID=?",
+		"delete from " + tableMoves + " where GameID=?",
+		"delete from " + tableAgentStates + " where GameID=?",
+		"delete from " + tablePlayers + " where GameID=?",
+		"delete from " + tableExtendedGames + " where ID=?",
+		"delete from " + tableGames + " where ID=?",
+	}
+
+	for _, query := range queries {
+		if _, err := tx.Exec(query, id); err != nil {
+			tx.Rollback()
+			return errors.New("Couldn't delete game: " + err.Error())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("Couldn't commit deletion: " + err.Error())
+	}
+
+	return nil
+}
+
+//DeleteUser deletes the user, their cookies and their ratings, hands their
+//seats and games to users.DeletedUserID, and tombstones them in tournaments.
+func (s *StorageManager) DeleteUser(uid string) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	count, err := s.dbMap.SelectInt("select count(*) from "+tableUsers+" where ID=?", uid)
+
+	if err != nil {
+		return errors.New("Unexpected error: " + err.Error())
+	}
+
+	if count < 1 {
+		return errors.New("No such user")
+	}
+
+	tx, err := s.dbMap.Begin()
+
+	if err != nil {
+		return errors.New("Couldn't start transaction: " + err.Error())
+	}
+
+	queries := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"update " + tablePlayers + " set UserID=? where UserID=?", []interface{}{users.DeletedUserID, uid}},
+		{"update " + tableExtendedGames + " set Owner=? where Owner=?", []interface{}{users.DeletedUserID, uid}},
+		{"delete from " + tableRatingHistory + " where UserID=?", []interface{}{uid}},
+		{"delete from " + tableRatings + " where UserID=?", []interface{}{uid}},
+		{"delete from " + tableCookies + " where UserID=?", []interface{}{uid}},
+		{"delete from " + tableUsers + " where ID=?", []interface{}{uid}},
+	}
+
+	for _, query := range queries {
+		if _, err := tx.Exec(query.query, query.args...); err != nil {
+			tx.Rollback()
+			return errors.New("Couldn't delete user: " + err.Error())
+		}
+	}
+
+	//Tournament players live in the blob, so narrow it down to the blobs
+	//that mention the user and let RemoveUser check them properly.
+	var tournamentRecords []tournamentStorageRecord
+
+	if _, err := tx.Select(&tournamentRecords, "select * from "+tableTournaments+" where Owner=? or Blob like ?", uid, "%\""+uid+"\"%"); err != nil {
+		tx.Rollback()
+		return errors.New("Couldn't select tournaments: " + err.Error())
+	}
+
+	for _, record := range tournamentRecords {
+		tournament, err := (&record).ToStorageRecord()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if !tournament.RemoveUser(uid) {
+			continue
+		}
+		updated, err := newTournamentStorageRecord(tournament)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if _, err := tx.Update(updated); err != nil {
+			tx.Rollback()
+			return errors.New("Couldn't update tournament: " + err.Error())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("Couldn't commit deletion: " + err.Error())
+	}
+
+	return nil
+}
+
+//AddAuditRecord inserts the given audit record
+func (s *StorageManager) AddAuditRecord(record *audit.StorageRecord) error {
+
+	if !s.connected {
+		return errors.New("Database not connected yet")
+	}
+
+	if record == nil {
+		return errors.New("No record provided")
+	}
+
+	if err := s.dbMap.Insert(newAuditStorageRecord(record)); err != nil {
+		return errors.New("Couldn't insert audit record: " + err.Error())
+	}
+
+	return nil
+}
+
+//AuditRecords returns the most recent audit records
+func (s *StorageManager) AuditRecords(max int) []*audit.StorageRecord {
+
+	if !s.connected {
+		return nil
+	}
+
+	var records []auditStorageRecord
+
+	if _, err := s.dbMap.Select(&records, "select * from "+tableAudit+" order by ID desc limit ?", max); err != nil {
+		log.Println("Audit records failed: " + err.Error())
+		return nil
+	}
+
+	result := make([]*audit.StorageRecord, len(records))
+
+	for i, record := range records {
+		result[i] = (&record).ToStorageRecord()
+	}
+
+	return result
+}
+
 //PlayerMoveApplied does nothing
 func (s *StorageManager) PlayerMoveApplied(game *boardgame.GameStorageRecord) error {
 	//Don't need to do anything
@@ -0,0 +1,2 @@
+drop table `ratings`;
+drop table `ratinghistory`;
@@ -0,0 +1,2 @@
+create table if not exists `ratings` (`ID` bigint not null primary key auto_increment, `UserID` varchar(128) not null, `GameName` varchar(64) not null, `Rating` double, `NumGames` bigint, `NumWins` bigint, `LastUpdated` bigint, unique key `UserGame` (`UserID`, `GameName`))  engine=InnoDB charset=utf8;
+create table if not exists `ratinghistory` (`ID` bigint not null primary key auto_increment, `UserID` varchar(128) not null, `GameName` varchar(64) not null, `GameID` varchar(16), `Rating` double, `Delta` double, `Won` boolean, `Timestamp` bigint)  engine=InnoDB charset=utf8;
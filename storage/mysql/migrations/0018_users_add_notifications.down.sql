@@ -0,0 +1,2 @@
+alter table `users` drop column `NotifyByEmail`;
+alter table `users` drop column `NotifyWebhookURL`;
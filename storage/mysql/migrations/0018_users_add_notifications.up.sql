@@ -0,0 +1,2 @@
+alter table `users` add column `NotifyByEmail` boolean not null default 0;
+alter table `users` add column `NotifyWebhookURL` text not null;
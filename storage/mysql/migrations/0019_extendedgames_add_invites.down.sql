@@ -0,0 +1,2 @@
+alter table `extendedgames` drop column `Invites`;
+alter table `extendedgames` drop column `PasswordHash`;
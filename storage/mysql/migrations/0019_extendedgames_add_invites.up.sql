@@ -0,0 +1,2 @@
+alter table `extendedgames` add column `PasswordHash` varchar(128) not null default '';
+alter table `extendedgames` add column `Invites` text not null;
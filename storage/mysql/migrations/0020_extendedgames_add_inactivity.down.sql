@@ -0,0 +1,4 @@
+alter table `extendedgames` drop column `InactivityWarned`;
+alter table `extendedgames` drop column `InactivityDeadline`;
+alter table `extendedgames` drop column `TurnStarted`;
+alter table `extendedgames` drop column `SeatLastMove`;
@@ -0,0 +1,4 @@
+alter table `extendedgames` add column `SeatLastMove` text not null;
+alter table `extendedgames` add column `TurnStarted` bigint not null default 0;
+alter table `extendedgames` add column `InactivityDeadline` bigint not null default 0;
+alter table `extendedgames` add column `InactivityWarned` boolean not null default false;
@@ -0,0 +1,2 @@
+alter table `extendedgames` drop column `TournamentID`;
+drop table `tournaments`;
@@ -0,0 +1,2 @@
+create table if not exists `tournaments` (`ID` varchar(16) not null primary key, `Name` varchar(128), `GameName` varchar(64) not null, `Owner` varchar(128), `Created` bigint, `Finished` boolean, `Blob` mediumtext)  engine=InnoDB charset=utf8;
+alter table `extendedgames` add column `TournamentID` varchar(16) not null default '';
@@ -0,0 +1,2 @@
+drop table `audit`;
+alter table `users` drop column `Banned`;
@@ -0,0 +1,2 @@
+alter table `users` add column `Banned` boolean not null default false;
+create table if not exists `audit` (`ID` bigint not null primary key auto_increment, `Timestamp` bigint, `AdminID` varchar(128), `Action` varchar(64), `GameID` varchar(16), `UserID` varchar(128), `Details` text)  engine=InnoDB charset=utf8;
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/jkomoros/boardgame"
+	"github.com/jkomoros/boardgame/server/api/audit"
 	"github.com/jkomoros/boardgame/server/api/extendedgame"
+	"github.com/jkomoros/boardgame/server/api/ratings"
+	"github.com/jkomoros/boardgame/server/api/tournaments"
 	"github.com/jkomoros/boardgame/server/api/users"
 )
 
@@ -23,6 +26,11 @@ type userStorageRecord struct {
 	DisplayName string `db:",size:64"`
 	PhotoURL    string `db:",size:1024"`
 	Email       string `db:",size:128"`
+	//NotifyByEmail and NotifyWebhookURL are the user's turn notification
+	//preferences.
+	NotifyByEmail    bool
+	NotifyWebhookURL string `db:",size:1024"`
+	Banned           bool
 }
 
 type cookieStorageRecord struct {
@@ -50,27 +58,36 @@ type gameStorageRecord struct {
 }
 
 type extendedGameStorageRecord struct {
-	ID      string `db:",size:16"`
-	Open    bool
-	Visible bool
-	Owner   string `db:",size:128"`
+	ID           string `db:",size:16"`
+	Open         bool
+	Visible      bool
+	Owner        string `db:",size:128"`
+	PasswordHash string `db:",size:128"`
+	Invites      string `db:",size:65536"`
+	//SeatLastMove is a comma separated list of UnixNano timestamps.
+	SeatLastMove       string `db:",size:65536"`
+	TurnStarted        int64
+	InactivityDeadline int64
+	InactivityWarned   bool
+	TournamentID       string `db:",size:16"`
 }
 
 //Used for pulling out of a db with a join
 type combinedGameStorageRecord struct {
-	Name       string
-	ID         string
-	SecretSalt string
-	Version    int64
-	Winners    string
-	Finished   bool
-	NumPlayers int64
-	Agents     string
-	Created    int64
-	Modified   int64
-	Open       bool
-	Visible    bool
-	Owner      string
+	Name         string
+	ID           string
+	SecretSalt   string
+	Version      int64
+	Winners      string
+	Finished     bool
+	NumPlayers   int64
+	Agents       string
+	Created      int64
+	Modified     int64
+	Open         bool
+	Visible      bool
+	Owner        string
+	PasswordHash string
 }
 
 type stateStorageRecord struct {
@@ -106,6 +123,49 @@ type agentStateStorageRecord struct {
 	Blob        string `db:",size:1000000"`
 }
 
+type ratingStorageRecord struct {
+	ID          int64
+	UserID      string `db:",size:128"`
+	GameName    string `db:",size:64"`
+	Rating      float64
+	NumGames    int64
+	NumWins     int64
+	LastUpdated int64
+}
+
+type ratingHistoryStorageRecord struct {
+	ID        int64
+	UserID    string `db:",size:128"`
+	GameName  string `db:",size:64"`
+	GameID    string `db:",size:16"`
+	Rating    float64
+	Delta     float64
+	Won       bool
+	Timestamp int64
+}
+
+type auditStorageRecord struct {
+	ID        int64
+	Timestamp int64
+	AdminID   string `db:",size:128"`
+	Action    string `db:",size:64"`
+	GameID    string `db:",size:16"`
+	UserID    string `db:",size:128"`
+	Details   string `db:",size:65536"`
+}
+
+//tournamentStorageRecord pulls out the fields we query on; everything else
+//about the tournament is stored in Blob.
+type tournamentStorageRecord struct {
+	ID       string `db:",size:16"`
+	Name     string `db:",size:128"`
+	GameName string `db:",size:64"`
+	Owner    string `db:",size:128"`
+	Created  int64
+	Finished bool
+	Blob     string `db:",size:10000000"`
+}
+
 func agentsToString(agents []string) string {
 	if agents == nil {
 		return ""
@@ -124,6 +184,34 @@ func winnersToString(winners []boardgame.PlayerIndex) string {
 	return strings.Join(strs, ",")
 }
 
+func timestampsToString(timestamps []int64) string {
+	if timestamps == nil {
+		return ""
+	}
+	strs := make([]string, len(timestamps))
+	for i, timestamp := range timestamps {
+		strs[i] = strconv.FormatInt(timestamp, 10)
+	}
+	return strings.Join(strs, ",")
+}
+
+func stringToTimestamps(timestamps string) []int64 {
+	if timestamps == "" {
+		return nil
+	}
+
+	strs := strings.Split(timestamps, ",")
+
+	result := make([]int64, len(strs))
+
+	for i, str := range strs {
+		//A malformed timestamp is treated as never.
+		result[i], _ = strconv.ParseInt(str, 10, 64)
+	}
+
+	return result
+}
+
 func stringToAgents(agents string) []string {
 	if agents == "" {
 		return nil
@@ -265,9 +353,10 @@ func (c *combinedGameStorageRecord) ToStorageRecord() *extendedgame.CombinedStor
 			Modified:   time.Unix(0, c.Modified),
 		},
 		StorageRecord: extendedgame.StorageRecord{
-			Open:    c.Open,
-			Visible: c.Visible,
-			Owner:   c.Owner,
+			Open:         c.Open,
+			Visible:      c.Visible,
+			Owner:        c.Owner,
+			PasswordHash: c.PasswordHash,
 		},
 	}
 
@@ -293,6 +382,9 @@ func newCombinedGameStorageRecord(combined *extendedgame.CombinedStorageRecord)
 		Open:       combined.Open,
 		Visible:    combined.Visible,
 		Owner:      combined.Owner,
+		//Invites aren't stored in the combined record; they're only
+		//available via ExtendedGame.
+		PasswordHash: combined.PasswordHash,
 	}
 
 }
@@ -302,10 +394,26 @@ func (e *extendedGameStorageRecord) ToStorageRecord() *extendedgame.StorageRecor
 		return nil
 	}
 
+	var invites []*extendedgame.Invite
+
+	if e.Invites != "" {
+		//A malformed blob just means no outstanding invites.
+		json.Unmarshal([]byte(e.Invites), &invites)
+	}
+
 	return &extendedgame.StorageRecord{
-		Open:    e.Open,
-		Visible: e.Visible,
-		Owner:   e.Owner,
+		Open:         e.Open,
+		Visible:      e.Visible,
+		Owner:        e.Owner,
+		PasswordHash: e.PasswordHash,
+		Invites:      invites,
+		InactivityClock: extendedgame.InactivityClock{
+			SeatLastMove:       stringToTimestamps(e.SeatLastMove),
+			TurnStarted:        e.TurnStarted,
+			InactivityDeadline: e.InactivityDeadline,
+			InactivityWarned:   e.InactivityWarned,
+		},
+		TournamentID: e.TournamentID,
 	}
 }
 
@@ -314,10 +422,24 @@ func newExtendedGameStorageRecord(eGame *extendedgame.StorageRecord) *extendedGa
 		return nil
 	}
 
+	var invites string
+
+	if len(eGame.Invites) > 0 {
+		blob, _ := json.Marshal(eGame.Invites)
+		invites = string(blob)
+	}
+
 	return &extendedGameStorageRecord{
-		Open:    eGame.Open,
-		Visible: eGame.Visible,
-		Owner:   eGame.Owner,
+		Open:               eGame.Open,
+		Visible:            eGame.Visible,
+		Owner:              eGame.Owner,
+		PasswordHash:       eGame.PasswordHash,
+		Invites:            invites,
+		SeatLastMove:       timestampsToString(eGame.SeatLastMove),
+		TurnStarted:        eGame.TurnStarted,
+		InactivityDeadline: eGame.InactivityDeadline,
+		InactivityWarned:   eGame.InactivityWarned,
+		TournamentID:       eGame.TournamentID,
 	}
 }
 
@@ -348,23 +470,29 @@ func newMoveStorageRecord(gameID string, version int, record *boardgame.MoveStor
 
 func (s *userStorageRecord) ToStorageRecord() *users.StorageRecord {
 	return &users.StorageRecord{
-		ID:          s.ID,
-		DisplayName: s.DisplayName,
-		Created:     s.Created,
-		LastSeen:    s.LastSeen,
-		PhotoURL:    s.PhotoURL,
-		Email:       s.Email,
+		ID:               s.ID,
+		DisplayName:      s.DisplayName,
+		Created:          s.Created,
+		LastSeen:         s.LastSeen,
+		PhotoURL:         s.PhotoURL,
+		Email:            s.Email,
+		NotifyByEmail:    s.NotifyByEmail,
+		NotifyWebhookURL: s.NotifyWebhookURL,
+		Banned:           s.Banned,
 	}
 }
 
 func newUserStorageRecord(user *users.StorageRecord) *userStorageRecord {
 	return &userStorageRecord{
-		ID:          user.ID,
-		DisplayName: user.DisplayName,
-		Created:     user.Created,
-		LastSeen:    user.LastSeen,
-		PhotoURL:    user.PhotoURL,
-		Email:       user.Email,
+		ID:               user.ID,
+		DisplayName:      user.DisplayName,
+		Created:          user.Created,
+		LastSeen:         user.LastSeen,
+		PhotoURL:         user.PhotoURL,
+		Email:            user.Email,
+		NotifyByEmail:    user.NotifyByEmail,
+		NotifyWebhookURL: user.NotifyWebhookURL,
+		Banned:           user.Banned,
 	}
 }
 
@@ -397,3 +525,119 @@ func newAgentStateStorageRecord(gameID string, player boardgame.PlayerIndex, sta
 		Blob:        string(state),
 	}
 }
+
+func (r *ratingStorageRecord) ToStorageRecord() *ratings.StorageRecord {
+	if r == nil {
+		return nil
+	}
+	return &ratings.StorageRecord{
+		UserID:      r.UserID,
+		GameName:    r.GameName,
+		Rating:      r.Rating,
+		NumGames:    int(r.NumGames),
+		NumWins:     int(r.NumWins),
+		LastUpdated: r.LastUpdated,
+	}
+}
+
+func newRatingStorageRecord(rating *ratings.StorageRecord) *ratingStorageRecord {
+	if rating == nil {
+		return nil
+	}
+	return &ratingStorageRecord{
+		UserID:      rating.UserID,
+		GameName:    rating.GameName,
+		Rating:      rating.Rating,
+		NumGames:    int64(rating.NumGames),
+		NumWins:     int64(rating.NumWins),
+		LastUpdated: rating.LastUpdated,
+	}
+}
+
+func (r *ratingHistoryStorageRecord) ToStorageRecord() *ratings.HistoryRecord {
+	if r == nil {
+		return nil
+	}
+	return &ratings.HistoryRecord{
+		UserID:    r.UserID,
+		GameName:  r.GameName,
+		GameID:    r.GameID,
+		Rating:    r.Rating,
+		Delta:     r.Delta,
+		Won:       r.Won,
+		Timestamp: r.Timestamp,
+	}
+}
+
+func newRatingHistoryStorageRecord(history *ratings.HistoryRecord) *ratingHistoryStorageRecord {
+	if history == nil {
+		return nil
+	}
+	return &ratingHistoryStorageRecord{
+		UserID:    history.UserID,
+		GameName:  history.GameName,
+		GameID:    history.GameID,
+		Rating:    history.Rating,
+		Delta:     history.Delta,
+		Won:       history.Won,
+		Timestamp: history.Timestamp,
+	}
+}
+
+func (t *tournamentStorageRecord) ToStorageRecord() (*tournaments.StorageRecord, error) {
+	if t == nil {
+		return nil, nil
+	}
+	var result tournaments.StorageRecord
+	if err := json.Unmarshal([]byte(t.Blob), &result); err != nil {
+		return nil, errors.New("Couldn't unmarshal tournament: " + err.Error())
+	}
+	return &result, nil
+}
+
+func newTournamentStorageRecord(tournament *tournaments.StorageRecord) (*tournamentStorageRecord, error) {
+	if tournament == nil {
+		return nil, nil
+	}
+	blob, err := json.Marshal(tournament)
+	if err != nil {
+		return nil, errors.New("Couldn't marshal tournament: " + err.Error())
+	}
+	return &tournamentStorageRecord{
+		ID:       tournament.ID,
+		Name:     tournament.Name,
+		GameName: tournament.GameName,
+		Owner:    tournament.Owner,
+		Created:  tournament.Created,
+		Finished: tournament.Finished,
+		Blob:     string(blob),
+	}, nil
+}
+
+func (a *auditStorageRecord) ToStorageRecord() *audit.StorageRecord {
+	if a == nil {
+		return nil
+	}
+	return &audit.StorageRecord{
+		Timestamp: a.Timestamp,
+		AdminID:   a.AdminID,
+		Action:    audit.Action(a.Action),
+		GameID:    a.GameID,
+		UserID:    a.UserID,
+		Details:   a.Details,
+	}
+}
+
+func newAuditStorageRecord(record *audit.StorageRecord) *auditStorageRecord {
+	if record == nil {
+		return nil
+	}
+	return &auditStorageRecord{
+		Timestamp: record.Timestamp,
+		AdminID:   record.AdminID,
+		Action:    string(record.Action),
+		GameID:    record.GameID,
+		UserID:    record.UserID,
+		Details:   record.Details,
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 //This file is actually used to just implement a shim StorageManager so our
 //own package tests can run. The shim is basically storage/memory/StorageManager.
+//Like it, it's safe for concurrent use, since agents and tests read from it
+//while a game's mainLoop writes to it.
 
 type testStorageManager struct {
+	lock   sync.RWMutex
 	states map[string]map[int]StateStorageRecord
 	moves  map[string]map[int]*MoveStorageRecord
 	games  map[string]*GameStorageRecord
@@ -26,6 +30,9 @@ func newTestStorageManager() *testStorageManager {
 }
 
 func (t *testStorageManager) String() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	var results []string
 
 	results = append(results, "States")
@@ -47,6 +54,9 @@ func (t *testStorageManager) String() string {
 }
 
 func (t *testStorageManager) State(gameID string, version int) (StateStorageRecord, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	if gameID == "" {
 		return nil, errors.New("No game provided")
 	}
@@ -86,6 +96,9 @@ func (t *testStorageManager) Moves(gameID string, fromVersion, toVersion int) ([
 }
 
 func (t *testStorageManager) Move(gameID string, version int) (*MoveStorageRecord, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	if gameID == "" {
 		return nil, errors.New("No game provided")
 	}
@@ -110,6 +123,9 @@ func (t *testStorageManager) Move(gameID string, version int) (*MoveStorageRecor
 }
 
 func (t *testStorageManager) Game(id string) (*GameStorageRecord, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	record := t.games[id]
 
 	if record == nil {
@@ -121,6 +137,9 @@ func (t *testStorageManager) Game(id string) (*GameStorageRecord, error) {
 }
 
 func (t *testStorageManager) SaveGameAndCurrentState(game *GameStorageRecord, state StateStorageRecord, move *MoveStorageRecord) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
 	if game == nil {
 		return errors.New("No game provided")
 	}
@@ -158,6 +177,23 @@ func (t *testStorageManager) SaveGameAndCurrentState(game *GameStorageRecord, st
 	return nil
 }
 
+func (t *testStorageManager) UpdateGame(game *GameStorageRecord) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if game == nil {
+		return errors.New("No game provided")
+	}
+
+	if t.games[game.ID] == nil {
+		return errors.New("That game does not exist")
+	}
+
+	t.games[game.ID] = game
+
+	return nil
+}
+
 func (t *testStorageManager) PlayerMoveApplied(game *GameStorageRecord) error {
 	//Pass
 	return nil
@@ -222,6 +222,8 @@ func (t *timerManager) PrepareTimer(duration time.Duration, state *state, move M
 
 	heap.Push(&t.records, record)
 
+	t.updateQueueDepth()
+
 	return record.id
 }
 
@@ -283,6 +285,8 @@ func (t *timerManager) CancelTimer(id string) {
 
 	delete(t.recordsByID, record.id)
 
+	t.updateQueueDepth()
+
 }
 
 //ForceNextTimer is designed to force fire the next timer no matter when it's
@@ -315,6 +319,11 @@ func (t *timerManager) Tick() {
 	}
 }
 
+//updateQueueDepth reports the number of timers in the queue to metrics.
+func (t *timerManager) updateQueueDepth() {
+	metricTimerQueueDepth.WithLabelValues(t.manager.delegate.Name()).Set(float64(len(t.records)))
+}
+
 //Whether the next timer in the queue is already fired
 func (t *timerManager) nextTimerFired() bool {
 	if len(t.records) == 0 {
@@ -343,6 +352,8 @@ func (t *timerManager) popNext(force bool) *timerRecord {
 
 	delete(t.recordsByID, record.id)
 
+	t.updateQueueDepth()
+
 	return record
 }
 